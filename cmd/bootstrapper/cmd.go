@@ -1,6 +1,11 @@
 package bootstrapper
 
 import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
 	"github.com/Dynatrace/dynatrace-bootstrapper/cmd/k8sinit"
 	"github.com/Dynatrace/dynatrace-bootstrapper/cmd/k8sinit/configure"
 	"github.com/Dynatrace/dynatrace-bootstrapper/cmd/k8sinit/move"
@@ -10,6 +15,7 @@ import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/csi/metadata"
 	"github.com/Dynatrace/dynatrace-operator/pkg/injection/codemodule/installer/url"
 	"github.com/Dynatrace/dynatrace-operator/pkg/logd"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"golang.org/x/sys/unix"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -25,6 +31,16 @@ const (
 	TargetVersionFlag      = "version"
 	FlavorFlag             = "flavor"
 	MetadataEnrichmentFlag = "metadata-enrichment"
+	SidecarFlag            = "sidecar"
+	CheckReadyFlag         = "check-ready"
+	StateFolderFlag        = "state-folder"
+
+	// ReadyFileName is created in the state folder once the bootstrap is done, when running as a native sidecar.
+	// The state folder is only mounted into the sidecar, so the application containers can't tamper with it, and it survives restarts of the sidecar.
+	ReadyFileName = ".bootstrap-ready"
+
+	// refreshInterval is the interval in which the configuration is refreshed, when running as a native sidecar.
+	refreshInterval = 5 * time.Minute
 )
 
 var (
@@ -36,6 +52,10 @@ var (
 
 	needsMetadataEnrichment bool
 
+	isSidecar    bool
+	isReadyCheck bool
+	stateFolder  string
+
 	log = logd.Get().WithName("bootstrap")
 )
 
@@ -69,12 +89,51 @@ func AddFlags(cmd *cobra.Command) {
 
 	cmd.PersistentFlags().Lookup(MetadataEnrichmentFlag).NoOptDefVal = "true"
 
+	cmd.PersistentFlags().BoolVar(&isSidecar, SidecarFlag, false, "(Optional) Keep running after the bootstrap and refresh the configuration periodically, used when running as a native sidecar.")
+
+	cmd.PersistentFlags().Lookup(SidecarFlag).NoOptDefVal = "true"
+
+	cmd.PersistentFlags().BoolVar(&isReadyCheck, CheckReadyFlag, false, "(Optional) Only check if the bootstrap is done, used as the startup probe of the native sidecar.")
+
+	cmd.PersistentFlags().Lookup(CheckReadyFlag).NoOptDefVal = "true"
+
+	cmd.PersistentFlags().StringVar(&stateFolder, StateFolderFlag, "", "(Optional) Folder where the native sidecar marks the bootstrap as done, required when running as a native sidecar.")
+
 	configure.AddFlags(cmd)
 }
 
 func run(cmd *cobra.Command, _ []string) error {
+	if (isReadyCheck || isSidecar) && stateFolder == "" {
+		return errors.Errorf("--%s is required when running as a native sidecar", StateFolderFlag)
+	}
+
+	if isReadyCheck {
+		return checkReady(stateFolder)
+	}
+
 	unix.Umask(0000)
 
+	ctx := context.Background()
+	if targetVersion != "" || isSidecar {
+		ctx = ctrl.SetupSignalHandler()
+	}
+
+	// a restarted sidecar must not bootstrap again, the application containers are already running with the result
+	if isSidecar && checkReady(stateFolder) == nil {
+		log.Info("bootstrap was already done, only refreshing the configuration", "state-folder", stateFolder)
+
+		return runSidecar(ctx, stateFolder)
+	}
+
+	err := bootstrap(ctx, cmd)
+	if err != nil || !isSidecar {
+		return err
+	}
+
+	return runSidecar(ctx, stateFolder)
+}
+
+func bootstrap(ctx context.Context, cmd *cobra.Command) error {
 	if targetVersion != "" {
 		inputDir, _ := cmd.Flags().GetString(configure.InputFolderFlag)
 
@@ -92,9 +151,7 @@ func run(cmd *cobra.Command, _ []string) error {
 
 		client := download.New()
 
-		signalHandler := ctrl.SetupSignalHandler()
-
-		err := client.Do(signalHandler, inputDir, targetFolder, props)
+		err := client.Do(ctx, inputDir, targetFolder, props)
 		if err != nil {
 			if areErrorsSuppressed {
 				log.Error(err, "error during download, the error was suppressed")
@@ -122,6 +179,57 @@ func run(cmd *cobra.Command, _ []string) error {
 	return nil
 }
 
+// runSidecar marks the bootstrap as done, so the startup probe succeeds and the application containers are started.
+// Afterwards the configuration is refreshed periodically, to pick up changes of the mounted input, until the pod is terminated.
+func runSidecar(ctx context.Context, stateFolder string) error {
+	err := markReady(stateFolder)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("pod is terminating, stopping the sidecar")
+
+			return nil
+		case <-ticker.C:
+			err := runConfigure()
+			if err != nil {
+				log.Error(err, "failed to refresh the configuration")
+			}
+		}
+	}
+}
+
+func markReady(stateFolder string) error {
+	err := os.MkdirAll(stateFolder, os.ModePerm)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	err = os.WriteFile(filepath.Join(stateFolder, ReadyFileName), nil, 0o644)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	log.Info("bootstrap done, marked the sidecar as ready", "state-folder", stateFolder)
+
+	return nil
+}
+
+func checkReady(stateFolder string) error {
+	_, err := os.Stat(filepath.Join(stateFolder, ReadyFileName))
+	if err != nil {
+		return errors.WithMessage(err, "bootstrap is not done yet")
+	}
+
+	return nil
+}
+
 func runConfigure() error {
 	if targetFolder != "" {
 		err := configure.SetupOneAgent(log.Logger, targetFolder)
@@ -1,9 +1,11 @@
 package bootstrapper
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/Dynatrace/dynatrace-bootstrapper/cmd/k8sinit/configure"
 	"github.com/Dynatrace/dynatrace-bootstrapper/cmd/k8sinit/configure/attributes/container"
@@ -216,6 +218,52 @@ func TestBootstrapConfigurationStep(t *testing.T) {
 	})
 }
 
+func TestSidecar(t *testing.T) {
+	t.Run("ready check fails until the bootstrap is done", func(t *testing.T) {
+		statePath := filepath.Join(t.TempDir(), "state")
+
+		cmd := New()
+		cmd.SetArgs([]string{
+			"bootstrap",
+			"--check-ready",
+			"--state-folder=" + statePath,
+		})
+
+		require.Error(t, cmd.Execute())
+
+		require.NoError(t, markReady(statePath))
+		require.NoError(t, cmd.Execute())
+	})
+
+	t.Run("ready check requires the state folder", func(t *testing.T) {
+		cmd := New()
+		cmd.SetArgs([]string{
+			"bootstrap",
+			"--check-ready",
+			"--state-folder=",
+		})
+
+		require.Error(t, cmd.Execute())
+	})
+
+	t.Run("sidecar marks itself ready and stops when the pod terminates", func(t *testing.T) {
+		statePath := filepath.Join(t.TempDir(), "state")
+		ctx, cancel := context.WithCancel(t.Context())
+
+		done := make(chan error)
+		go func() {
+			done <- runSidecar(ctx, statePath)
+		}()
+
+		assert.Eventually(t, func() bool {
+			return checkReady(statePath) == nil
+		}, time.Second, 10*time.Millisecond)
+
+		cancel()
+		require.NoError(t, <-done)
+	})
+}
+
 func createFile(t *testing.T, filePath string, content string) {
 	t.Helper()
 
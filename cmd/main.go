@@ -27,6 +27,7 @@ import (
 	csiServer "github.com/Dynatrace/dynatrace-operator/cmd/csi/server"
 	"github.com/Dynatrace/dynatrace-operator/cmd/metadata"
 	"github.com/Dynatrace/dynatrace-operator/cmd/operator"
+	"github.com/Dynatrace/dynatrace-operator/cmd/preview"
 	startupProbe "github.com/Dynatrace/dynatrace-operator/cmd/startupprobe"
 	supportArchive "github.com/Dynatrace/dynatrace-operator/cmd/supportarchive"
 	"github.com/Dynatrace/dynatrace-operator/cmd/troubleshoot"
@@ -74,6 +75,7 @@ func main() {
 		registrar.New(),
 		bootstrapper.New(),
 		metadata.New(),
+		preview.New(),
 	)
 
 	err := cmd.Execute()
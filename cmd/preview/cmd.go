@@ -0,0 +1,245 @@
+package preview
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8senv"
+	"github.com/Dynatrace/dynatrace-operator/pkg/webhook"
+	podwebhook "github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	use                      = "preview"
+	fileFlagName             = "file"
+	fileFlagShorthand        = "f"
+	namespaceFlagName        = "namespace"
+	namespaceFlagShorthand   = "n"
+	webhookNamespaceFlagName = "webhook-namespace"
+	tokenFlagName            = "token"
+	defaultPodNamespace      = "default"
+	stdinFileName            = "-"
+	requestTimeout           = 30 * time.Second
+)
+
+var (
+	fileFlagValue             string
+	namespaceFlagValue        string
+	webhookNamespaceFlagValue string
+	tokenFlagValue            string
+)
+
+func New() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: "Shows how the webhook would mutate a Pod or the pods of a workload, without creating anything",
+		Long: "Sends the manifest to the preview endpoint of the webhook and prints the mutated pod, the matched DynaKube and the decisions of the mutators.\n" +
+			"The webhook only answers if the token is allowed to create pods in the namespace.\n" +
+			"Example: kubectl exec -i -n dynatrace deploy/dynatrace-operator -- dynatrace-operator preview -n my-app -f - --token \"$(kubectl create token -n my-app my-sa)\" < deployment.yaml",
+		RunE:         run,
+		SilenceUsage: true,
+	}
+
+	addFlags(cmd)
+
+	return cmd
+}
+
+func addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&fileFlagValue, fileFlagName, fileFlagShorthand, "", "Manifest of the Pod or workload, use - to read it from stdin.")
+	cmd.PersistentFlags().StringVarP(&namespaceFlagValue, namespaceFlagName, namespaceFlagShorthand, "", "Namespace of the pod, defaults to the namespace of the manifest.")
+	cmd.PersistentFlags().StringVar(&webhookNamespaceFlagValue, webhookNamespaceFlagName, k8senv.DefaultNamespace(), "Namespace the webhook is deployed to.")
+	cmd.PersistentFlags().StringVar(&tokenFlagValue, tokenFlagName, "", "Bearer token the webhook authorizes the preview with, defaults to the token of the kubeconfig.")
+
+	_ = cmd.MarkPersistentFlagRequired(fileFlagName)
+}
+
+func run(cmd *cobra.Command, args []string) error {
+	manifest, err := readManifest(cmd.InOrStdin(), fileFlagValue)
+	if err != nil {
+		return err
+	}
+
+	request, err := buildRequest(manifest, namespaceFlagValue)
+	if err != nil {
+		return err
+	}
+
+	kubeConfig, err := config.GetConfig()
+	if err != nil {
+		return err
+	}
+
+	token, err := getToken(kubeConfig, tokenFlagValue)
+	if err != nil {
+		return err
+	}
+
+	clt, err := client.New(kubeConfig, client.Options{Scheme: scheme.Scheme})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), requestTimeout)
+	defer cancel()
+
+	httpClient, err := newWebhookHTTPClient(ctx, clt)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("https://%s.%s.svc%s", webhook.DeploymentName, webhookNamespaceFlagValue, podwebhook.PreviewPath)
+
+	response, err := sendRequest(ctx, httpClient, url, token, request)
+	if err != nil {
+		return err
+	}
+
+	out, err := yaml.Marshal(response)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	_, err = cmd.OutOrStdout().Write(out)
+
+	return errors.WithStack(err)
+}
+
+func readManifest(stdin io.Reader, fileName string) ([]byte, error) {
+	if fileName == stdinFileName {
+		manifest, err := io.ReadAll(stdin)
+
+		return manifest, errors.WithStack(err)
+	}
+
+	manifest, err := os.ReadFile(fileName)
+
+	return manifest, errors.WithStack(err)
+}
+
+// getToken returns the token of the flag, or the bearer token the kubeconfig authenticates with.
+func getToken(kubeConfig *rest.Config, flagValue string) (string, error) {
+	if flagValue != "" {
+		return flagValue, nil
+	}
+
+	if kubeConfig.BearerToken != "" {
+		return kubeConfig.BearerToken, nil
+	}
+
+	if kubeConfig.BearerTokenFile != "" {
+		token, err := os.ReadFile(kubeConfig.BearerTokenFile)
+		if err != nil {
+			return "", errors.WithStack(err)
+		}
+
+		return strings.TrimSpace(string(token)), nil
+	}
+
+	return "", errors.Errorf("the kubeconfig doesn't authenticate with a bearer token, use --%s", tokenFlagName)
+}
+
+// buildRequest converts the YAML or JSON manifest into a preview request.
+// If no namespace is given, the namespace of the manifest is used.
+func buildRequest(manifest []byte, namespace string) (*podwebhook.PreviewRequest, error) {
+	raw, err := yaml.YAMLToJSON(manifest)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to parse manifest")
+	}
+
+	if namespace == "" {
+		var objectMeta struct {
+			metav1.ObjectMeta `json:"metadata"`
+		}
+
+		if err := json.Unmarshal(raw, &objectMeta); err != nil {
+			return nil, errors.WithMessage(err, "failed to parse manifest")
+		}
+
+		namespace = objectMeta.Namespace
+	}
+
+	if namespace == "" {
+		namespace = defaultPodNamespace
+	}
+
+	return &podwebhook.PreviewRequest{Namespace: namespace, Object: runtime.RawExtension{Raw: raw}}, nil
+}
+
+// newWebhookHTTPClient trusts the same CA as the API server uses when calling the webhook.
+func newWebhookHTTPClient(ctx context.Context, clt client.Reader) (*http.Client, error) {
+	var webhookConfig admissionregistrationv1.MutatingWebhookConfiguration
+	if err := clt.Get(ctx, client.ObjectKey{Name: webhook.DeploymentName}, &webhookConfig); err != nil {
+		return nil, errors.WithMessage(err, "failed to get the webhook configuration")
+	}
+
+	if len(webhookConfig.Webhooks) == 0 || len(webhookConfig.Webhooks[0].ClientConfig.CABundle) == 0 {
+		return nil, errors.New("webhook configuration has no CA bundle, the webhook is not ready yet")
+	}
+
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(webhookConfig.Webhooks[0].ClientConfig.CABundle) {
+		return nil, errors.New("failed to parse the CA bundle of the webhook configuration")
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12}
+
+	return &http.Client{Transport: transport}, nil
+}
+
+func sendRequest(ctx context.Context, httpClient *http.Client, url, token string, request *podwebhook.PreviewRequest) (*podwebhook.PreviewResponse, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpRequest.Header.Set("Authorization", "Bearer "+token)
+
+	httpResponse, err := httpClient.Do(httpRequest)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to call the webhook")
+	}
+	defer httpResponse.Body.Close()
+
+	responseBody, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("webhook responded with %d: %s", httpResponse.StatusCode, bytes.TrimSpace(responseBody))
+	}
+
+	var response podwebhook.PreviewResponse
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return nil, errors.WithMessage(err, "failed to parse the response of the webhook")
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,115 @@
+package preview
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	podwebhook "github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+)
+
+const testManifest = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test-deployment
+  namespace: test-namespace
+`
+
+func TestBuildRequest(t *testing.T) {
+	t.Run("namespace of manifest", func(t *testing.T) {
+		request, err := buildRequest([]byte(testManifest), "")
+		require.NoError(t, err)
+
+		assert.Equal(t, "test-namespace", request.Namespace)
+		assert.JSONEq(t, `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"test-deployment","namespace":"test-namespace"}}`, string(request.Object.Raw))
+	})
+
+	t.Run("namespace flag takes precedence", func(t *testing.T) {
+		request, err := buildRequest([]byte(testManifest), "other")
+		require.NoError(t, err)
+
+		assert.Equal(t, "other", request.Namespace)
+	})
+
+	t.Run("default namespace", func(t *testing.T) {
+		request, err := buildRequest([]byte("apiVersion: v1\nkind: Pod\n"), "")
+		require.NoError(t, err)
+
+		assert.Equal(t, defaultPodNamespace, request.Namespace)
+	})
+
+	t.Run("invalid manifest", func(t *testing.T) {
+		_, err := buildRequest([]byte("kind: [Pod"), "")
+		require.Error(t, err)
+	})
+}
+
+func TestSendRequest(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("response is parsed", func(t *testing.T) {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var request podwebhook.PreviewRequest
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			assert.Equal(t, "test-namespace", request.Namespace)
+			assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+
+			_ = json.NewEncoder(w).Encode(podwebhook.PreviewResponse{DynaKube: "test-dynakube"})
+		}))
+		defer server.Close()
+
+		request, err := buildRequest([]byte(testManifest), "")
+		require.NoError(t, err)
+
+		response, err := sendRequest(ctx, server.Client(), server.URL+podwebhook.PreviewPath, "test-token", request)
+		require.NoError(t, err)
+
+		assert.Equal(t, "test-dynakube", response.DynaKube)
+	})
+
+	t.Run("error status is returned", func(t *testing.T) {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "unsupported kind", http.StatusBadRequest)
+		}))
+		defer server.Close()
+
+		_, err := sendRequest(ctx, server.Client(), server.URL+podwebhook.PreviewPath, "test-token", &podwebhook.PreviewRequest{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported kind")
+	})
+}
+
+func TestGetToken(t *testing.T) {
+	t.Run("flag takes precedence", func(t *testing.T) {
+		token, err := getToken(&rest.Config{BearerToken: "kubeconfig-token"}, "flag-token")
+		require.NoError(t, err)
+		assert.Equal(t, "flag-token", token)
+	})
+
+	t.Run("token of kubeconfig", func(t *testing.T) {
+		token, err := getToken(&rest.Config{BearerToken: "kubeconfig-token"}, "")
+		require.NoError(t, err)
+		assert.Equal(t, "kubeconfig-token", token)
+	})
+
+	t.Run("token file of kubeconfig", func(t *testing.T) {
+		tokenFile := filepath.Join(t.TempDir(), "token")
+		require.NoError(t, os.WriteFile(tokenFile, []byte("file-token\n"), 0600))
+
+		token, err := getToken(&rest.Config{BearerTokenFile: tokenFile}, "")
+		require.NoError(t, err)
+		assert.Equal(t, "file-token", token)
+	})
+
+	t.Run("no token", func(t *testing.T) {
+		_, err := getToken(&rest.Config{}, "")
+		require.Error(t, err)
+	})
+}
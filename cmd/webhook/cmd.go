@@ -72,6 +72,7 @@ func run(cmd *cobra.Command, args []string) error {
 	}
 
 	isOpenShift := false
+	supportsNativeSidecars := false
 
 	client, err := discovery.NewDiscoveryClientForConfig(kubeConfig)
 	if err != nil {
@@ -88,6 +89,8 @@ func run(cmd *cobra.Command, args []string) error {
 		default:
 			logd.Get().WithName("platform").Error(err, "failed to detect platform, defaulting to kubernetes")
 		}
+
+		supportsNativeSidecars = detectNativeSidecarSupport(client)
 	}
 
 	webhookManager, err := createManager(kubeConfig, namespace, certificateDirectory, certificateFileName, certificateKeyFileName)
@@ -107,7 +110,7 @@ func run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	err = podmutator.AddWebhookToManager(signalHandler, webhookManager, namespace, isOpenShift)
+	err = podmutator.AddWebhookToManager(signalHandler, webhookManager, namespace, isOpenShift, supportsNativeSidecars)
 	if err != nil {
 		return err
 	}
@@ -145,3 +148,17 @@ func startCertificateWatcher(webhookManager manager.Manager, namespace string, p
 
 	return nil
 }
+
+func detectNativeSidecarSupport(client discovery.ServerVersionInterface) bool {
+	serverVersion, err := client.ServerVersion()
+	if err != nil {
+		logd.Get().WithName("platform").Error(err, "failed to detect the Kubernetes version, native sidecars are not used")
+
+		return false
+	}
+
+	supported := system.SupportsNativeSidecars(serverVersion)
+	logd.Get().WithName("platform").Info("detected Kubernetes version", "version", serverVersion.GitVersion, "nativeSidecars", supported)
+
+	return supported
+}
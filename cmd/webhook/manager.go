@@ -6,10 +6,12 @@ import (
 	"strconv"
 
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/v1alpha2/injectionpolicy"
 	"github.com/pkg/errors"
 	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
@@ -76,6 +78,12 @@ func createOptions(namespace string) ctrl.Options {
 			DefaultNamespaces: map[string]cache.Config{
 				namespace: {},
 			},
+			// the injection policies of all namespaces are part of the snapshot used during admission
+			ByObject: map[client.Object]cache.ByObject{
+				&injectionpolicy.InjectionPolicy{}: {
+					Namespaces: map[string]cache.Config{cache.AllNamespaces: {}},
+				},
+			},
 		},
 		WebhookServer: webhook.NewServer(webhook.Options{
 			Port: port,
@@ -0,0 +1,37 @@
+package exp
+
+const (
+	// SelfSignedCertificateValidityKey can be set on a DynaKube to configure the validity (in days) of the self-signed ActiveGate and extension certificates.
+	// It only applies to newly created certificates, existing ones are renewed once their own validity reaches the renewal threshold.
+	SelfSignedCertificateValidityKey = FFPrefix + "self-signed-certificate-validity"
+	// SelfSignedCertificateRenewalThresholdKey can be set on a DynaKube to configure after which percentage of their validity the self-signed certificates are renewed.
+	SelfSignedCertificateRenewalThresholdKey = FFPrefix + "self-signed-certificate-renewal-threshold"
+
+	// DefaultSelfSignedCertificateValidity is the validity of the self-signed certificates in days, defaults to 1 year.
+	DefaultSelfSignedCertificateValidity = 365
+	// DefaultSelfSignedCertificateRenewalThreshold is the percentage of the validity after which a self-signed certificate is renewed,
+	// with the default validity certificates are renewed after about 10 months.
+	DefaultSelfSignedCertificateRenewalThreshold = 80
+
+	maxPercentage = 100
+)
+
+// GetSelfSignedCertificateValidity is a feature flag to configure the validity (in days) of the self-signed certificates created by the Operator.
+func (ff *FeatureFlags) GetSelfSignedCertificateValidity() int {
+	validity := ff.getIntWithDefault(SelfSignedCertificateValidityKey, DefaultSelfSignedCertificateValidity)
+	if validity <= 0 {
+		return DefaultSelfSignedCertificateValidity
+	}
+
+	return validity
+}
+
+// GetSelfSignedCertificateRenewalThreshold is a feature flag to configure after which percentage of their validity the self-signed certificates are renewed.
+func (ff *FeatureFlags) GetSelfSignedCertificateRenewalThreshold() int {
+	threshold := ff.getIntWithDefault(SelfSignedCertificateRenewalThresholdKey, DefaultSelfSignedCertificateRenewalThreshold)
+	if threshold <= 0 || threshold >= maxPercentage {
+		return DefaultSelfSignedCertificateRenewalThreshold
+	}
+
+	return threshold
+}
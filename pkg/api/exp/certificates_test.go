@@ -0,0 +1,53 @@
+package exp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSelfSignedCertificateValidity(t *testing.T) {
+	cases := []struct {
+		title string
+		in    string
+		out   int
+	}{
+		{title: "default", in: "", out: DefaultSelfSignedCertificateValidity},
+		{title: "overrule", in: "30", out: 30},
+		{title: "negative", in: "-1", out: DefaultSelfSignedCertificateValidity},
+		{title: "invalid", in: "a year", out: DefaultSelfSignedCertificateValidity},
+	}
+
+	for _, c := range cases {
+		t.Run(c.title, func(t *testing.T) {
+			ff := FeatureFlags{annotations: map[string]string{
+				SelfSignedCertificateValidityKey: c.in,
+			}}
+
+			assert.Equal(t, c.out, ff.GetSelfSignedCertificateValidity())
+		})
+	}
+}
+
+func TestGetSelfSignedCertificateRenewalThreshold(t *testing.T) {
+	cases := []struct {
+		title string
+		in    string
+		out   int
+	}{
+		{title: "default", in: "", out: DefaultSelfSignedCertificateRenewalThreshold},
+		{title: "overrule", in: "50", out: 50},
+		{title: "zero", in: "0", out: DefaultSelfSignedCertificateRenewalThreshold},
+		{title: "too high", in: "100", out: DefaultSelfSignedCertificateRenewalThreshold},
+	}
+
+	for _, c := range cases {
+		t.Run(c.title, func(t *testing.T) {
+			ff := FeatureFlags{annotations: map[string]string{
+				SelfSignedCertificateRenewalThresholdKey: c.in,
+			}}
+
+			assert.Equal(t, c.out, ff.GetSelfSignedCertificateRenewalThreshold())
+		})
+	}
+}
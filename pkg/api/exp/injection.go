@@ -3,6 +3,7 @@ package exp
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 )
 
 const (
@@ -10,6 +11,13 @@ const (
 	InjectionAutomaticKey             = FFPrefix + "automatic-injection"
 	InjectionLabelVersionDetectionKey = FFPrefix + "label-version-detection"
 	InjectionFailurePolicyKey         = FFPrefix + "injection-failure-policy"
+	InjectionTechnologyDetectionKey   = FFPrefix + "technology-detection"
+	InjectionNativeSidecarKey         = FFPrefix + "injection-native-sidecar"
+	InjectionEphemeralContainersKey   = FFPrefix + "injection-ephemeral-containers"
+	InjectionReportKey                = FFPrefix + "injection-report"
+	InjectionReportIntervalKey        = FFPrefix + "injection-report-interval"
+
+	DefaultInjectionReportIntervalMinutes = 60
 
 	// Deprecated: This field will be removed in a future release.
 	InjectionSeccompKey = FFPrefix + "init-container-seccomp-profile"
@@ -56,6 +64,39 @@ func (ff *FeatureFlags) IsLabelVersionDetection() bool {
 	return ff.getBoolWithDefault(InjectionLabelVersionDetectionKey, false)
 }
 
+// IsTechnologyDetection is a feature flag to only inject the code modules of the technologies detected in the container images of a pod.
+func (ff *FeatureFlags) IsTechnologyDetection() bool {
+	return ff.getBoolWithDefault(InjectionTechnologyDetectionKey, false)
+}
+
+// IsNativeSidecarInjection is a feature flag to add the install container as a native sidecar (restartable init container),
+// which keeps running next to the application to refresh the configuration. Only used if the cluster supports native sidecars.
+func (ff *FeatureFlags) IsNativeSidecarInjection() bool {
+	return ff.getBoolWithDefault(InjectionNativeSidecarKey, false)
+}
+
+// IsEphemeralContainerInjection is a feature flag to inject the OneAgent into ephemeral containers that are added to injected pods, e.g. by kubectl debug.
+// The webhook only receives these updates if the webhook.mutatingWebhook.ephemeralContainers Helm value is enabled.
+func (ff *FeatureFlags) IsEphemeralContainerInjection() bool {
+	return ff.getBoolWithDefault(InjectionEphemeralContainersKey, false)
+}
+
+// IsInjectionReport is a feature flag to periodically report which running pods in the namespaces of the DynaKube are not injected
+// or injected with an outdated code modules version.
+func (ff *FeatureFlags) IsInjectionReport() bool {
+	return ff.getBoolWithDefault(InjectionReportKey, false)
+}
+
+// GetInjectionReportInterval is a feature flag to configure how often the injection report is updated, in minutes.
+func (ff *FeatureFlags) GetInjectionReportInterval() time.Duration {
+	interval := ff.getIntWithDefault(InjectionReportIntervalKey, DefaultInjectionReportIntervalMinutes)
+	if interval <= 0 {
+		interval = DefaultInjectionReportIntervalMinutes
+	}
+
+	return time.Duration(interval) * time.Minute
+}
+
 func (ff *FeatureFlags) GetInjectionFailurePolicy() string {
 	if ff.getRaw(InjectionFailurePolicyKey) == failPhrase {
 		return failPhrase
@@ -2,6 +2,7 @@ package exp
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -151,6 +152,105 @@ func TestIsLabelVersionDetection(t *testing.T) {
 	}
 }
 
+func TestIsTechnologyDetection(t *testing.T) {
+	type testCase struct {
+		title string
+		in    string
+		out   bool
+	}
+
+	cases := []testCase{
+		{
+			title: "default",
+			in:    "",
+			out:   false,
+		},
+		{
+			title: "overrule",
+			in:    "true",
+			out:   true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.title, func(t *testing.T) {
+			ff := FeatureFlags{annotations: map[string]string{
+				InjectionTechnologyDetectionKey: c.in,
+			}}
+
+			out := ff.IsTechnologyDetection()
+
+			assert.Equal(t, c.out, out)
+		})
+	}
+}
+
+func TestIsNativeSidecarInjection(t *testing.T) {
+	type testCase struct {
+		title string
+		in    string
+		out   bool
+	}
+
+	cases := []testCase{
+		{
+			title: "default",
+			in:    "",
+			out:   false,
+		},
+		{
+			title: "overrule",
+			in:    "true",
+			out:   true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.title, func(t *testing.T) {
+			ff := FeatureFlags{annotations: map[string]string{
+				InjectionNativeSidecarKey: c.in,
+			}}
+
+			out := ff.IsNativeSidecarInjection()
+
+			assert.Equal(t, c.out, out)
+		})
+	}
+}
+
+func TestIsEphemeralContainerInjection(t *testing.T) {
+	type testCase struct {
+		title string
+		in    string
+		out   bool
+	}
+
+	cases := []testCase{
+		{
+			title: "default",
+			in:    "",
+			out:   false,
+		},
+		{
+			title: "overrule",
+			in:    "true",
+			out:   true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.title, func(t *testing.T) {
+			ff := FeatureFlags{annotations: map[string]string{
+				InjectionEphemeralContainersKey: c.in,
+			}}
+
+			out := ff.IsEphemeralContainerInjection()
+
+			assert.Equal(t, c.out, out)
+		})
+	}
+}
+
 func TestHasInitSeccomp(t *testing.T) {
 	type testCase struct {
 		title string
@@ -183,3 +283,74 @@ func TestHasInitSeccomp(t *testing.T) {
 		})
 	}
 }
+
+func TestIsInjectionReport(t *testing.T) {
+	type testCase struct {
+		title string
+		in    string
+		out   bool
+	}
+
+	cases := []testCase{
+		{
+			title: "default",
+			in:    "",
+			out:   false,
+		},
+		{
+			title: "overrule",
+			in:    "true",
+			out:   true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.title, func(t *testing.T) {
+			ff := FeatureFlags{annotations: map[string]string{
+				InjectionReportKey: c.in,
+			}}
+
+			out := ff.IsInjectionReport()
+
+			assert.Equal(t, c.out, out)
+		})
+	}
+}
+
+func TestGetInjectionReportInterval(t *testing.T) {
+	type testCase struct {
+		title string
+		in    string
+		out   time.Duration
+	}
+
+	cases := []testCase{
+		{
+			title: "default",
+			in:    "",
+			out:   DefaultInjectionReportIntervalMinutes * time.Minute,
+		},
+		{
+			title: "incorrect input",
+			in:    "0",
+			out:   DefaultInjectionReportIntervalMinutes * time.Minute,
+		},
+		{
+			title: "overrule",
+			in:    "15",
+			out:   15 * time.Minute,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.title, func(t *testing.T) {
+			ff := FeatureFlags{annotations: map[string]string{
+				InjectionReportIntervalKey: c.in,
+			}}
+
+			out := ff.GetInjectionReportInterval()
+
+			assert.Equal(t, c.out, out)
+		})
+	}
+}
@@ -8,6 +8,7 @@ const (
 	OAInitialConnectRetryKey = FFPrefix + "oneagent-initial-connect-retry-ms"
 	OAPrivilegedKey          = FFPrefix + "oneagent-privileged"
 	OASkipLivenessProbeKey   = FFPrefix + "oneagent-skip-liveness-probe"
+	OANodeMaintenanceKey     = FFPrefix + "oneagent-node-maintenance-events"
 
 	OANodeImagePullKey = FFPrefix + "node-image-pull"
 	// OANodeImagePullTechnologiesKey can be set on a Pod or DynaKube to configure which code module technologies to download. It's set to
@@ -51,6 +52,11 @@ func (ff *FeatureFlags) SkipOneAgentLivenessProbe() bool {
 	return ff.getBoolWithDefault(OASkipLivenessProbeKey, false)
 }
 
+// IsOneAgentNodeMaintenanceEvents is a feature flag to send maintenance start/end events for the host entities of cordoned nodes.
+func (ff *FeatureFlags) IsOneAgentNodeMaintenanceEvents() bool {
+	return ff.getBoolWithDefault(OANodeMaintenanceKey, false)
+}
+
 func (ff *FeatureFlags) IsNodeImagePull() bool {
 	return ff.getBoolWithDefault(OANodeImagePullKey, false)
 }
@@ -251,3 +251,36 @@ func TestIsNodeImagePull(t *testing.T) {
 		})
 	}
 }
+
+func TestIsOneAgentNodeMaintenanceEvents(t *testing.T) {
+	type testCase struct {
+		title string
+		in    string
+		out   bool
+	}
+
+	cases := []testCase{
+		{
+			title: "default",
+			in:    "",
+			out:   false,
+		},
+		{
+			title: "overrule",
+			in:    "true",
+			out:   true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.title, func(t *testing.T) {
+			ff := FeatureFlags{annotations: map[string]string{
+				OANodeMaintenanceKey: c.in,
+			}}
+
+			out := ff.IsOneAgentNodeMaintenanceEvents()
+
+			assert.Equal(t, c.out, out)
+		})
+	}
+}
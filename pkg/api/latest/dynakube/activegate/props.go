@@ -13,8 +13,11 @@ import (
 const (
 	TenantSecretSuffix            = "-activegate-tenant-secret"
 	TLSSecretSuffix               = "-activegate-tls-secret"
+	CertManagerTLSSecretSuffix    = "-activegate-cert-manager-tls"
+	TLSKeystorePasswordSuffix     = "-activegate-tls-keystore-password"
 	ConnectionInfoConfigMapSuffix = "-activegate-connection-info"
 	AuthTokenSecretSuffix         = "-activegate-authtoken-secret"
+	ExternalServiceSuffix         = "-activegate-external"
 	DefaultImageRegistrySubPath   = "/linux/activegate"
 )
 
@@ -68,6 +71,70 @@ func (ag *Spec) GetReplicas() int32 {
 	return *ag.Replicas
 }
 
+// IsAutoscalingEnabled returns true when the ActiveGate replicas are managed by a HorizontalPodAutoscaler.
+func (ag *Spec) IsAutoscalingEnabled() bool {
+	return ag.Autoscaling != nil
+}
+
+func (ag *Spec) GetMinReplicas() int32 {
+	var defaultMinReplicas int32 = 1
+	if ag.Autoscaling == nil || ag.Autoscaling.MinReplicas == nil {
+		return defaultMinReplicas
+	}
+
+	return *ag.Autoscaling.MinReplicas
+}
+
+// IsLoadBalancerEnabled returns true when the ActiveGate is exposed via an additional Service of type LoadBalancer.
+func (ag *Spec) IsLoadBalancerEnabled() bool {
+	return ag.Exposure != nil && ag.Exposure.LoadBalancer != nil
+}
+
+// IsIngressEnabled returns true when the ActiveGate is exposed via an Ingress.
+func (ag *Spec) IsIngressEnabled() bool {
+	return ag.Exposure != nil && ag.Exposure.Ingress != nil
+}
+
+// IsGatewayRouteEnabled returns true when the ActiveGate is exposed via a Gateway API route.
+func (ag *Spec) IsGatewayRouteEnabled() bool {
+	return ag.Exposure != nil && ag.Exposure.Gateway != nil
+}
+
+// GetExternalServiceName returns the name of the LoadBalancer Service of the ActiveGate.
+func (ag *Spec) GetExternalServiceName() string {
+	return ag.name + ExternalServiceSuffix
+}
+
+// GetPassthroughHostnames returns the host names under which clients reach the HTTPS port of the ActiveGate directly,
+// so they have to be part of its certificate.
+func (ag *Spec) GetPassthroughHostnames() []string {
+	if !ag.IsGatewayRouteEnabled() || ag.Exposure.Gateway.GetRouteKind() != TLSRouteKind {
+		return nil
+	}
+
+	return ag.Exposure.Gateway.Hostnames
+}
+
+// GetRouteKind returns the kind of the Gateway API route, defaults to HTTPRoute.
+func (g *GatewaySpec) GetRouteKind() GatewayRouteKind {
+	if g.RouteKind == "" {
+		return HTTPRouteKind
+	}
+
+	return g.RouteKind
+}
+
+// GetPool returns the spec of the given pool, nil if no such pool is configured.
+func (ag *Spec) GetPool(name string) *PoolSpec {
+	for i := range ag.Pools {
+		if ag.Pools[i].Name == name {
+			return &ag.Pools[i]
+		}
+	}
+
+	return nil
+}
+
 func (ag *Spec) GetServiceAccountName() string {
 	return "dynatrace-activegate"
 }
@@ -124,6 +191,17 @@ func (ag *Spec) GetAutoTLSSecretName() string {
 	return ag.name + TLSSecretSuffix
 }
 
+// GetCertManagerTLSSecretName returns the name of the cert-manager Certificate and of the secret it is issued to.
+// The ActiveGate itself uses the automatically created AG TLS secret, which is filled from this secret.
+func (ag *Spec) GetCertManagerTLSSecretName() string {
+	return ag.name + CertManagerTLSSecretSuffix
+}
+
+// GetTLSKeystorePasswordSecretName returns the name of the secret holding the password of the PKCS12 keystore issued by cert-manager.
+func (ag *Spec) GetTLSKeystorePasswordSecretName() string {
+	return ag.name + TLSKeystorePasswordSuffix
+}
+
 func (ag *Spec) GetConnectionInfoConfigMapName() string {
 	return ag.name + ConnectionInfoConfigMapSuffix
 }
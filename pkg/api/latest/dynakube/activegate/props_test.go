@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSpec_IsMode(t *testing.T) {
@@ -52,3 +53,60 @@ func TestSpec_IsModeHelpers(t *testing.T) {
 		})
 	}
 }
+
+func TestSpec_Exposure(t *testing.T) {
+	t.Run("nothing exposed", func(t *testing.T) {
+		ag := &Spec{}
+
+		assert.False(t, ag.IsLoadBalancerEnabled())
+		assert.False(t, ag.IsIngressEnabled())
+		assert.False(t, ag.IsGatewayRouteEnabled())
+		assert.Empty(t, ag.GetPassthroughHostnames())
+	})
+	t.Run("everything exposed", func(t *testing.T) {
+		ag := &Spec{Exposure: &ExposureSpec{
+			LoadBalancer: &LoadBalancerSpec{},
+			Ingress:      &IngressSpec{Host: "ag.example.com"},
+			Gateway:      &GatewaySpec{Hostnames: []string{"ag.example.com"}},
+		}}
+
+		assert.True(t, ag.IsLoadBalancerEnabled())
+		assert.True(t, ag.IsIngressEnabled())
+		assert.True(t, ag.IsGatewayRouteEnabled())
+		assert.Equal(t, HTTPRouteKind, ag.Exposure.Gateway.GetRouteKind())
+		assert.Empty(t, ag.GetPassthroughHostnames())
+	})
+	t.Run("TLSRoute hostnames are passed through", func(t *testing.T) {
+		ag := &Spec{Exposure: &ExposureSpec{
+			Gateway: &GatewaySpec{RouteKind: TLSRouteKind, Hostnames: []string{"ag.example.com"}},
+		}}
+
+		assert.Equal(t, []string{"ag.example.com"}, ag.GetPassthroughHostnames())
+	})
+}
+
+func TestActiveGate_Pools(t *testing.T) {
+	ag := ActiveGate{
+		Spec: &Spec{
+			Pools: []PoolSpec{{Name: "routing"}, {Name: "api"}},
+		},
+		Status: &Status{
+			ServiceIPs: []string{"10.0.0.1"},
+			Pools: []PoolStatus{
+				{Name: "routing", ServiceIPs: []string{"10.0.0.2"}},
+				{Name: "api", ServiceIPs: []string{"10.0.0.3", "fd00::3"}},
+			},
+		},
+	}
+
+	require.NotNil(t, ag.GetPool("api"))
+	assert.Equal(t, "api", ag.GetPool("api").Name)
+	assert.Nil(t, ag.GetPool("missing"))
+
+	require.NotNil(t, ag.GetPoolStatus("routing"))
+	assert.Equal(t, []string{"10.0.0.2"}, ag.GetPoolStatus("routing").ServiceIPs)
+	assert.Nil(t, ag.GetPoolStatus("missing"))
+
+	assert.Equal(t, []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "fd00::3"}, ag.GetAllServiceIPs())
+	assert.Equal(t, []string{"10.0.0.1"}, ag.ServiceIPs)
+}
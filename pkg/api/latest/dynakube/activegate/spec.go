@@ -2,7 +2,9 @@ package activegate
 
 import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/image"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/pdb"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/value"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -108,6 +110,37 @@ type Spec struct {
 	// Activegate capabilities enabled (routing, kubernetes-monitoring, metrics-ingest, dynatrace-api)
 	Capabilities []CapabilityDisplayName `json:"capabilities,omitempty"`
 
+	// Enables horizontal autoscaling of the ActiveGate StatefulSet. If set, the replicas field is ignored.
+	// Pools without replicas are autoscaled with the same settings.
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Autoscaling",order=32,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:advanced","urn:alm:descriptor:com.tectonic.ui:hidden"}
+	Autoscaling *AutoscalingSpec `json:"autoscaling,omitempty"`
+
+	// Configures the PodDisruptionBudget of the ActiveGate pods. Defaults to maxUnavailable=1 if more than one replica is used.
+	// Every pool gets its own PodDisruptionBudget with the same settings.
+	// +kubebuilder:validation:Optional
+	PodDisruptionBudget *pdb.Spec `json:"podDisruptionBudget,omitempty"`
+
+	// Exposes the ActiveGate to clients outside of the cluster, via a LoadBalancer Service, an Ingress or a Gateway API route.
+	// Only the main ActiveGate is exposed, pools are only reachable inside the cluster via their own Service.
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Exposure",order=33,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:advanced","urn:alm:descriptor:com.tectonic.ui:hidden"}
+	Exposure *ExposureSpec `json:"exposure,omitempty"`
+
+	// Additional groups of ActiveGates, each rendered as a separate StatefulSet and Service with its own capabilities.
+	// Requires the capabilities of the main ActiveGate to be set, the image, custom properties and TLS settings are shared with it.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Pools",order=41,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:advanced","urn:alm:descriptor:com.tectonic.ui:hidden"}
+	Pools []PoolSpec `json:"pools,omitempty"`
+
+	// Typed configuration of common ActiveGate settings, validated by the webhook and rendered into the custom properties file.
+	// Takes precedence over the same settings in customProperties, both are merged otherwise.
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Properties",order=42,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:advanced","urn:alm:descriptor:com.tectonic.ui:hidden"}
+	Properties *PropertiesSpec `json:"properties,omitempty"`
+
 	enabledDependencies dependencies
 
 	automaticTLSCertificateEnabled bool
@@ -118,6 +151,256 @@ type Spec struct {
 
 // +kubebuilder:object:generate=true
 
+type AutoscalingSpec struct {
+
+	// Lower limit for the number of ActiveGate replicas. Defaults to 1.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// Upper limit for the number of ActiveGate replicas.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// Metrics used to calculate the desired replica count. Defaults to 80% average CPU utilization.
+	// Pods, object or external metrics (e.g. ActiveGate throughput) require a metrics adapter in the cluster.
+	// +kubebuilder:validation:Optional
+	Metrics []autoscalingv2.MetricSpec `json:"metrics,omitempty"`
+
+	// Configures the scaling behavior in both up and down directions. Kubernetes defaults apply if not set.
+	// +kubebuilder:validation:Optional
+	Behavior *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+type ExposureSpec struct {
+
+	// Creates an additional Service of type LoadBalancer for the HTTPS port of the ActiveGate.
+	// +kubebuilder:validation:Optional
+	LoadBalancer *LoadBalancerSpec `json:"loadBalancer,omitempty"`
+
+	// Creates an Ingress for the ActiveGate. TLS is terminated by the Ingress controller, traffic is forwarded to the HTTP port of the ActiveGate.
+	// +kubebuilder:validation:Optional
+	Ingress *IngressSpec `json:"ingress,omitempty"`
+
+	// Creates a Gateway API route that attaches the ActiveGate to existing Gateways. Requires the Gateway API CRDs to be installed.
+	// +kubebuilder:validation:Optional
+	Gateway *GatewaySpec `json:"gateway,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+type LoadBalancerSpec struct {
+
+	// Adds additional annotations to the Service, e.g. to configure the load balancer of the cloud provider.
+	// +kubebuilder:validation:Optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// The class of the load balancer implementation the Service belongs to.
+	// +kubebuilder:validation:Optional
+	LoadBalancerClass *string `json:"loadBalancerClass,omitempty"`
+
+	// Restricts the traffic through the load balancer to the given client IP ranges.
+	// +kubebuilder:validation:Optional
+	LoadBalancerSourceRanges []string `json:"loadBalancerSourceRanges,omitempty"`
+
+	// Describes how nodes distribute the external traffic. Kubernetes defaults apply if not set.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Cluster;Local
+	ExternalTrafficPolicy corev1.ServiceExternalTrafficPolicy `json:"externalTrafficPolicy,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+type IngressSpec struct {
+
+	// Adds additional annotations to the Ingress, e.g. to configure the Ingress controller.
+	// +kubebuilder:validation:Optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// The name of the IngressClass. The default IngressClass of the cluster is used if not set.
+	// +kubebuilder:validation:Optional
+	IngressClassName *string `json:"ingressClassName,omitempty"`
+
+	// The host name under which the ActiveGate is reachable.
+	// +kubebuilder:validation:Required
+	Host string `json:"host"`
+
+	// The name of a secret containing the TLS certificate for the host. The Ingress controller's default certificate is used if not set.
+	// +kubebuilder:validation:Optional
+	TLSSecretName string `json:"tlsSecretName,omitempty"`
+}
+
+type GatewayRouteKind string
+
+const (
+	HTTPRouteKind GatewayRouteKind = "HTTPRoute"
+	TLSRouteKind  GatewayRouteKind = "TLSRoute"
+)
+
+// +kubebuilder:object:generate=true
+
+type GatewaySpec struct {
+
+	// Adds additional annotations to the route.
+	// +kubebuilder:validation:Optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// The kind of the created route, defaults to HTTPRoute.
+	// HTTPRoute: TLS is terminated by the Gateway, traffic is forwarded to the HTTP port of the ActiveGate.
+	// TLSRoute: TLS is passed through to the HTTPS port of the ActiveGate, the hostnames are added to the ActiveGate certificate.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=HTTPRoute;TLSRoute
+	RouteKind GatewayRouteKind `json:"routeKind,omitempty"`
+
+	// The Gateways the route attaches to.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	ParentRefs []GatewayParentRef `json:"parentRefs"`
+
+	// The host names the route matches. Required for TLSRoutes.
+	// +kubebuilder:validation:Optional
+	Hostnames []string `json:"hostnames,omitempty"`
+}
+
+type GatewayParentRef struct {
+
+	// The name of the Gateway.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// The namespace of the Gateway, defaults to the namespace of the DynaKube.
+	// +kubebuilder:validation:Optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// The name of the Gateway listener to attach to. All listeners are used if not set.
+	// +kubebuilder:validation:Optional
+	SectionName string `json:"sectionName,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+type PoolSpec struct {
+
+	// The name of the pool, used as suffix for the names of its StatefulSet and Service.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=20
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Activegate capabilities enabled for the pool (routing, metrics-ingest, dynatrace-api, debugging)
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Capabilities []CapabilityDisplayName `json:"capabilities"`
+
+	// Set activation group for the ActiveGates of the pool
+	// +kubebuilder:validation:Optional
+	Group string `json:"group,omitempty"`
+
+	// Sets a network zone for the ActiveGates of the pool, defaults to the network zone of the DynaKube.
+	// +kubebuilder:validation:Optional
+	NetworkZone string `json:"networkZone,omitempty"`
+
+	// Amount of replicas of the pool. If not set, the pool is autoscaled like the main ActiveGate, if autoscaling is configured, otherwise it defaults to 1.
+	// +kubebuilder:validation:Optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Define resources requests and limits for single ActiveGate pods of the pool
+	// +kubebuilder:validation:Optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Node selector to control the selection of nodes
+	// +kubebuilder:validation:Optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Adds additional labels for the ActiveGate pods of the pool
+	// +kubebuilder:validation:Optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Set tolerations for the ActiveGate pods of the pool
+	// +kubebuilder:validation:Optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// List of environment variables to set for the ActiveGates of the pool
+	// +kubebuilder:validation:Optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// Adds TopologySpreadConstraints for the ActiveGate pods of the pool
+	// +kubebuilder:validation:Optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+type PropertiesSpec struct {
+	// Enables or disables modules of the ActiveGate, rendered as '<module>_enabled' into the 'collector' section.
+	// Example: {"aws_monitoring": false}
+	// +kubebuilder:validation:Optional
+	Collector map[string]bool `json:"collector,omitempty"`
+
+	// Proxy used by the ActiveGate for connections to external services, like cloud provider APIs, rendered into the 'http.client.external' section.
+	// +kubebuilder:validation:Optional
+	Proxy *ProxyPropertiesSpec `json:"proxy,omitempty"`
+
+	// Limits of the outgoing connections of the ActiveGate, rendered into the 'http.client' section.
+	// +kubebuilder:validation:Optional
+	Connections *ConnectionPropertiesSpec `json:"connections,omitempty"`
+
+	// Debug flags of the ActiveGate, rendered into the 'collector' section.
+	// +kubebuilder:validation:Optional
+	Debug *DebugPropertiesSpec `json:"debug,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+type ProxyPropertiesSpec struct {
+	// Host name or IP of the proxy server, without scheme and port.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Server string `json:"server"`
+
+	// Hosts that are connected to directly, bypassing the proxy.
+	// +kubebuilder:validation:Optional
+	NonProxyHosts []string `json:"nonProxyHosts,omitempty"`
+
+	// Port of the proxy server.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+}
+
+// +kubebuilder:object:generate=true
+
+type ConnectionPropertiesSpec struct {
+	// Maximum number of concurrent outgoing connections.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MaxConnections *int32 `json:"maxConnections,omitempty"`
+
+	// Timeout in seconds for establishing an outgoing connection.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	ConnectTimeoutSeconds *int32 `json:"connectTimeoutSeconds,omitempty"`
+
+	// Timeout in seconds for waiting on data of an established connection.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	SocketTimeoutSeconds *int32 `json:"socketTimeoutSeconds,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+type DebugPropertiesSpec struct {
+	// Allows the ActiveGate to create thread and memory dumps for support archives.
+	// +kubebuilder:validation:Optional
+	DumpSupported *bool `json:"dumpSupported,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
 // CapabilityProperties is a struct which can be embedded by ActiveGate capabilities
 // Such as KubernetesMonitoring or Routing
 // It encapsulates common properties.
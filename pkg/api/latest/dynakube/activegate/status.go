@@ -1,6 +1,8 @@
 package activegate
 
 import (
+	"slices"
+
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/communication"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/status"
 )
@@ -15,6 +17,43 @@ type Status struct {
 
 	// The ClusterIPs set by Kubernetes on the ActiveGate Service created by the Operator
 	ServiceIPs []string `json:"serviceIPs,omitempty"`
+
+	// Information about the self-signed TLS certificate created by the Operator
+	TLSCertificate *status.CertificateStatus `json:"tlsCertificate,omitempty"`
+
+	// Information about the ActiveGate pools created by the Operator
+	Pools []PoolStatus `json:"pools,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+type PoolStatus struct {
+	// The name of the pool
+	Name string `json:"name"`
+
+	// The ClusterIPs set by Kubernetes on the Service of the pool
+	ServiceIPs []string `json:"serviceIPs,omitempty"`
+}
+
+// GetPoolStatus returns the status of the given pool, nil if the pool was not created yet.
+func (ag *Status) GetPoolStatus(name string) *PoolStatus {
+	for i := range ag.Pools {
+		if ag.Pools[i].Name == name {
+			return &ag.Pools[i]
+		}
+	}
+
+	return nil
+}
+
+// GetAllServiceIPs returns the ClusterIPs of the Service of the main ActiveGate and of all pools.
+func (ag *Status) GetAllServiceIPs() []string {
+	ips := slices.Clone(ag.ServiceIPs)
+	for _, pool := range ag.Pools {
+		ips = append(ips, pool.ServiceIPs...)
+	}
+
+	return ips
 }
 
 // GetImage provides the image reference set in Status for the ActiveGate.
@@ -0,0 +1,8 @@
+package dynakube
+
+import "github.com/Dynatrace/dynatrace-operator/pkg/api/shared/certmanager"
+
+// CertManager returns the cert-manager configuration, it's nil if the certificates are self-signed.
+func (dk *DynaKube) CertManager() *certmanager.Spec {
+	return dk.Spec.CertManager
+}
@@ -13,7 +13,11 @@ import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/metadataenrichment"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/oneagent"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/otlp"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/sizing"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/telemetryingest"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/certmanager"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/networkpolicy"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/podtemplate"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/value"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -130,6 +134,22 @@ type DynaKubeSpec struct { //nolint:revive
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Trusted CAs",order=6,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:advanced","urn:alm:descriptor:io.kubernetes:ConfigMap"}
 	TrustedCAs string `json:"trustedCAs,omitempty"`
 
+	// Issues the TLS certificates of the ActiveGate and the extensions via cert-manager instead of self-signing them.
+	// Components that reference their own TLS secret (e.g. activeGate.tlsSecretName) are not affected.
+	// Note: cert-manager has to be installed in the cluster.
+	// +kubebuilder:validation:Optional
+	CertManager *certmanager.Spec `json:"certManager,omitempty"`
+
+	// Generates NetworkPolicies for the components managed by the operator, which allow the traffic they need.
+	// Meant for clusters that deny all traffic by default. The OneAgent pods use the host network and are not affected.
+	// +kubebuilder:validation:Optional
+	NetworkPolicies *networkpolicy.Spec `json:"networkPolicies,omitempty"`
+
+	// Sets tuned resource requests and limits for the ActiveGate, extensions, OpenTelemetry Collector, LogMonitoring, KSPM and the injected init container.
+	// The resources configured for a component itself still take precedence.
+	// +kubebuilder:validation:Optional
+	Sizing *sizing.Spec `json:"sizing,omitempty"`
+
 	// Sets a network zone for the OneAgent and ActiveGate pods.
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Network Zone",order=7,xDescriptors={"urn:alm:descriptor:com.tectonic.ui:advanced","urn:alm:descriptor:com.tectonic.ui:text"}
@@ -164,6 +184,12 @@ type DynaKubeSpec struct { //nolint:revive
 }
 
 type TemplatesSpec struct {
+	// Low-level configuration options for the ActiveGate pods, including the ones of its pools.
+	// +kubebuilder:validation:Optional
+	ActiveGate podtemplate.TemplateSpec `json:"activeGate,omitempty"`
+	// Low-level configuration options for the OneAgent pods.
+	// +kubebuilder:validation:Optional
+	OneAgent podtemplate.TemplateSpec `json:"oneAgent,omitempty"`
 	// Low-level configuration options for the LogMonitoring feature.
 	// +kubebuilder:validation:Optional
 	LogMonitoring *logmonitoring.TemplateSpec `json:"logMonitoring,omitempty"`
@@ -50,6 +50,12 @@ func (e *Extensions) GetSelfSignedTLSSecretName() string {
 	return e.name + consts.ExtensionsSelfSignedTLSSecretSuffix
 }
 
+// GetCertManagerTLSSecretName returns the name of the cert-manager Certificate and of the secret it is issued to.
+// The extensions use the self-signed TLS secret, which is filled from this secret.
+func (e *Extensions) GetCertManagerTLSSecretName() string {
+	return e.name + "-extension-controller-cert-manager-tls"
+}
+
 func (e *Extensions) GetExecutionControllerStatefulsetName() string {
 	return e.name + "-extension-controller"
 }
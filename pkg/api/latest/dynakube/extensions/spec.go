@@ -2,6 +2,8 @@ package extensions
 
 import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/image"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/pdb"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/podtemplate"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -76,6 +78,13 @@ type ExecutionControllerSpec struct {
 	// Selects EmptyDir volume to be storage device
 	// +kubebuilder:validation:Optional
 	UseEphemeralVolume bool `json:"useEphemeralVolume,omitempty"`
+
+	// Configures a PodDisruptionBudget for the ExtensionExecutionController pod. Not created unless set.
+	// +kubebuilder:validation:Optional
+	PodDisruptionBudget *pdb.Spec `json:"podDisruptionBudget,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	podtemplate.TemplateSpec `json:",inline"`
 }
 
 // +kubebuilder:object:generate=true
@@ -115,6 +124,10 @@ type DatabaseSpec struct {
 
 	// +kubebuilder:validation:Optional
 	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// Configures the PodDisruptionBudget of the database executor pods. Defaults to maxUnavailable=1 if more than one replica is used.
+	// +kubebuilder:validation:Optional
+	PodDisruptionBudget *pdb.Spec `json:"podDisruptionBudget,omitempty"`
 }
 
 // +kubebuilder:object:generate=true
@@ -125,4 +138,7 @@ type DatabaseExecutorSpec struct {
 
 	// +kubebuilder:validation:Optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	podtemplate.TemplateSpec `json:",inline"`
 }
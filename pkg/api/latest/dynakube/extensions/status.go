@@ -0,0 +1,12 @@
+package extensions
+
+import (
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/status"
+)
+
+// +kubebuilder:object:generate=true
+
+type Status struct {
+	// Information about the self-signed TLS certificate created by the Operator
+	TLSCertificate *status.CertificateStatus `json:"tlsCertificate,omitempty"`
+}
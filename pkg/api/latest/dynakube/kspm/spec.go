@@ -2,6 +2,7 @@ package kspm
 
 import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/image"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/podtemplate"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 )
@@ -80,4 +81,7 @@ type NodeConfigurationCollectorSpec struct {
 	// Set additional environment variables for the NodeConfigurationCollector pods
 	// +kubebuilder:validation:Optional
 	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	podtemplate.TemplateSpec `json:",inline"`
 }
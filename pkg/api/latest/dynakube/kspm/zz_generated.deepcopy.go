@@ -78,6 +78,7 @@ func (in *NodeConfigurationCollectorSpec) DeepCopyInto(out *NodeConfigurationCol
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.TemplateSpec.DeepCopyInto(&out.TemplateSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeConfigurationCollectorSpec.
@@ -2,6 +2,7 @@ package logmonitoring
 
 import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/image"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/podtemplate"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -65,4 +66,7 @@ type TemplateSpec struct {
 	// Set additional arguments to the LogMonitoring init container
 	// +kubebuilder:validation:Optional
 	Args []string `json:"args,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	podtemplate.TemplateSpec `json:",inline"`
 }
@@ -102,6 +102,7 @@ func (in *TemplateSpec) DeepCopyInto(out *TemplateSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	in.TemplateSpec.DeepCopyInto(&out.TemplateSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateSpec.
@@ -0,0 +1,8 @@
+package dynakube
+
+import "github.com/Dynatrace/dynatrace-operator/pkg/api/shared/networkpolicy"
+
+// NetworkPolicies returns the configuration of the generated NetworkPolicies, it's nil if none should be generated.
+func (dk *DynaKube) NetworkPolicies() *networkpolicy.Spec {
+	return dk.Spec.NetworkPolicies
+}
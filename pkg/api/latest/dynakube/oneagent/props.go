@@ -7,6 +7,7 @@ import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/api"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/dtversion"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/installconfig"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/wildcard"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -17,6 +18,8 @@ const (
 	PodNameOsAgent                        = "oneagent"
 	DefaultOneAgentImageRegistrySubPath   = "/linux/oneagent"
 	StorageVolumeDefaultHostPath          = "/var/opt/dynatrace"
+
+	DefaultRolloutMaxConcurrentRestarts = 1
 )
 
 func NewOneAgent(spec *Spec, status *Status, codeModulesStatus *CodeModulesStatus, //nolint:revive
@@ -133,6 +136,124 @@ func (oa *OneAgent) GetNamespaceSelector() *metav1.LabelSelector {
 	}
 }
 
+func (oa *OneAgent) getAppInjectionSpec() *AppInjectionSpec {
+	switch {
+	case oa.IsCloudNativeFullstackMode():
+		return &oa.CloudNativeFullStack.AppInjectionSpec
+	case oa.IsApplicationMonitoringMode():
+		return &oa.ApplicationMonitoring.AppInjectionSpec
+	default:
+		return nil
+	}
+}
+
+// GetContainerImages returns the allow and deny lists for the images of injected containers, or nil if none are configured.
+func (oa *OneAgent) GetContainerImages() *ContainerImagesSpec {
+	appInjectionSpec := oa.getAppInjectionSpec()
+	if appInjectionSpec == nil {
+		return nil
+	}
+
+	return appInjectionSpec.ContainerImages
+}
+
+// IsContainerImageExcluded checks if containers with the given image are excluded from the injection by the allow and deny lists.
+func (oa *OneAgent) IsContainerImageExcluded(image string) bool {
+	containerImages := oa.GetContainerImages()
+	if containerImages == nil {
+		return false
+	}
+
+	if wildcard.MatchesAny(containerImages.Deny, image) {
+		return true
+	}
+
+	return len(containerImages.Allow) > 0 && !wildcard.MatchesAny(containerImages.Allow, image)
+}
+
+// IsRolloutEnabled checks if workloads with pods that were not handled by the webhook are restarted.
+func (oa *OneAgent) IsRolloutEnabled() bool {
+	appInjectionSpec := oa.getAppInjectionSpec()
+
+	return appInjectionSpec != nil && appInjectionSpec.Rollout != nil
+}
+
+// IsRolloutExcluded checks if the workload is excluded from restarts by the allow and deny lists.
+func (oa *OneAgent) IsRolloutExcluded(namespace, name string) bool {
+	if !oa.IsRolloutEnabled() {
+		return true
+	}
+
+	rollout := oa.getAppInjectionSpec().Rollout
+	workload := namespace + "/" + name
+
+	if wildcard.MatchesAny(rollout.Deny, workload) {
+		return true
+	}
+
+	return len(rollout.Allow) > 0 && !wildcard.MatchesAny(rollout.Allow, workload)
+}
+
+func (oa *OneAgent) GetRolloutMaxConcurrentRestarts() int {
+	if !oa.IsRolloutEnabled() || oa.getAppInjectionSpec().Rollout.MaxConcurrentRestarts == nil {
+		return DefaultRolloutMaxConcurrentRestarts
+	}
+
+	return int(*oa.getAppInjectionSpec().Rollout.MaxConcurrentRestarts)
+}
+
+// GetResourceOverhead returns the overhead added to the injected containers of a pod with the given technologies.
+// The highest overhead of the configured technologies is used, if none of them is configured the default is used.
+func (oa *OneAgent) GetResourceOverhead(technologies []string) *corev1.ResourceRequirements {
+	appInjectionSpec := oa.getAppInjectionSpec()
+	if appInjectionSpec == nil || appInjectionSpec.ResourceOverhead == nil {
+		return nil
+	}
+
+	overheadSpec := appInjectionSpec.ResourceOverhead
+
+	var overhead *corev1.ResourceRequirements
+
+	for _, technology := range technologies {
+		technologyOverhead, ok := overheadSpec.Technologies[technology]
+		if !ok {
+			continue
+		}
+
+		if overhead == nil {
+			overhead = &corev1.ResourceRequirements{}
+		}
+
+		overhead.Requests = maxResourceList(overhead.Requests, technologyOverhead.Requests)
+		overhead.Limits = maxResourceList(overhead.Limits, technologyOverhead.Limits)
+	}
+
+	if overhead == nil {
+		return overheadSpec.Default
+	}
+
+	return overhead
+}
+
+func maxResourceList(current, other corev1.ResourceList) corev1.ResourceList {
+	if len(other) == 0 {
+		return current
+	}
+
+	result := current.DeepCopy()
+	if result == nil {
+		result = corev1.ResourceList{}
+	}
+
+	for name, quantity := range other {
+		if existing, ok := result[name]; !ok || quantity.Cmp(existing) > 0 {
+			result[name] = quantity.DeepCopy()
+		}
+	}
+
+	return result
+}
+
 func (oa *OneAgent) GetSecCompProfile() string {
 	switch {
 	case oa.IsCloudNativeFullstackMode():
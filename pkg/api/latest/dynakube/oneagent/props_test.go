@@ -22,9 +22,11 @@ import (
 
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/status"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/installconfig"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8sresource"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
 )
 
 const testAPIURL = "http://test-endpoint/api"
@@ -532,3 +534,104 @@ func TestOneAgent_IsAutoUpdateEnabled(t *testing.T) {
 		assert.Equal(t, tc.autoUpdateEnabled, oa.IsAutoUpdateEnabled(), tc.name)
 	})
 }
+
+func TestIsContainerImageExcluded(t *testing.T) {
+	containerImages := &ContainerImagesSpec{
+		Allow: []string{"registry.example.com/*"},
+		Deny:  []string{"*/envoyproxy/*"},
+	}
+
+	t.Run("not configured", func(t *testing.T) {
+		oa := NewOneAgent(&Spec{CloudNativeFullStack: &CloudNativeFullStackSpec{}}, nil, nil, "", "", false, false, false)
+
+		assert.False(t, oa.IsContainerImageExcluded("docker.io/envoyproxy/envoy:v1"))
+	})
+
+	t.Run("allow and deny list", func(t *testing.T) {
+		oa := NewOneAgent(&Spec{CloudNativeFullStack: &CloudNativeFullStackSpec{AppInjectionSpec: AppInjectionSpec{ContainerImages: containerImages}}}, nil, nil, "", "", false, false, false)
+
+		assert.False(t, oa.IsContainerImageExcluded("registry.example.com/shop:1.0"))
+		assert.True(t, oa.IsContainerImageExcluded("registry.example.com/envoyproxy/envoy:v1"))
+		assert.True(t, oa.IsContainerImageExcluded("docker.io/library/nginx:1.27"))
+	})
+
+	t.Run("no app injection", func(t *testing.T) {
+		oa := NewOneAgent(&Spec{HostMonitoring: &HostInjectSpec{}}, nil, nil, "", "", false, false, false)
+
+		assert.False(t, oa.IsContainerImageExcluded("docker.io/envoyproxy/envoy:v1"))
+	})
+}
+
+func TestRollout(t *testing.T) {
+	t.Run("not configured", func(t *testing.T) {
+		oa := NewOneAgent(&Spec{CloudNativeFullStack: &CloudNativeFullStackSpec{}}, nil, nil, "", "", false, false, false)
+
+		assert.False(t, oa.IsRolloutEnabled())
+		assert.True(t, oa.IsRolloutExcluded("shop", "frontend"))
+		assert.Equal(t, DefaultRolloutMaxConcurrentRestarts, oa.GetRolloutMaxConcurrentRestarts())
+	})
+
+	t.Run("allow and deny list", func(t *testing.T) {
+		rollout := &RolloutSpec{
+			Allow:                 []string{"shop/*", "payment/checkout"},
+			Deny:                  []string{"*/database"},
+			MaxConcurrentRestarts: ptr.To(int32(3)),
+		}
+		oa := NewOneAgent(&Spec{ApplicationMonitoring: &ApplicationMonitoringSpec{AppInjectionSpec: AppInjectionSpec{Rollout: rollout}}}, nil, nil, "", "", false, false, false)
+
+		assert.True(t, oa.IsRolloutEnabled())
+		assert.False(t, oa.IsRolloutExcluded("shop", "frontend"))
+		assert.False(t, oa.IsRolloutExcluded("payment", "checkout"))
+		assert.True(t, oa.IsRolloutExcluded("shop", "database"))
+		assert.True(t, oa.IsRolloutExcluded("payment", "fraud-detection"))
+		assert.Equal(t, 3, oa.GetRolloutMaxConcurrentRestarts())
+	})
+
+	t.Run("no app injection", func(t *testing.T) {
+		oa := NewOneAgent(&Spec{ClassicFullStack: &HostInjectSpec{}}, nil, nil, "", "", false, false, false)
+
+		assert.False(t, oa.IsRolloutEnabled())
+	})
+}
+
+func TestGetResourceOverhead(t *testing.T) {
+	overheadSpec := &ResourceOverheadSpec{
+		Default: &corev1.ResourceRequirements{Requests: k8sresource.NewResourceList("10m", "50Mi")},
+		Technologies: map[string]corev1.ResourceRequirements{
+			"java": {
+				Requests: k8sresource.NewResourceList("20m", "100Mi"),
+				Limits:   k8sresource.NewResourceList("100m", "200Mi"),
+			},
+			"nodejs": {
+				Requests: k8sresource.NewResourceList("50m", "60Mi"),
+			},
+		},
+	}
+	oa := NewOneAgent(&Spec{CloudNativeFullStack: &CloudNativeFullStackSpec{AppInjectionSpec: AppInjectionSpec{ResourceOverhead: overheadSpec}}}, nil, nil, "", "", false, false, false)
+
+	t.Run("not configured", func(t *testing.T) {
+		oa := NewOneAgent(&Spec{CloudNativeFullStack: &CloudNativeFullStackSpec{}}, nil, nil, "", "", false, false, false)
+
+		assert.Nil(t, oa.GetResourceOverhead([]string{"java"}))
+	})
+
+	t.Run("technology", func(t *testing.T) {
+		overhead := oa.GetResourceOverhead([]string{"java"})
+
+		require.NotNil(t, overhead)
+		assert.Equal(t, overheadSpec.Technologies["java"], *overhead)
+	})
+
+	t.Run("highest overhead of the technologies", func(t *testing.T) {
+		overhead := oa.GetResourceOverhead([]string{"java", "nodejs", "php"})
+
+		require.NotNil(t, overhead)
+		assert.Equal(t, k8sresource.NewResourceList("50m", "100Mi"), overhead.Requests)
+		assert.Equal(t, k8sresource.NewResourceList("100m", "200Mi"), overhead.Limits)
+	})
+
+	t.Run("unknown technologies => default", func(t *testing.T) {
+		assert.Equal(t, overheadSpec.Default, oa.GetResourceOverhead([]string{"all"}))
+		assert.Equal(t, overheadSpec.Default, oa.GetResourceOverhead(nil))
+	})
+}
@@ -170,6 +170,65 @@ type AppInjectionSpec struct {
 	// For more information, see Configure monitoring for namespaces and pods (https://www.dynatrace.com/support/help/setup-and-configuration/setup-on-container-platforms/kubernetes/get-started-with-kubernetes-monitoring/dto-config-options-k8s#annotate).
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Namespace Selector",order=17,xDescriptors="urn:alm:descriptor:com.tectonic.ui:selector:core:v1:Namespace"
 	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// Controls which containers the OneAgent is injected into, based on their images.
+	// +kubebuilder:validation:Optional
+	ContainerImages *ContainerImagesSpec `json:"containerImages,omitempty"`
+
+	// Enables rolling restarts of the Deployments, StatefulSets and DaemonSets in the monitored namespaces, whose pods were created before the injection was configured.
+	// +kubebuilder:validation:Optional
+	Rollout *RolloutSpec `json:"rollout,omitempty"`
+
+	// Adds the resource overhead of the code modules to the requests and limits of the injected containers, so it is considered by the scheduler.
+	// Containers without requests get the overhead as requests, limits are only increased if they are set.
+	// +kubebuilder:validation:Optional
+	ResourceOverhead *ResourceOverheadSpec `json:"resourceOverhead,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+type ContainerImagesSpec struct {
+	// Image patterns of the containers that are injected, '*' can be used as a wildcard.
+	// If set, containers with other images are not injected.
+	// +kubebuilder:validation:Optional
+	Allow []string `json:"allow,omitempty"`
+
+	// Image patterns of the containers that are never injected, '*' can be used as a wildcard, for example */istio/proxyv2* or */envoyproxy/*.
+	// Takes precedence over allow.
+	// +kubebuilder:validation:Optional
+	Deny []string `json:"deny,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+type RolloutSpec struct {
+	// Workloads that are restarted, in the format <namespace>/<name>, '*' can be used as a wildcard.
+	// If set, other workloads are not restarted.
+	// +kubebuilder:validation:Optional
+	Allow []string `json:"allow,omitempty"`
+
+	// Workloads that are never restarted, in the format <namespace>/<name>, '*' can be used as a wildcard.
+	// Takes precedence over allow.
+	// +kubebuilder:validation:Optional
+	Deny []string `json:"deny,omitempty"`
+
+	// Maximum number of workloads that are restarted at the same time, a restart is finished once the rollout of the workload is complete. Defaults to 1.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MaxConcurrentRestarts *int32 `json:"maxConcurrentRestarts,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+
+type ResourceOverheadSpec struct {
+	// Overhead added to the injected containers, if no overhead is configured for the technologies of the pod.
+	// +kubebuilder:validation:Optional
+	Default *corev1.ResourceRequirements `json:"default,omitempty"`
+
+	// Overhead per technology of the code modules, e.g. java or nodejs.
+	// If a pod uses multiple technologies, the highest overhead of them is added.
+	// +kubebuilder:validation:Optional
+	Technologies map[string]corev1.ResourceRequirements `json:"technologies,omitempty"`
 }
 
 // +kubebuilder:object:generate=true
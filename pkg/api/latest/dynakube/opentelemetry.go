@@ -2,6 +2,8 @@ package dynakube
 
 import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/image"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/pdb"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/podtemplate"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -37,4 +39,11 @@ type OpenTelemetryCollectorSpec struct {
 	// Adds TopologySpreadConstraints for the OtelCollector pods
 	// +kubebuilder:validation:Optional
 	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// Configures the PodDisruptionBudget of the OtelCollector pods. Defaults to maxUnavailable=1 if more than one replica is used.
+	// +kubebuilder:validation:Optional
+	PodDisruptionBudget *pdb.Spec `json:"podDisruptionBudget,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	podtemplate.TemplateSpec `json:",inline"`
 }
@@ -0,0 +1,43 @@
+package sizing
+
+import (
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8sresource"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var profiles = map[Profile]Resources{
+	SmallProfile: {
+		ActiveGate:                   newResources("250m", "512Mi", "1", "1Gi"),
+		ExtensionExecutionController: newResources("100m", "256Mi", "500m", "512Mi"),
+		SQLExtensionExecutor:         newResources("250m", "256Mi", "500m", "512Mi"),
+		OpenTelemetryCollector:       newResources("100m", "256Mi", "500m", "512Mi"),
+		LogMonitoring:                newResources("100m", "128Mi", "300m", "256Mi"),
+		KSPM:                         newResources("50m", "64Mi", "100m", "128Mi"),
+		InitContainer:                newResources("30m", "30Mi", "100m", "60Mi"),
+	},
+	MediumProfile: {
+		ActiveGate:                   newResources("500m", "1Gi", "2", "2Gi"),
+		ExtensionExecutionController: newResources("250m", "512Mi", "1", "1Gi"),
+		SQLExtensionExecutor:         newResources("500m", "512Mi", "1", "1Gi"),
+		OpenTelemetryCollector:       newResources("250m", "512Mi", "1", "1Gi"),
+		LogMonitoring:                newResources("150m", "256Mi", "500m", "512Mi"),
+		KSPM:                         newResources("100m", "128Mi", "200m", "256Mi"),
+		InitContainer:                newResources("50m", "50Mi", "200m", "100Mi"),
+	},
+	LargeProfile: {
+		ActiveGate:                   newResources("1", "2Gi", "4", "4Gi"),
+		ExtensionExecutionController: newResources("500m", "1Gi", "2", "2Gi"),
+		SQLExtensionExecutor:         newResources("1", "1Gi", "2", "2Gi"),
+		OpenTelemetryCollector:       newResources("500m", "1Gi", "2", "2Gi"),
+		LogMonitoring:                newResources("300m", "512Mi", "1", "1Gi"),
+		KSPM:                         newResources("200m", "256Mi", "500m", "512Mi"),
+		InitContainer:                newResources("100m", "100Mi", "500m", "200Mi"),
+	},
+}
+
+func newResources(requestedCPU, requestedMemory, cpuLimit, memoryLimit string) *corev1.ResourceRequirements {
+	return &corev1.ResourceRequirements{
+		Requests: k8sresource.NewResourceList(requestedCPU, requestedMemory),
+		Limits:   k8sresource.NewResourceList(cpuLimit, memoryLimit),
+	}
+}
@@ -0,0 +1,63 @@
+package sizing
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// GetResources returns the resources of the components for the configured profile.
+// Components without resources in the profile keep their built-in defaults.
+func (s *Spec) GetResources() *Resources {
+	if s == nil {
+		return &Resources{}
+	}
+
+	if s.Profile == CustomProfile {
+		if s.Custom == nil {
+			return &Resources{}
+		}
+
+		return s.Custom.DeepCopy()
+	}
+
+	resources, ok := profiles[s.Profile]
+	if !ok {
+		return &Resources{}
+	}
+
+	return resources.DeepCopy()
+}
+
+// Merge returns the resources configured for a component, where the requests or limits that are not set are taken from the profile.
+// The values taken from the profile are adjusted to the ones of the component, so the requests never exceed the limits.
+func Merge(component corev1.ResourceRequirements, profile *corev1.ResourceRequirements) corev1.ResourceRequirements {
+	if profile == nil {
+		return component
+	}
+
+	merged := *component.DeepCopy()
+
+	switch {
+	case merged.Requests == nil && merged.Limits == nil:
+		return *profile.DeepCopy()
+	case merged.Requests == nil:
+		merged.Requests = adjust(profile.Requests, merged.Limits, func(request, limit resource.Quantity) bool { return request.Cmp(limit) > 0 })
+	case merged.Limits == nil:
+		merged.Limits = adjust(profile.Limits, merged.Requests, func(limit, request resource.Quantity) bool { return limit.Cmp(request) < 0 })
+	}
+
+	return merged
+}
+
+// adjust returns a copy of the resources of the profile, where the ones that conflict with the resources of the component are replaced by those.
+func adjust(profile, component corev1.ResourceList, conflicts func(profile, component resource.Quantity) bool) corev1.ResourceList {
+	adjusted := profile.DeepCopy()
+
+	for name, quantity := range adjusted {
+		if componentQuantity, ok := component[name]; ok && conflicts(quantity, componentQuantity) {
+			adjusted[name] = componentQuantity.DeepCopy()
+		}
+	}
+
+	return adjusted
+}
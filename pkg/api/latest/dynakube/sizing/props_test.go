@@ -0,0 +1,96 @@
+package sizing
+
+import (
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8sresource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestGetResources(t *testing.T) {
+	t.Run("no profile", func(t *testing.T) {
+		var notConfigured *Spec
+
+		assert.Equal(t, &Resources{}, notConfigured.GetResources())
+	})
+
+	t.Run("predefined profiles", func(t *testing.T) {
+		for _, profile := range []Profile{SmallProfile, MediumProfile, LargeProfile} {
+			resources := (&Spec{Profile: profile}).GetResources()
+
+			require.NotNil(t, resources.ActiveGate, profile)
+			require.NotNil(t, resources.ExtensionExecutionController, profile)
+			require.NotNil(t, resources.SQLExtensionExecutor, profile)
+			require.NotNil(t, resources.OpenTelemetryCollector, profile)
+			require.NotNil(t, resources.LogMonitoring, profile)
+			require.NotNil(t, resources.KSPM, profile)
+			require.NotNil(t, resources.InitContainer, profile)
+		}
+	})
+
+	t.Run("profiles are not modified by callers", func(t *testing.T) {
+		spec := &Spec{Profile: SmallProfile}
+		spec.GetResources().ActiveGate.Limits[corev1.ResourceCPU] = *k8sresource.NewQuantity("42")
+
+		assert.Equal(t, *k8sresource.NewQuantity("1"), spec.GetResources().ActiveGate.Limits[corev1.ResourceCPU])
+	})
+
+	t.Run("custom profile", func(t *testing.T) {
+		custom := &Resources{KSPM: &corev1.ResourceRequirements{Requests: k8sresource.NewResourceList("1", "1Gi")}}
+
+		assert.Equal(t, custom, (&Spec{Profile: CustomProfile, Custom: custom}).GetResources())
+		assert.Equal(t, &Resources{}, (&Spec{Profile: CustomProfile}).GetResources())
+	})
+}
+
+func TestMerge(t *testing.T) {
+	profile := &corev1.ResourceRequirements{
+		Requests: k8sresource.NewResourceList("1", "1Gi"),
+		Limits:   k8sresource.NewResourceList("2", "2Gi"),
+	}
+
+	t.Run("no profile", func(t *testing.T) {
+		component := corev1.ResourceRequirements{Requests: k8sresource.NewResourceList("3", "3Gi")}
+
+		assert.Equal(t, component, Merge(component, nil))
+	})
+
+	t.Run("profile used if component has no resources", func(t *testing.T) {
+		assert.Equal(t, *profile, Merge(corev1.ResourceRequirements{}, profile))
+	})
+
+	t.Run("resources of the component take precedence", func(t *testing.T) {
+		component := corev1.ResourceRequirements{Requests: k8sresource.NewResourceList("500m", "512Mi")}
+
+		merged := Merge(component, profile)
+
+		assert.Equal(t, component.Requests, merged.Requests)
+		assert.Equal(t, profile.Limits, merged.Limits)
+	})
+
+	t.Run("requests of the profile don't exceed the limits of the component", func(t *testing.T) {
+		component := corev1.ResourceRequirements{Limits: k8sresource.NewResourceList("500m", "4Gi")}
+
+		merged := Merge(component, profile)
+
+		assert.Equal(t, component.Limits, merged.Limits)
+		assert.Equal(t, k8sresource.NewResourceList("500m", "1Gi"), merged.Requests)
+	})
+
+	t.Run("limits of the profile are raised to the requests of the component", func(t *testing.T) {
+		component := corev1.ResourceRequirements{Requests: k8sresource.NewResourceList("3", "1Gi")}
+
+		merged := Merge(component, profile)
+
+		assert.Equal(t, component.Requests, merged.Requests)
+		assert.Equal(t, k8sresource.NewResourceList("3", "2Gi"), merged.Limits)
+	})
+
+	t.Run("profile is not modified", func(t *testing.T) {
+		Merge(corev1.ResourceRequirements{Limits: k8sresource.NewResourceList("500m", "4Gi")}, profile)
+
+		assert.Equal(t, k8sresource.NewResourceList("1", "1Gi"), profile.Requests)
+	})
+}
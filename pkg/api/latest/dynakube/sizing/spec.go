@@ -0,0 +1,59 @@
+package sizing
+
+import corev1 "k8s.io/api/core/v1"
+
+type Profile string
+
+const (
+	SmallProfile  Profile = "small"
+	MediumProfile Profile = "medium"
+	LargeProfile  Profile = "large"
+	CustomProfile Profile = "custom"
+)
+
+// +kubebuilder:object:generate=true
+
+type Spec struct {
+	// Custom resources of the components, used with the custom profile.
+	// Components that are not listed keep their built-in defaults.
+	// +kubebuilder:validation:Optional
+	Custom *Resources `json:"custom,omitempty"`
+
+	// The sizing profile, which sets tuned resource requests and limits for the components.
+	// The resources configured for a component itself still take precedence.
+	// +kubebuilder:validation:Enum=small;medium;large;custom
+	// +kubebuilder:validation:Required
+	Profile Profile `json:"profile"`
+}
+
+// +kubebuilder:object:generate=true
+
+type Resources struct {
+	// Resources of the ActiveGate and its pools.
+	// +kubebuilder:validation:Optional
+	ActiveGate *corev1.ResourceRequirements `json:"activeGate,omitempty"`
+
+	// Resources of the Extension Execution Controller.
+	// +kubebuilder:validation:Optional
+	ExtensionExecutionController *corev1.ResourceRequirements `json:"extensionExecutionController,omitempty"`
+
+	// Resources of the SQL extension executors.
+	// +kubebuilder:validation:Optional
+	SQLExtensionExecutor *corev1.ResourceRequirements `json:"sqlExtensionExecutor,omitempty"`
+
+	// Resources of the OpenTelemetry Collector.
+	// +kubebuilder:validation:Optional
+	OpenTelemetryCollector *corev1.ResourceRequirements `json:"otelCollector,omitempty"`
+
+	// Resources of the LogMonitoring pods.
+	// +kubebuilder:validation:Optional
+	LogMonitoring *corev1.ResourceRequirements `json:"logMonitoring,omitempty"`
+
+	// Resources of the KSPM Node Configuration Collector.
+	// +kubebuilder:validation:Optional
+	KSPM *corev1.ResourceRequirements `json:"kspm,omitempty"`
+
+	// Resources of the init container injected into the monitored pods, if the CSI driver provides the code modules.
+	// +kubebuilder:validation:Optional
+	InitContainer *corev1.ResourceRequirements `json:"initContainer,omitempty"`
+}
@@ -0,0 +1,8 @@
+package dynakube
+
+import "github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/sizing"
+
+// Sizing returns the resources of the configured sizing profile, without a profile all resources are nil.
+func (dk *DynaKube) Sizing() *sizing.Resources {
+	return dk.Spec.Sizing.GetResources()
+}
@@ -0,0 +1,27 @@
+package certmanager
+
+const (
+	DefaultIssuerKind  = "Issuer"
+	DefaultIssuerGroup = "cert-manager.io"
+)
+
+// IsEnabled returns true if the certificates should be issued by cert-manager instead of being self-signed.
+func (s *Spec) IsEnabled() bool {
+	return s != nil && s.IssuerRef.Name != ""
+}
+
+func (ref IssuerRef) GetKind() string {
+	if ref.Kind == "" {
+		return DefaultIssuerKind
+	}
+
+	return ref.Kind
+}
+
+func (ref IssuerRef) GetGroup() string {
+	if ref.Group == "" {
+		return DefaultIssuerGroup
+	}
+
+	return ref.Group
+}
@@ -0,0 +1,30 @@
+package certmanager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsEnabled(t *testing.T) {
+	var notConfigured *Spec
+
+	assert.False(t, notConfigured.IsEnabled())
+	assert.False(t, (&Spec{}).IsEnabled())
+	assert.True(t, (&Spec{IssuerRef: IssuerRef{Name: "issuer"}}).IsEnabled())
+}
+
+func TestIssuerRef(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		ref := IssuerRef{Name: "issuer"}
+
+		assert.Equal(t, DefaultIssuerKind, ref.GetKind())
+		assert.Equal(t, DefaultIssuerGroup, ref.GetGroup())
+	})
+	t.Run("configured", func(t *testing.T) {
+		ref := IssuerRef{Name: "issuer", Kind: "ClusterIssuer", Group: "awspca.cert-manager.io"}
+
+		assert.Equal(t, "ClusterIssuer", ref.GetKind())
+		assert.Equal(t, "awspca.cert-manager.io", ref.GetGroup())
+	})
+}
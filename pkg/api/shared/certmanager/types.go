@@ -0,0 +1,25 @@
+package certmanager
+
+// +kubebuilder:object:generate=true
+
+type Spec struct {
+	// Reference to the cert-manager Issuer or ClusterIssuer that signs the certificates.
+	// +kubebuilder:validation:Required
+	IssuerRef IssuerRef `json:"issuerRef"`
+}
+
+// +kubebuilder:object:generate=true
+
+type IssuerRef struct {
+	// Name of the issuer.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Kind of the issuer, e.g. Issuer or ClusterIssuer. An Issuer has to be in the same namespace as the certificate. Defaults to Issuer.
+	// +kubebuilder:validation:Optional
+	Kind string `json:"kind,omitempty"`
+
+	// Group of the issuer, only needs to be set for external issuers. Defaults to cert-manager.io.
+	// +kubebuilder:validation:Optional
+	Group string `json:"group,omitempty"`
+}
@@ -0,0 +1,11 @@
+package networkpolicy
+
+// IsEnabled returns true if NetworkPolicies should be generated, which is opt-in by configuring the section.
+func (s *Spec) IsEnabled() bool {
+	return s != nil
+}
+
+// IsCiliumEnabled returns true if CiliumNetworkPolicies should be generated in addition to the NetworkPolicies.
+func (s *Spec) IsCiliumEnabled() bool {
+	return s != nil && s.Cilium
+}
@@ -0,0 +1,11 @@
+package networkpolicy
+
+// +kubebuilder:object:generate=true
+
+type Spec struct {
+	// Additionally generates CiliumNetworkPolicies, which allow the egress to the Dynatrace environment and the Kubernetes API by host name and entity.
+	// The NetworkPolicies then no longer allow this egress to any IP, as they can't restrict it to host names.
+	// Note: Cilium has to be installed in the cluster.
+	// +kubebuilder:validation:Optional
+	Cilium bool `json:"cilium,omitempty"`
+}
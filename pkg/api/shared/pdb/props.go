@@ -0,0 +1,35 @@
+package pdb
+
+import (
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+const defaultMaxUnavailable = 1
+
+// IsEnabled returns true if a PodDisruptionBudget is needed for a workload with the given amount of replicas.
+// This is the case if it is configured explicitly or if the workload runs more than one replica.
+func (s *Spec) IsEnabled(replicas int32) bool {
+	return s != nil || replicas > 1
+}
+
+func (s *Spec) GetMinAvailable() *intstr.IntOrString {
+	if s == nil {
+		return nil
+	}
+
+	return s.MinAvailable
+}
+
+func (s *Spec) GetMaxUnavailable() *intstr.IntOrString {
+	if s == nil || (s.MinAvailable == nil && s.MaxUnavailable == nil) {
+		return ptr.To(intstr.FromInt32(defaultMaxUnavailable))
+	}
+
+	return s.MaxUnavailable
+}
+
+// IsConflicting returns true if both minAvailable and maxUnavailable are set, which is rejected by Kubernetes.
+func (s *Spec) IsConflicting() bool {
+	return s != nil && s.MinAvailable != nil && s.MaxUnavailable != nil
+}
@@ -0,0 +1,39 @@
+package pdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+func TestIsEnabled(t *testing.T) {
+	var notConfigured *Spec
+
+	assert.False(t, notConfigured.IsEnabled(1))
+	assert.True(t, notConfigured.IsEnabled(2))
+	assert.True(t, (&Spec{}).IsEnabled(1))
+}
+
+func TestBudget(t *testing.T) {
+	t.Run("default to maxUnavailable 1", func(t *testing.T) {
+		var notConfigured *Spec
+
+		assert.Nil(t, notConfigured.GetMinAvailable())
+		assert.Equal(t, intstr.FromInt32(1), *notConfigured.GetMaxUnavailable())
+		assert.Equal(t, intstr.FromInt32(1), *(&Spec{}).GetMaxUnavailable())
+	})
+	t.Run("minAvailable set", func(t *testing.T) {
+		spec := &Spec{MinAvailable: ptr.To(intstr.FromString("50%"))}
+
+		assert.Equal(t, intstr.FromString("50%"), *spec.GetMinAvailable())
+		assert.Nil(t, spec.GetMaxUnavailable())
+		assert.False(t, spec.IsConflicting())
+	})
+	t.Run("both set", func(t *testing.T) {
+		spec := &Spec{MinAvailable: ptr.To(intstr.FromInt32(1)), MaxUnavailable: ptr.To(intstr.FromInt32(1))}
+
+		assert.True(t, spec.IsConflicting())
+	})
+}
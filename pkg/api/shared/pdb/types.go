@@ -0,0 +1,17 @@
+package pdb
+
+import "k8s.io/apimachinery/pkg/util/intstr"
+
+// +kubebuilder:object:generate=true
+
+type Spec struct {
+	// Minimum number or percentage of pods that must remain available during a voluntary disruption, e.g. a node drain.
+	// Mutually exclusive with maxUnavailable.
+	// +kubebuilder:validation:Optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+
+	// Maximum number or percentage of pods that can be unavailable during a voluntary disruption, e.g. a node drain.
+	// Mutually exclusive with minAvailable. Defaults to 1 if neither is set.
+	// +kubebuilder:validation:Optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
@@ -0,0 +1,137 @@
+package podtemplate
+
+import (
+	"bytes"
+	"encoding/json"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// protectedPodSpecFields can't be overridden, as the operator relies on them for the pods to work at all.
+// The volumes are merged by name, so an override could replace the certificate, token or config volumes of the operator.
+var protectedPodSpecFields = []string{
+	"serviceAccountName",
+	"serviceAccount",
+	"automountServiceAccountToken",
+	"hostNetwork",
+	"hostPID",
+	"hostIPC",
+	"securityContext",
+	"volumes",
+}
+
+// protectedContainerFields can't be overridden for any of the containers and init containers.
+// The env and volumeMounts are merged by name, like the volumes, and the securityContext holds the capabilities the components need.
+var protectedContainerFields = []string{
+	"image",
+	"command",
+	"args",
+	"env",
+	"volumeMounts",
+	"securityContext",
+}
+
+// IsEmpty returns true if no override is configured.
+func (o *Override) IsEmpty() bool {
+	return o == nil || len(bytes.TrimSpace(o.Raw)) == 0
+}
+
+func (s TemplateSpec) GetOverride() *Override {
+	return s.PodTemplateOverride
+}
+
+// GetProtectedFields returns the paths of all fields set by the override, that the operator doesn't allow to be overridden.
+// Patch directives, like $patch, are protected as well, as they could remove anything the operator has set.
+// An error is returned if the override isn't a valid pod template.
+func (o *Override) GetProtectedFields() ([]string, error) {
+	if o.IsEmpty() {
+		return nil, nil
+	}
+
+	var patch map[string]any
+	if err := json.Unmarshal(o.Raw, &patch); err != nil {
+		return nil, errors.WithMessage(err, "pod template override is not a JSON object")
+	}
+
+	protected := findDirectives(patch, "")
+	if len(protected) > 0 {
+		// directives are not part of the pod template, so the strict decoding below would fail on them
+		return protected, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(o.Raw))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&corev1.PodTemplateSpec{}); err != nil {
+		return nil, errors.WithMessage(err, "pod template override is not a valid pod template")
+	}
+
+	spec, _ := patch["spec"].(map[string]any)
+
+	for _, field := range protectedPodSpecFields {
+		if _, ok := spec[field]; ok {
+			protected = append(protected, "spec."+field)
+		}
+	}
+
+	protected = append(protected, findProtectedContainerFields(spec, "containers")...)
+	protected = append(protected, findProtectedContainerFields(spec, "initContainers")...)
+
+	return protected, nil
+}
+
+func findProtectedContainerFields(spec map[string]any, containersField string) []string {
+	var protected []string
+
+	containers, _ := spec[containersField].([]any)
+
+	for i, container := range containers {
+		containerFields, _ := container.(map[string]any)
+
+		for _, field := range protectedContainerFields {
+			if _, ok := containerFields[field]; ok {
+				protected = append(protected, "spec."+containersField+"["+containerName(containerFields, i)+"]."+field)
+			}
+		}
+	}
+
+	return protected
+}
+
+func findDirectives(value any, path string) []string {
+	var directives []string
+
+	switch typed := value.(type) {
+	case map[string]any:
+		for _, key := range slices.Sorted(maps.Keys(typed)) {
+			fieldPath := strings.TrimPrefix(path+"."+key, ".")
+
+			if strings.HasPrefix(key, "$") {
+				directives = append(directives, fieldPath)
+
+				continue
+			}
+
+			directives = append(directives, findDirectives(typed[key], fieldPath)...)
+		}
+	case []any:
+		for _, item := range typed {
+			directives = append(directives, findDirectives(item, path+"[]")...)
+		}
+	}
+
+	return directives
+}
+
+func containerName(container map[string]any, index int) string {
+	if name, ok := container["name"].(string); ok && name != "" {
+		return name
+	}
+
+	return strconv.Itoa(index)
+}
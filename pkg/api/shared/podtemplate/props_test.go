@@ -0,0 +1,86 @@
+package podtemplate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func createOverride(raw string) *Override {
+	return &Override{RawExtension: runtime.RawExtension{Raw: []byte(raw)}}
+}
+
+func TestGetOverride(t *testing.T) {
+	assert.Nil(t, TemplateSpec{}.GetOverride())
+	assert.True(t, TemplateSpec{}.GetOverride().IsEmpty())
+	assert.True(t, (&Override{}).IsEmpty())
+	assert.False(t, createOverride(`{}`).IsEmpty())
+}
+
+func TestGetProtectedFields(t *testing.T) {
+	t.Run("no override", func(t *testing.T) {
+		var notConfigured *Override
+
+		protected, err := notConfigured.GetProtectedFields()
+		require.NoError(t, err)
+		assert.Empty(t, protected)
+	})
+
+	t.Run("allowed fields", func(t *testing.T) {
+		override := createOverride(`{"metadata": {"labels": {"team": "a"}}, "spec": {"priorityClassName": "high", "containers": [{"name": "main", "resources": {"limits": {"cpu": "1"}}}]}}`)
+
+		protected, err := override.GetProtectedFields()
+		require.NoError(t, err)
+		assert.Empty(t, protected)
+	})
+
+	t.Run("protected fields", func(t *testing.T) {
+		override := createOverride(`{"spec": {"serviceAccountName": "other", "hostNetwork": false, "containers": [{"name": "main", "image": "other"}], "initContainers": [{"command": ["sh"]}]}}`)
+
+		protected, err := override.GetProtectedFields()
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"spec.serviceAccountName",
+			"spec.hostNetwork",
+			"spec.containers[main].image",
+			"spec.initContainers[0].command",
+		}, protected)
+	})
+
+	t.Run("fields merged with the ones of the operator", func(t *testing.T) {
+		override := createOverride(`{"spec": {"securityContext": {"runAsUser": 0}, "volumes": [{"name": "certs", "emptyDir": {}}], ` +
+			`"containers": [{"name": "main", "env": [{"name": "A", "value": "a"}], "volumeMounts": [{"name": "certs", "mountPath": "/certs"}], "securityContext": {"capabilities": {"drop": ["ALL"]}}}]}}`)
+
+		protected, err := override.GetProtectedFields()
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"spec.securityContext",
+			"spec.volumes",
+			"spec.containers[main].env",
+			"spec.containers[main].volumeMounts",
+			"spec.containers[main].securityContext",
+		}, protected)
+	})
+
+	t.Run("patch directives", func(t *testing.T) {
+		override := createOverride(`{"spec": {"$patch": "replace", "containers": [{"name": "main", "$patch": "delete"}]}}`)
+
+		protected, err := override.GetProtectedFields()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"spec.$patch", "spec.containers[].$patch"}, protected)
+	})
+
+	t.Run("unknown fields", func(t *testing.T) {
+		override := createOverride(`{"spec": {"priorityClass": "high"}}`)
+
+		_, err := override.GetProtectedFields()
+		require.Error(t, err)
+	})
+
+	t.Run("not an object", func(t *testing.T) {
+		_, err := createOverride(`[]`).GetProtectedFields()
+		require.Error(t, err)
+	})
+}
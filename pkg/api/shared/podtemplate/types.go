@@ -0,0 +1,20 @@
+package podtemplate
+
+import "k8s.io/apimachinery/pkg/runtime"
+
+// +kubebuilder:object:generate=true
+
+type TemplateSpec struct {
+	// Strategic merge patch of the pod template, applied after the operator built it.
+	// Fields the operator depends on, like the images, commands, env, volumes, security contexts and the service account, can't be overridden.
+	// +kubebuilder:validation:Optional
+	PodTemplateOverride *Override `json:"podTemplateOverride,omitempty"`
+}
+
+// Override is a strategic merge patch of a pod template, e.g. {"spec": {"priorityClassName": "high"}}.
+// +kubebuilder:object:generate=true
+// +kubebuilder:validation:Type=object
+// +kubebuilder:pruning:PreserveUnknownFields
+type Override struct {
+	runtime.RawExtension `json:",inline"`
+}
@@ -0,0 +1,15 @@
+// +kubebuilder:object:generate=true
+// +k8s:openapi-gen=true
+package status
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// CertificateStatus describes a certificate that is generated and renewed by the Operator or issued by cert-manager.
+type CertificateStatus struct {
+	// Indicates when the certificate expires
+	NotAfter metav1.Time `json:"notAfter,omitempty"`
+	// Hash of the certificate, used to restart the components using it when it is renewed
+	Hash string `json:"hash,omitempty"`
+	// Name of the cert-manager issuer that signed the certificate, empty if it is self-signed
+	Issuer string `json:"issuer,omitempty"`
+}
@@ -7,6 +7,9 @@ package edgeconnect
 
 import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/image"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/networkpolicy"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/pdb"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/podtemplate"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/proxy"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/v1alpha2"
 	corev1 "k8s.io/api/core/v1"
@@ -76,6 +79,16 @@ type EdgeConnectSpec struct { //nolint:revive
 	// Sets topology spread constraints for the EdgeConnect pods
 	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
 
+	// Configures the PodDisruptionBudget of the EdgeConnect pods. Defaults to maxUnavailable=1 if more than one replica is used.
+	PodDisruptionBudget *pdb.Spec `json:"podDisruptionBudget,omitempty"`
+
+	// Generates a NetworkPolicy for the EdgeConnect pods, which denies all ingress traffic.
+	// The egress is not restricted, as EdgeConnect forwards requests to arbitrary hosts.
+	NetworkPolicies *networkpolicy.Spec `json:"networkPolicies,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	podtemplate.TemplateSpec `json:",inline"`
+
 	// Host patterns to be set in the tenant, only considered when provisioning is enabled.
 	// +kubebuilder:validation:Optional
 	HostPatterns []string `json:"hostPatterns,omitempty"`
@@ -19,6 +19,8 @@ limitations under the License.
 package edgeconnect
 
 import (
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/networkpolicy"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/pdb"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/proxy"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -162,6 +164,17 @@ func (in *EdgeConnectSpec) DeepCopyInto(out *EdgeConnectSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(pdb.Spec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkPolicies != nil {
+		in, out := &in.NetworkPolicies, &out.NetworkPolicies
+		*out = new(networkpolicy.Spec)
+		**out = **in
+	}
+	in.TemplateSpec.DeepCopyInto(&out.TemplateSpec)
 	if in.HostPatterns != nil {
 		in, out := &in.HostPatterns, &out.HostPatterns
 		*out = make([]string, len(*in))
@@ -0,0 +1,112 @@
+// +kubebuilder:object:generate=true
+// +groupName=dynatrace.com
+// +versionName=v1alpha2
+// +kubebuilder:validation:Optional
+
+package injectionpolicy
+
+import (
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// InjectionPolicySpec defines which pods of the namespace are injected, without the need to annotate the pods.
+type InjectionPolicySpec struct {
+	// Rules are evaluated in order, only the first rule that matches a pod is applied.
+	// Annotations set on the pod take precedence over the settings of the rule.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Rules []Rule `json:"rules"`
+}
+
+type Rule struct {
+	// Name of the rule, it is added to the annotations of the matched pods.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Conditions a pod has to fulfill for the rule to be applied. All configured conditions have to match.
+	// +kubebuilder:validation:Required
+	Match MatchSpec `json:"match"`
+
+	// Controls the OneAgent injection for the matched pods.
+	// +kubebuilder:validation:Optional
+	OneAgent *OneAgentInjectionSpec `json:"oneAgent,omitempty"`
+
+	// Controls the metadata enrichment for the matched pods.
+	// +kubebuilder:validation:Optional
+	MetadataEnrichment *InjectionSpec `json:"metadataEnrichment,omitempty"`
+
+	// Controls the injection of the OTLP exporter configuration for the matched pods.
+	// +kubebuilder:validation:Optional
+	OTLPExporterConfiguration *InjectionSpec `json:"otlpExporterConfiguration,omitempty"`
+}
+
+type MatchSpec struct {
+	// Kinds of the workloads owning the pods, for example Deployment or StatefulSet.
+	// +kubebuilder:validation:Optional
+	WorkloadKinds []string `json:"workloadKinds,omitempty"`
+
+	// Names of the workloads owning the pods, '*' can be used as a wildcard.
+	// +kubebuilder:validation:Optional
+	WorkloadNames []string `json:"workloadNames,omitempty"`
+
+	// Label selector for the pods.
+	// +kubebuilder:validation:Optional
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+
+	// Images of the containers, '*' can be used as a wildcard. At least one container of the pod has to use a matching image.
+	// +kubebuilder:validation:Optional
+	Images []string `json:"images,omitempty"`
+
+	// Names of the service accounts used by the pods.
+	// +kubebuilder:validation:Optional
+	ServiceAccounts []string `json:"serviceAccounts,omitempty"`
+}
+
+type InjectionSpec struct {
+	// Enables or disables the injection for the matched pods.
+	// +kubebuilder:validation:Required
+	Inject bool `json:"inject"`
+}
+
+type OneAgentInjectionSpec struct {
+	InjectionSpec `json:",inline"`
+
+	// Technologies of the code modules that are injected, for example java or nodejs.
+	// +kubebuilder:validation:Optional
+	Technologies []string `json:"technologies,omitempty"`
+
+	// Path the code modules are mounted to in the application containers.
+	// +kubebuilder:validation:Optional
+	InstallPath string `json:"installPath,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=injectionpolicies,scope=Namespaced,categories=dynatrace,shortName={ip,ips}
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:storageversion
+
+// InjectionPolicy is the Schema for the InjectionPolicy API.
+type InjectionPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec InjectionPolicySpec `json:"spec,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +kubebuilder:object:root=true
+
+// InjectionPolicyList contains a list of InjectionPolicy.
+type InjectionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InjectionPolicy `json:"items"`
+}
+
+func init() {
+	v1alpha2.SchemeBuilder.Register(&InjectionPolicy{}, &InjectionPolicyList{})
+}
@@ -3,13 +3,24 @@ package validation
 import (
 	"context"
 	"fmt"
+	"maps"
+	"net"
+	"slices"
+	"strings"
 
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/activegate"
+	agconsts "github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/consts"
 	corev1 "k8s.io/api/core/v1"
 )
 
 const (
+	// the controller-revision-hash label of the pods contains the StatefulSet name and a 10 characters hash, it must not exceed 63 characters
+	maxActiveGatePoolStatefulSetNameLength = 52
+
+	// the Service of the pool would clash with the LoadBalancer Service of the main ActiveGate
+	activeGatePoolReservedName = "external"
+
 	errorInvalidActiveGateCapability = `The DynaKube's specification tries to use an invalid capability in ActiveGate section, invalid capability=%s.
 Make sure you correctly specify the ActiveGate capabilities in your custom resource.
 `
@@ -19,7 +30,31 @@ Make sure you don't duplicate an Activegate capability in your custom resource.
 `
 	errorActiveGateInvalidPVCConfiguration = ` DynaKube specifies a PVC for the ActiveGate while ephemeral volume is also enabled. These settings are mutually exclusive, please choose only one.`
 
+	errorActiveGateInvalidAutoscalingReplicas = `The DynaKube's specification sets ActiveGate autoscaling minReplicas (%d) higher than maxReplicas (%d).`
+
+	errorActiveGateTLSRouteWithoutHostnames = `The DynaKube's specification exposes the ActiveGate via a TLSRoute without hostnames. Please specify the hostnames the ActiveGate is reachable under, they are required for TLS passthrough.`
+
+	errorActiveGatePoolsWithoutCapabilities = `The DynaKube's specification configures ActiveGate pools without capabilities for the main ActiveGate. Pools share the configuration of the main ActiveGate, please specify its capabilities too.`
+
+	errorActiveGatePoolInvalidCapability = `The DynaKube's specification tries to use an invalid capability in ActiveGate pool '%s', invalid capability=%s.
+Pools support the routing, metrics-ingest, dynatrace-api and debugging capabilities, kubernetes-monitoring is only available for the main ActiveGate.
+`
+
+	errorActiveGatePoolInvalidName = `The DynaKube's specification configures an ActiveGate pool with the name '%s', which is either reserved or too long. The name of the DynaKube and the pool together must not exceed %d characters.`
+
+	errorActiveGateUnknownCollectorModule = `The DynaKube's specification tries to configure an unknown ActiveGate module in the collector properties, unknown module=%s.
+Supported modules are: %s.
+`
+
+	errorActiveGateInvalidPropertiesProxyServer = `The DynaKube's specification configures the ActiveGate properties proxy server '%s', which is invalid. Please specify only the host name or IP of the proxy, without scheme and port.`
+
+	warningMalformedActiveGateCustomProperties = `The DynaKube's specification contains ActiveGate custom properties with the malformed line '%s'. Every line has to be a [section], a key=value pair or a comment, otherwise the ActiveGate fails at startup.`
+
 	warningMissingActiveGateMemoryLimit = `ActiveGate specification missing memory limits. Can cause excess memory usage.`
+
+	warningActiveGateReplicasIgnored = `The DynaKube's specification sets ActiveGate replicas while autoscaling is enabled. The replicas field is ignored, the HorizontalPodAutoscaler manages the replicas between minReplicas and maxReplicas.`
+
+	warningActiveGateTLSRouteWithCustomCertificate = `The DynaKube's specification exposes the ActiveGate via a TLSRoute while using a custom TLS secret. Make sure its certificate covers the hostnames of the TLSRoute.`
 )
 
 func duplicateActiveGateCapabilities(_ context.Context, _ *Validator, dk *dynakube.DynaKube) string {
@@ -56,6 +91,117 @@ func invalidActiveGateCapabilities(_ context.Context, _ *Validator, dk *dynakube
 	return ""
 }
 
+func invalidActiveGatePools(_ context.Context, _ *Validator, dk *dynakube.DynaKube) string {
+	if len(dk.Spec.ActiveGate.Pools) == 0 {
+		return ""
+	}
+
+	if len(dk.Spec.ActiveGate.Capabilities) == 0 {
+		log.Info("requested dynakube has ActiveGate pools without main ActiveGate", "name", dk.Name, "namespace", dk.Namespace)
+
+		return errorActiveGatePoolsWithoutCapabilities
+	}
+
+	maxPoolNameLength := maxActiveGatePoolStatefulSetNameLength - len(dk.Name+"-"+agconsts.MultiActiveGateName+"-")
+
+	for _, pool := range dk.Spec.ActiveGate.Pools {
+		if pool.Name == activeGatePoolReservedName || len(pool.Name) > maxPoolNameLength {
+			log.Info("requested dynakube has invalid ActiveGate pool name", "name", dk.Name, "namespace", dk.Namespace, "pool", pool.Name)
+
+			return fmt.Sprintf(errorActiveGatePoolInvalidName, pool.Name, maxActiveGatePoolStatefulSetNameLength-len("-"+agconsts.MultiActiveGateName+"-"))
+		}
+	}
+
+	return ""
+}
+
+func invalidActiveGatePoolCapabilities(_ context.Context, _ *Validator, dk *dynakube.DynaKube) string {
+	for _, pool := range dk.Spec.ActiveGate.Pools {
+		for _, capability := range pool.Capabilities {
+			if _, ok := activegate.CapabilityDisplayNames[capability]; !ok || capability == activegate.KubeMonCapability.DisplayName {
+				log.Info("requested dynakube has invalid ActiveGate pool capability", "name", dk.Name, "namespace", dk.Namespace, "pool", pool.Name)
+
+				return fmt.Sprintf(errorActiveGatePoolInvalidCapability, pool.Name, capability)
+			}
+		}
+	}
+
+	return ""
+}
+
+// knownActiveGateCollectorModules are the ActiveGate modules that can be toggled in the collector section of the properties.
+var knownActiveGateCollectorModules = []string{
+	activegate.RoutingCapability.ArgumentName,
+	activegate.KubeMonCapability.ArgumentName,
+	activegate.MetricsIngestCapability.ArgumentName,
+	activegate.DynatraceAPICapability.ArgumentName,
+	activegate.DebuggingCapability.ArgumentName,
+	"aws_monitoring",
+	"azure_monitoring",
+	"beacon_forwarder",
+	"cloudfoundry_monitoring",
+	"dbAgent",
+	"extension_controller",
+	"generic_ingest",
+	"log_analytics_collector",
+	"otlp_ingest",
+	"synthetic",
+	"vmware_monitoring",
+	"zremote",
+}
+
+func unknownActiveGateCollectorModules(_ context.Context, _ *Validator, dk *dynakube.DynaKube) string {
+	if dk.Spec.ActiveGate.Properties == nil {
+		return ""
+	}
+
+	for _, module := range slices.Sorted(maps.Keys(dk.Spec.ActiveGate.Properties.Collector)) {
+		if !slices.Contains(knownActiveGateCollectorModules, module) {
+			log.Info("requested dynakube has unknown ActiveGate collector module", "name", dk.Name, "namespace", dk.Namespace, "module", module)
+
+			return fmt.Sprintf(errorActiveGateUnknownCollectorModule, module, strings.Join(knownActiveGateCollectorModules, ", "))
+		}
+	}
+
+	return ""
+}
+
+func invalidActiveGatePropertiesProxyServer(_ context.Context, _ *Validator, dk *dynakube.DynaKube) string {
+	if dk.Spec.ActiveGate.Properties == nil || dk.Spec.ActiveGate.Properties.Proxy == nil {
+		return ""
+	}
+
+	server := dk.Spec.ActiveGate.Properties.Proxy.Server
+	if strings.Contains(server, "://") || strings.ContainsAny(server, " /") || (net.ParseIP(server) == nil && strings.Contains(server, ":")) {
+		log.Info("requested dynakube has invalid ActiveGate properties proxy server", "name", dk.Name, "namespace", dk.Namespace)
+
+		return fmt.Sprintf(errorActiveGateInvalidPropertiesProxyServer, server)
+	}
+
+	return ""
+}
+
+// malformedActiveGateCustomProperties checks the syntax of custom properties provided as value, the ones referenced from a secret can't be checked at admission.
+func malformedActiveGateCustomProperties(_ context.Context, _ *Validator, dk *dynakube.DynaKube) string {
+	if !dk.ActiveGate().IsEnabled() || dk.Spec.ActiveGate.CustomProperties == nil {
+		return ""
+	}
+
+	for line := range strings.SplitSeq(dk.Spec.ActiveGate.CustomProperties.Value, "\n") {
+		line = strings.TrimSpace(line)
+
+		switch {
+		case line == "", strings.HasPrefix(line, "#"), strings.HasPrefix(line, ";"):
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+		case strings.Contains(line, "=") && !strings.HasPrefix(line, "="):
+		default:
+			return fmt.Sprintf(warningMalformedActiveGateCustomProperties, line)
+		}
+	}
+
+	return ""
+}
+
 func missingActiveGateMemoryLimit(_ context.Context, _ *Validator, dk *dynakube.DynaKube) string {
 	if dk.ActiveGate().IsEnabled() &&
 		!memoryLimitSet(dk.Spec.ActiveGate.Resources) {
@@ -82,3 +228,51 @@ func mutuallyExclusiveActiveGatePVsettings(_ context.Context, _ *Validator, dk *
 
 	return ""
 }
+
+func invalidActiveGateAutoscalingReplicas(_ context.Context, _ *Validator, dk *dynakube.DynaKube) string {
+	if !dk.ActiveGate().IsEnabled() || !dk.ActiveGate().IsAutoscalingEnabled() {
+		return ""
+	}
+
+	minReplicas := dk.ActiveGate().GetMinReplicas()
+	maxReplicas := dk.Spec.ActiveGate.Autoscaling.MaxReplicas
+
+	if minReplicas > maxReplicas {
+		log.Info("requested dynakube has invalid ActiveGate autoscaling replicas", "name", dk.Name, "namespace", dk.Namespace)
+
+		return fmt.Sprintf(errorActiveGateInvalidAutoscalingReplicas, minReplicas, maxReplicas)
+	}
+
+	return ""
+}
+
+func ignoredActiveGateReplicas(_ context.Context, _ *Validator, dk *dynakube.DynaKube) string {
+	if dk.ActiveGate().IsEnabled() && dk.ActiveGate().IsAutoscalingEnabled() && dk.Spec.ActiveGate.Replicas != nil {
+		return warningActiveGateReplicasIgnored
+	}
+
+	return ""
+}
+
+func missingActiveGateTLSRouteHostnames(_ context.Context, _ *Validator, dk *dynakube.DynaKube) string {
+	if !dk.ActiveGate().IsEnabled() || !dk.ActiveGate().IsGatewayRouteEnabled() {
+		return ""
+	}
+
+	gateway := dk.Spec.ActiveGate.Exposure.Gateway
+	if gateway.GetRouteKind() == activegate.TLSRouteKind && len(gateway.Hostnames) == 0 {
+		log.Info("requested dynakube exposes the ActiveGate via a TLSRoute without hostnames", "name", dk.Name, "namespace", dk.Namespace)
+
+		return errorActiveGateTLSRouteWithoutHostnames
+	}
+
+	return ""
+}
+
+func activeGateTLSRouteWithCustomCertificate(_ context.Context, _ *Validator, dk *dynakube.DynaKube) string {
+	if dk.ActiveGate().IsEnabled() && len(dk.ActiveGate().GetPassthroughHostnames()) > 0 && dk.Spec.ActiveGate.TLSSecretName != "" {
+		return warningActiveGateTLSRouteWithCustomCertificate
+	}
+
+	return ""
+}
@@ -2,13 +2,16 @@ package validation
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/activegate"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/extensions"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/value"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
 )
 
 func TestDuplicateActiveGateCapabilities(t *testing.T) {
@@ -131,3 +134,197 @@ func TestActiveGatePVCSettings(t *testing.T) {
 			})
 	})
 }
+
+func TestActiveGateAutoscaling(t *testing.T) {
+	createDynakube := func(replicas *int32, autoscaling *activegate.AutoscalingSpec) *dynakube.DynaKube {
+		return &dynakube.DynaKube{
+			ObjectMeta: defaultDynakubeObjectMeta,
+			Spec: dynakube.DynaKubeSpec{
+				APIURL: testAPIURL,
+				ActiveGate: activegate.Spec{
+					Capabilities: []activegate.CapabilityDisplayName{
+						activegate.RoutingCapability.DisplayName,
+					},
+					CapabilityProperties: activegate.CapabilityProperties{
+						Replicas: replicas,
+						Resources: corev1.ResourceRequirements{
+							Limits: corev1.ResourceList{
+								corev1.ResourceMemory: *resource.NewMilliQuantity(1, ""),
+							},
+						},
+					},
+					Autoscaling: autoscaling,
+				},
+			},
+		}
+	}
+
+	t.Run("valid autoscaling", func(t *testing.T) {
+		assertAllowedWithoutWarnings(t, createDynakube(nil, &activegate.AutoscalingSpec{MinReplicas: ptr.To(int32(2)), MaxReplicas: 5}))
+	})
+	t.Run("minReplicas higher than maxReplicas", func(t *testing.T) {
+		assertDenied(t,
+			[]string{fmt.Sprintf(errorActiveGateInvalidAutoscalingReplicas, 6, 5)},
+			createDynakube(nil, &activegate.AutoscalingSpec{MinReplicas: ptr.To(int32(6)), MaxReplicas: 5}))
+	})
+	t.Run("replicas are ignored with autoscaling", func(t *testing.T) {
+		assertAllowedWithWarnings(t, 1, createDynakube(ptr.To(int32(3)), &activegate.AutoscalingSpec{MaxReplicas: 5}))
+	})
+}
+
+func TestActiveGateExposure(t *testing.T) {
+	createDynakube := func(tlsSecretName string, gateway *activegate.GatewaySpec) *dynakube.DynaKube {
+		return &dynakube.DynaKube{
+			ObjectMeta: defaultDynakubeObjectMeta,
+			Spec: dynakube.DynaKubeSpec{
+				APIURL: testAPIURL,
+				ActiveGate: activegate.Spec{
+					Capabilities: []activegate.CapabilityDisplayName{
+						activegate.RoutingCapability.DisplayName,
+					},
+					CapabilityProperties: activegate.CapabilityProperties{
+						Resources: corev1.ResourceRequirements{
+							Limits: corev1.ResourceList{
+								corev1.ResourceMemory: *resource.NewMilliQuantity(1, ""),
+							},
+						},
+					},
+					TLSSecretName: tlsSecretName,
+					Exposure:      &activegate.ExposureSpec{Gateway: gateway},
+				},
+			},
+		}
+	}
+	parentRefs := []activegate.GatewayParentRef{{Name: "gateway"}}
+
+	t.Run("HTTPRoute without hostnames", func(t *testing.T) {
+		assertAllowedWithoutWarnings(t, createDynakube("", &activegate.GatewaySpec{ParentRefs: parentRefs}))
+	})
+	t.Run("TLSRoute with hostnames", func(t *testing.T) {
+		assertAllowedWithoutWarnings(t, createDynakube("", &activegate.GatewaySpec{
+			RouteKind:  activegate.TLSRouteKind,
+			ParentRefs: parentRefs,
+			Hostnames:  []string{"ag.example.com"},
+		}))
+	})
+	t.Run("TLSRoute without hostnames", func(t *testing.T) {
+		assertDenied(t,
+			[]string{errorActiveGateTLSRouteWithoutHostnames},
+			createDynakube("", &activegate.GatewaySpec{RouteKind: activegate.TLSRouteKind, ParentRefs: parentRefs}))
+	})
+	t.Run("TLSRoute with custom certificate", func(t *testing.T) {
+		assertAllowedWithWarnings(t, 1, createDynakube("custom-tls", &activegate.GatewaySpec{
+			RouteKind:  activegate.TLSRouteKind,
+			ParentRefs: parentRefs,
+			Hostnames:  []string{"ag.example.com"},
+		}))
+	})
+}
+
+func TestActiveGatePools(t *testing.T) {
+	createDynakube := func(capabilities []activegate.CapabilityDisplayName, pools ...activegate.PoolSpec) *dynakube.DynaKube {
+		return &dynakube.DynaKube{
+			ObjectMeta: defaultDynakubeObjectMeta,
+			Spec: dynakube.DynaKubeSpec{
+				APIURL: testAPIURL,
+				ActiveGate: activegate.Spec{
+					Capabilities: capabilities,
+					CapabilityProperties: activegate.CapabilityProperties{
+						Resources: corev1.ResourceRequirements{
+							Limits: corev1.ResourceList{
+								corev1.ResourceMemory: *resource.NewMilliQuantity(1, ""),
+							},
+						},
+					},
+					Pools: pools,
+				},
+			},
+		}
+	}
+	kubeMon := []activegate.CapabilityDisplayName{activegate.KubeMonCapability.DisplayName}
+	routing := []activegate.CapabilityDisplayName{activegate.RoutingCapability.DisplayName}
+
+	t.Run("pool next to main ActiveGate", func(t *testing.T) {
+		assertAllowedWithoutWarnings(t, createDynakube(kubeMon, activegate.PoolSpec{Name: "routing", Capabilities: routing}))
+	})
+	t.Run("pool without main ActiveGate", func(t *testing.T) {
+		assertDenied(t,
+			[]string{errorActiveGatePoolsWithoutCapabilities},
+			createDynakube(nil, activegate.PoolSpec{Name: "routing", Capabilities: routing}))
+	})
+	t.Run("pool with kubernetes-monitoring", func(t *testing.T) {
+		assertDenied(t,
+			[]string{fmt.Sprintf(errorActiveGatePoolInvalidCapability, "kubemon", activegate.KubeMonCapability.DisplayName)},
+			createDynakube(routing, activegate.PoolSpec{Name: "kubemon", Capabilities: kubeMon}))
+	})
+	t.Run("pool with invalid capability", func(t *testing.T) {
+		assertDenied(t,
+			[]string{fmt.Sprintf(errorActiveGatePoolInvalidCapability, "invalid", "invalid-capability")},
+			createDynakube(kubeMon, activegate.PoolSpec{Name: "invalid", Capabilities: []activegate.CapabilityDisplayName{"invalid-capability"}}))
+	})
+	t.Run("pool with reserved name", func(t *testing.T) {
+		assertDenied(t,
+			[]string{fmt.Sprintf(errorActiveGatePoolInvalidName, activeGatePoolReservedName, 40)},
+			createDynakube(kubeMon, activegate.PoolSpec{Name: activeGatePoolReservedName, Capabilities: routing}))
+	})
+	t.Run("pool name too long", func(t *testing.T) {
+		name := strings.Repeat("a", 41-len(defaultDynakubeObjectMeta.Name))
+		assertDenied(t,
+			[]string{fmt.Sprintf(errorActiveGatePoolInvalidName, name, 40)},
+			createDynakube(kubeMon, activegate.PoolSpec{Name: name, Capabilities: routing}))
+	})
+}
+
+func TestActiveGateProperties(t *testing.T) {
+	createDynakube := func(properties *activegate.PropertiesSpec, customProperties string) *dynakube.DynaKube {
+		return &dynakube.DynaKube{
+			ObjectMeta: defaultDynakubeObjectMeta,
+			Spec: dynakube.DynaKubeSpec{
+				APIURL: testAPIURL,
+				ActiveGate: activegate.Spec{
+					Capabilities: []activegate.CapabilityDisplayName{activegate.RoutingCapability.DisplayName},
+					CapabilityProperties: activegate.CapabilityProperties{
+						CustomProperties: &value.Source{Value: customProperties},
+						Resources: corev1.ResourceRequirements{
+							Limits: corev1.ResourceList{
+								corev1.ResourceMemory: *resource.NewMilliQuantity(1, ""),
+							},
+						},
+					},
+					Properties: properties,
+				},
+			},
+		}
+	}
+
+	t.Run("valid properties", func(t *testing.T) {
+		assertAllowedWithoutWarnings(t, createDynakube(&activegate.PropertiesSpec{
+			Collector: map[string]bool{"aws_monitoring": false, "MSGrouter": true},
+			Proxy:     &activegate.ProxyPropertiesSpec{Server: "proxy.example.com", Port: 3128},
+		}, "# comment\n[collector]\nzremote_enabled = false\n\n[http.client]\nsocket-timeout=5"))
+	})
+	t.Run("IPv6 proxy server", func(t *testing.T) {
+		assertAllowedWithoutWarnings(t, createDynakube(&activegate.PropertiesSpec{
+			Proxy: &activegate.ProxyPropertiesSpec{Server: "fd00::1", Port: 3128},
+		}, ""))
+	})
+	t.Run("unknown collector module", func(t *testing.T) {
+		assertDenied(t,
+			[]string{fmt.Sprintf(errorActiveGateUnknownCollectorModule, "aws_monitorin", strings.Join(knownActiveGateCollectorModules, ", "))},
+			createDynakube(&activegate.PropertiesSpec{Collector: map[string]bool{"aws_monitorin": false}}, ""))
+	})
+	t.Run("proxy server with scheme", func(t *testing.T) {
+		assertDenied(t,
+			[]string{fmt.Sprintf(errorActiveGateInvalidPropertiesProxyServer, "http://proxy")},
+			createDynakube(&activegate.PropertiesSpec{Proxy: &activegate.ProxyPropertiesSpec{Server: "http://proxy", Port: 3128}}, ""))
+	})
+	t.Run("proxy server with port", func(t *testing.T) {
+		assertDenied(t,
+			[]string{fmt.Sprintf(errorActiveGateInvalidPropertiesProxyServer, "proxy:3128")},
+			createDynakube(&activegate.PropertiesSpec{Proxy: &activegate.ProxyPropertiesSpec{Server: "proxy:3128", Port: 3128}}, ""))
+	})
+	t.Run("malformed custom properties", func(t *testing.T) {
+		assertAllowedWithWarnings(t, 1, createDynakube(nil, "[collector\nzremote_enabled=false"))
+		assertAllowedWithWarnings(t, 1, createDynakube(nil, "[collector]\nzremote_enabled false"))
+	})
+}
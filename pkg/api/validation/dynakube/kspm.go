@@ -19,13 +19,21 @@ const (
 )
 
 func tooManyAGReplicas(_ context.Context, _ *Validator, dk *dynakube.DynaKube) string {
-	if dk.KSPM().IsEnabled() && dk.ActiveGate().GetReplicas() > 1 {
+	if dk.KSPM().IsEnabled() && maxAGReplicas(dk) > 1 {
 		return errorTooManyAGReplicas
 	}
 
 	return ""
 }
 
+func maxAGReplicas(dk *dynakube.DynaKube) int32 {
+	if dk.ActiveGate().IsAutoscalingEnabled() {
+		return dk.Spec.ActiveGate.Autoscaling.MaxReplicas
+	}
+
+	return dk.ActiveGate().GetReplicas()
+}
+
 func kspmWithoutK8SMonitoring(_ context.Context, _ *Validator, dk *dynakube.DynaKube) string {
 	if dk.KSPM().IsEnabled() && (!dk.ActiveGate().IsKubernetesMonitoringEnabled() || !dk.FF().IsAutomaticK8sAPIMonitoring()) {
 		return errorKSPMMissingKubemon
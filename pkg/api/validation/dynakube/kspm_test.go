@@ -66,6 +66,34 @@ func TestTooManyAGReplicas(t *testing.T) {
 				},
 			})
 	})
+
+	t.Run("activegate with autoscaling above 1 replica and kspm enabled", func(t *testing.T) {
+		activeGate := activegate.Spec{
+			Capabilities: []activegate.CapabilityDisplayName{
+				activegate.KubeMonCapability.DisplayName,
+			},
+			Autoscaling: &activegate.AutoscalingSpec{MaxReplicas: 3},
+		}
+
+		assertDenied(t,
+			[]string{errorTooManyAGReplicas},
+			&dynakube.DynaKube{
+				ObjectMeta: defaultDynakubeObjectMeta,
+				Spec: dynakube.DynaKubeSpec{
+					APIURL:     testAPIURL,
+					Kspm:       &kspm.Spec{},
+					ActiveGate: activeGate,
+					Templates: dynakube.TemplatesSpec{
+						KspmNodeConfigurationCollector: kspm.NodeConfigurationCollectorSpec{
+							ImageRef: image.Ref{
+								Repository: "repo/image",
+								Tag:        "version",
+							},
+						},
+					},
+				},
+			})
+	})
 }
 
 func TestMissingKSPMDependency(t *testing.T) {
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/dtversion"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8senv"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/wildcard"
 	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -42,6 +44,8 @@ Use a nodeSelector to avoid this conflict. Conflicting DynaKubes: %s`
 	errorSameHostTagMultipleTimes = "Providing the same tag(s) (%s) multiple times with --set-host-tag is not allowed."
 
 	warningDeprecatedVersion = `version field is deprecated. Please use "%s" field instead to set a version.`
+
+	errorInvalidContainerImagePattern = `The DynaKube specification contains an invalid containerImages pattern: %s`
 )
 
 func conflictingOneAgentConfiguration(_ context.Context, _ *Validator, dk *dynakube.DynaKube) string {
@@ -275,6 +279,23 @@ func forbiddenHostIDSourceArgument(_ context.Context, _ *Validator, dk *dynakube
 	return ""
 }
 
+func invalidContainerImagePatterns(_ context.Context, _ *Validator, dk *dynakube.DynaKube) string {
+	containerImages := dk.OneAgent().GetContainerImages()
+	if containerImages == nil {
+		return ""
+	}
+
+	for _, pattern := range slices.Concat(containerImages.Allow, containerImages.Deny) {
+		if err := wildcard.Validate(pattern); err != nil {
+			log.Info("requested dynakube has an invalid containerImages pattern", "name", dk.Name, "namespace", dk.Namespace, "pattern", pattern)
+
+			return fmt.Sprintf(errorInvalidContainerImagePattern, err.Error())
+		}
+	}
+
+	return ""
+}
+
 func deprecatedOneAgentVersionField(_ context.Context, _ *Validator, dk *dynakube.DynaKube) string {
 	oa := dk.OneAgent()
 
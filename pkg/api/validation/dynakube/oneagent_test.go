@@ -1133,3 +1133,39 @@ func Test_findDuplicates(t *testing.T) {
 		})
 	}
 }
+
+func TestInvalidContainerImagePatterns(t *testing.T) {
+	createDynakube := func(containerImages *oneagent.ContainerImagesSpec) *dynakube.DynaKube {
+		return &dynakube.DynaKube{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "dynakube",
+				Namespace: testNamespace,
+			},
+			Spec: dynakube.DynaKubeSpec{
+				APIURL: testAPIURL,
+				OneAgent: oneagent.Spec{
+					ApplicationMonitoring: &oneagent.ApplicationMonitoringSpec{
+						AppInjectionSpec: oneagent.AppInjectionSpec{ContainerImages: containerImages},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("valid patterns", func(t *testing.T) {
+		assertAllowedWithoutWarnings(t, createDynakube(&oneagent.ContainerImagesSpec{
+			Allow: []string{"registry.example.com/*"},
+			Deny:  []string{"*/istio/proxyv2*"},
+		}))
+	})
+	t.Run("invalid pattern", func(t *testing.T) {
+		assertDenied(t, []string{"invalid containerImages pattern"}, createDynakube(&oneagent.ContainerImagesSpec{
+			Deny: []string{"(istio|envoy)"},
+		}))
+	})
+	t.Run("empty pattern", func(t *testing.T) {
+		assertDenied(t, []string{"invalid containerImages pattern"}, createDynakube(&oneagent.ContainerImagesSpec{
+			Allow: []string{""},
+		}))
+	})
+}
@@ -0,0 +1,43 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+)
+
+const (
+	errorConflictingPodDisruptionBudget = `DynaKube's specification sets both minAvailable and maxUnavailable for the PodDisruptionBudget of: %s. Only one of them can be set.`
+)
+
+func conflictingPodDisruptionBudgets(_ context.Context, _ *Validator, dk *dynakube.DynaKube) string {
+	var conflicting []string
+
+	if dk.Spec.ActiveGate.PodDisruptionBudget.IsConflicting() {
+		conflicting = append(conflicting, "activeGate")
+	}
+
+	if dk.Spec.Templates.ExtensionExecutionController.PodDisruptionBudget.IsConflicting() {
+		conflicting = append(conflicting, "templates.extensionExecutionController")
+	}
+
+	if dk.Spec.Templates.OpenTelemetryCollector.PodDisruptionBudget.IsConflicting() {
+		conflicting = append(conflicting, "templates.openTelemetryCollector")
+	}
+
+	for _, db := range dk.Extensions().Databases {
+		if db.PodDisruptionBudget.IsConflicting() {
+			conflicting = append(conflicting, "extensions.databases["+db.ID+"]")
+		}
+	}
+
+	if len(conflicting) == 0 {
+		return ""
+	}
+
+	log.Info("requested dynakube has conflicting PodDisruptionBudget settings", "name", dk.Name, "namespace", dk.Namespace, "fields", conflicting)
+
+	return fmt.Sprintf(errorConflictingPodDisruptionBudget, strings.Join(conflicting, ", "))
+}
@@ -0,0 +1,53 @@
+package validation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/activegate"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/pdb"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+func TestConflictingPodDisruptionBudgets(t *testing.T) {
+	createDynakube := func(budget *pdb.Spec) *dynakube.DynaKube {
+		return &dynakube.DynaKube{
+			ObjectMeta: defaultDynakubeObjectMeta,
+			Spec: dynakube.DynaKubeSpec{
+				APIURL: testAPIURL,
+				ActiveGate: activegate.Spec{
+					Capabilities: []activegate.CapabilityDisplayName{
+						activegate.RoutingCapability.DisplayName,
+					},
+					CapabilityProperties: activegate.CapabilityProperties{
+						Resources: corev1.ResourceRequirements{
+							Limits: corev1.ResourceList{
+								corev1.ResourceMemory: *resource.NewMilliQuantity(1, ""),
+							},
+						},
+					},
+					PodDisruptionBudget: budget,
+				},
+			},
+		}
+	}
+
+	t.Run("only minAvailable", func(t *testing.T) {
+		assertAllowedWithoutWarnings(t, createDynakube(&pdb.Spec{MinAvailable: ptr.To(intstr.FromInt32(1))}))
+	})
+	t.Run("only maxUnavailable", func(t *testing.T) {
+		assertAllowedWithoutWarnings(t, createDynakube(&pdb.Spec{MaxUnavailable: ptr.To(intstr.FromString("50%"))}))
+	})
+	t.Run("both set", func(t *testing.T) {
+		assertDenied(t,
+			[]string{fmt.Sprintf(errorConflictingPodDisruptionBudget, "activeGate")},
+			createDynakube(&pdb.Spec{
+				MinAvailable:   ptr.To(intstr.FromInt32(1)),
+				MaxUnavailable: ptr.To(intstr.FromInt32(1)),
+			}))
+	})
+}
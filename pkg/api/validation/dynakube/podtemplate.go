@@ -0,0 +1,52 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/podtemplate"
+)
+
+const (
+	errorInvalidPodTemplateOverride   = `DynaKube's specification contains an invalid podTemplateOverride for %s: %s`
+	errorProtectedPodTemplateOverride = `DynaKube's specification overrides fields of the pod template which are managed by the operator: %s. Remove them from the podTemplateOverride.`
+)
+
+func invalidPodTemplateOverrides(_ context.Context, _ *Validator, dk *dynakube.DynaKube) string {
+	overrides := map[string]*podtemplate.Override{
+		"templates.activeGate":                     dk.Spec.Templates.ActiveGate.GetOverride(),
+		"templates.oneAgent":                       dk.Spec.Templates.OneAgent.GetOverride(),
+		"templates.logMonitoring":                  dk.LogMonitoring().Template().GetOverride(),
+		"templates.kspmNodeConfigurationCollector": dk.Spec.Templates.KspmNodeConfigurationCollector.GetOverride(),
+		"templates.otelCollector":                  dk.Spec.Templates.OpenTelemetryCollector.GetOverride(),
+		"templates.sqlExtensionExecutor":           dk.Spec.Templates.SQLExtensionExecutor.GetOverride(),
+		"templates.extensionExecutionController":   dk.Spec.Templates.ExtensionExecutionController.GetOverride(),
+	}
+
+	var protected []string
+
+	for _, path := range slices.Sorted(maps.Keys(overrides)) {
+		fields, err := overrides[path].GetProtectedFields()
+		if err != nil {
+			log.Info("requested dynakube has an invalid podTemplateOverride", "name", dk.Name, "namespace", dk.Namespace, "template", path)
+
+			return fmt.Sprintf(errorInvalidPodTemplateOverride, path, err.Error())
+		}
+
+		for _, field := range fields {
+			protected = append(protected, path+".podTemplateOverride."+field)
+		}
+	}
+
+	if len(protected) == 0 {
+		return ""
+	}
+
+	log.Info("requested dynakube overrides protected pod template fields", "name", dk.Name, "namespace", dk.Namespace, "fields", protected)
+
+	return fmt.Sprintf(errorProtectedPodTemplateOverride, strings.Join(protected, ", "))
+}
@@ -0,0 +1,40 @@
+package validation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/podtemplate"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestInvalidPodTemplateOverrides(t *testing.T) {
+	createDynakube := func(raw string) *dynakube.DynaKube {
+		return &dynakube.DynaKube{
+			ObjectMeta: defaultDynakubeObjectMeta,
+			Spec: dynakube.DynaKubeSpec{
+				APIURL: testAPIURL,
+				Templates: dynakube.TemplatesSpec{
+					OneAgent: podtemplate.TemplateSpec{
+						PodTemplateOverride: &podtemplate.Override{RawExtension: runtime.RawExtension{Raw: []byte(raw)}},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("allowed override", func(t *testing.T) {
+		assertAllowedWithoutWarnings(t, createDynakube(`{"spec": {"priorityClassName": "high"}}`))
+	})
+	t.Run("protected fields", func(t *testing.T) {
+		assertDenied(t,
+			[]string{fmt.Sprintf(errorProtectedPodTemplateOverride, "templates.oneAgent.podTemplateOverride.spec.serviceAccountName")},
+			createDynakube(`{"spec": {"serviceAccountName": "other"}}`))
+	})
+	t.Run("unknown fields", func(t *testing.T) {
+		assertDenied(t,
+			[]string{"invalid podTemplateOverride for templates.oneAgent"},
+			createDynakube(`{"spec": {"priorityClass": "high"}}`))
+	})
+}
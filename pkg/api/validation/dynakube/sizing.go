@@ -0,0 +1,29 @@
+package validation
+
+import (
+	"context"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/sizing"
+)
+
+const (
+	warningIgnoredCustomSizing = `The Dynakube's specification configures custom resources in sizing.custom, which are ignored as the sizing profile is not "custom".`
+	warningMissingCustomSizing = `The Dynakube's specification uses the custom sizing profile without sizing.custom, so all components keep their default resources.`
+)
+
+func ignoredCustomSizing(_ context.Context, _ *Validator, dk *dynakube.DynaKube) string {
+	if dk.Spec.Sizing != nil && dk.Spec.Sizing.Profile != sizing.CustomProfile && dk.Spec.Sizing.Custom != nil {
+		return warningIgnoredCustomSizing
+	}
+
+	return ""
+}
+
+func missingCustomSizing(_ context.Context, _ *Validator, dk *dynakube.DynaKube) string {
+	if dk.Spec.Sizing != nil && dk.Spec.Sizing.Profile == sizing.CustomProfile && dk.Spec.Sizing.Custom == nil {
+		return warningMissingCustomSizing
+	}
+
+	return ""
+}
@@ -0,0 +1,40 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/sizing"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestSizing(t *testing.T) {
+	createDynakube := func(spec *sizing.Spec) *dynakube.DynaKube {
+		return &dynakube.DynaKube{
+			ObjectMeta: defaultDynakubeObjectMeta,
+			Spec: dynakube.DynaKubeSpec{
+				APIURL: testAPIURL,
+				Sizing: spec,
+			},
+		}
+	}
+
+	t.Run("predefined profile", func(t *testing.T) {
+		assertAllowedWithoutWarnings(t, createDynakube(&sizing.Spec{Profile: sizing.MediumProfile}))
+	})
+	t.Run("custom profile", func(t *testing.T) {
+		assertAllowedWithoutWarnings(t, createDynakube(&sizing.Spec{
+			Profile: sizing.CustomProfile,
+			Custom:  &sizing.Resources{KSPM: &corev1.ResourceRequirements{}},
+		}))
+	})
+	t.Run("custom resources ignored", func(t *testing.T) {
+		assertAllowedWithWarnings(t, 1, createDynakube(&sizing.Spec{
+			Profile: sizing.SmallProfile,
+			Custom:  &sizing.Resources{KSPM: &corev1.ResourceRequirements{}},
+		}))
+	})
+	t.Run("custom profile without resources", func(t *testing.T) {
+		assertAllowedWithWarnings(t, 1, createDynakube(&sizing.Spec{Profile: sizing.CustomProfile}))
+	})
+}
@@ -33,12 +33,19 @@ var (
 		isOneAgentVersionValid,
 		duplicateOneAgentArguments,
 		forbiddenHostIDSourceArgument,
+		invalidContainerImagePatterns,
 		NoAPIURL,
 		IsInvalidAPIURL,
 		IsThirdGenAPIUrl,
 		invalidActiveGateCapabilities,
 		duplicateActiveGateCapabilities,
 		mutuallyExclusiveActiveGatePVsettings,
+		invalidActiveGateAutoscalingReplicas,
+		missingActiveGateTLSRouteHostnames,
+		invalidActiveGatePools,
+		invalidActiveGatePoolCapabilities,
+		unknownActiveGateCollectorModules,
+		invalidActiveGatePropertiesProxyServer,
 		invalidActiveGateProxyURL,
 		conflictingOneAgentConfiguration,
 		conflictingOneAgentNodeSelector,
@@ -68,9 +75,14 @@ var (
 		missingDatabaseExecutorImage,
 		conflictingOrInvalidDatabasesVolumeMounts,
 		unusedDatabasesVolume,
+		conflictingPodDisruptionBudgets,
+		invalidPodTemplateOverrides,
 	}
 	validatorWarningFuncs = []validatorFunc{
 		missingActiveGateMemoryLimit,
+		ignoredActiveGateReplicas,
+		activeGateTLSRouteWithCustomCertificate,
+		malformedActiveGateCustomProperties,
 		unsupportedOneAgentImage,
 		conflictingHostGroupSettings,
 		deprecatedAutoUpdate,
@@ -83,6 +95,8 @@ var (
 		extensionsWithoutK8SMonitoring,
 		hostPathDatabaseVolumeFound,
 		disabledMetadataEnrichmentForInjectionModes,
+		ignoredCustomSizing,
+		missingCustomSizing,
 	}
 	updateValidatorErrorFuncs = []updateValidatorFunc{
 		IsMutatedAPIURL,
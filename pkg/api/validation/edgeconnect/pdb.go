@@ -0,0 +1,19 @@
+package validation
+
+import (
+	"context"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/v1alpha2/edgeconnect"
+)
+
+const (
+	errorConflictingPodDisruptionBudget = `The EdgeConnect's specification sets both minAvailable and maxUnavailable for the PodDisruptionBudget. Only one of them can be set.`
+)
+
+func conflictingPodDisruptionBudget(_ context.Context, _ *Validator, ec *edgeconnect.EdgeConnect) string {
+	if ec.Spec.PodDisruptionBudget.IsConflicting() {
+		return errorConflictingPodDisruptionBudget
+	}
+
+	return ""
+}
@@ -0,0 +1,35 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/pdb"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/v1alpha2/edgeconnect"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+func TestConflictingPodDisruptionBudget(t *testing.T) {
+	t.Run("only minAvailable", func(t *testing.T) {
+		ec := &edgeconnect.EdgeConnect{
+			Spec: edgeconnect.EdgeConnectSpec{
+				APIServer:           "tenant.apps.dynatrace.com",
+				PodDisruptionBudget: &pdb.Spec{MinAvailable: ptr.To(intstr.FromInt32(1))},
+			},
+		}
+		assertAllowed(t, ec)
+	})
+
+	t.Run("both set", func(t *testing.T) {
+		ec := &edgeconnect.EdgeConnect{
+			Spec: edgeconnect.EdgeConnectSpec{
+				APIServer: "tenant.apps.dynatrace.com",
+				PodDisruptionBudget: &pdb.Spec{
+					MinAvailable:   ptr.To(intstr.FromInt32(1)),
+					MaxUnavailable: ptr.To(intstr.FromInt32(1)),
+				},
+			},
+		}
+		assertDenied(t, []string{errorConflictingPodDisruptionBudget}, ec)
+	})
+}
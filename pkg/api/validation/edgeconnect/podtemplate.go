@@ -0,0 +1,27 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/v1alpha2/edgeconnect"
+)
+
+const (
+	errorInvalidPodTemplateOverride   = `The EdgeConnect's specification contains an invalid podTemplateOverride: %s`
+	errorProtectedPodTemplateOverride = `The EdgeConnect's specification overrides fields of the pod template which are managed by the operator: %s. Remove them from the podTemplateOverride.`
+)
+
+func invalidPodTemplateOverride(_ context.Context, _ *Validator, ec *edgeconnect.EdgeConnect) string {
+	protected, err := ec.Spec.GetOverride().GetProtectedFields()
+	if err != nil {
+		return fmt.Sprintf(errorInvalidPodTemplateOverride, err.Error())
+	}
+
+	if len(protected) > 0 {
+		return fmt.Sprintf(errorProtectedPodTemplateOverride, strings.Join(protected, ", "))
+	}
+
+	return ""
+}
@@ -0,0 +1,39 @@
+package validation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/podtemplate"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/v1alpha2/edgeconnect"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestInvalidPodTemplateOverride(t *testing.T) {
+	createEdgeConnect := func(raw string) *edgeconnect.EdgeConnect {
+		return &edgeconnect.EdgeConnect{
+			Spec: edgeconnect.EdgeConnectSpec{
+				APIServer: "tenant.apps.dynatrace.com",
+				TemplateSpec: podtemplate.TemplateSpec{
+					PodTemplateOverride: &podtemplate.Override{RawExtension: runtime.RawExtension{Raw: []byte(raw)}},
+				},
+			},
+		}
+	}
+
+	t.Run("allowed override", func(t *testing.T) {
+		assertAllowed(t, createEdgeConnect(`{"spec": {"priorityClassName": "high"}}`))
+	})
+
+	t.Run("protected fields", func(t *testing.T) {
+		assertDenied(t,
+			[]string{fmt.Sprintf(errorProtectedPodTemplateOverride, "spec.containers[edge-connect].image")},
+			createEdgeConnect(`{"spec": {"containers": [{"name": "edge-connect", "image": "other"}]}}`))
+	})
+
+	t.Run("patch directives", func(t *testing.T) {
+		assertDenied(t,
+			[]string{fmt.Sprintf(errorProtectedPodTemplateOverride, "spec.$patch")},
+			createEdgeConnect(`{"spec": {"$patch": "replace"}}`))
+	})
+}
@@ -22,11 +22,13 @@ func (e V1EventsAPINotAvailableErr) Error() string {
 
 const (
 	MarkedForTerminationEvent = "MARKED_FOR_TERMINATION"
+	CustomInfoEvent           = "CUSTOM_INFO"
 )
 
 // EventData struct which defines what event payload should contain
 type EventData struct {
 	EventType     string               `json:"eventType"`
+	Title         string               `json:"title,omitempty"`
 	Description   string               `json:"description"`
 	Source        string               `json:"source"`
 	AttachRules   EventDataAttachRules `json:"attachRules"`
@@ -1,3 +1,12 @@
 package consts
 
-const HostAvailabilityDetectionEnvVar = "DT_HOST_AVAILABILITY_DETECTION"
+const (
+	HostAvailabilityDetectionEnvVar = "DT_HOST_AVAILABILITY_DETECTION"
+
+	// NodeCacheShardsEnvVar configures the number of ConfigMaps the node cache is spread over.
+	NodeCacheShardsEnvVar = "DT_NODE_CACHE_SHARDS"
+	// NodeCacheFlushIntervalEnvVar configures the interval (in seconds) in which changes to the node cache are written, 0 writes on every reconcile.
+	NodeCacheFlushIntervalEnvVar = "DT_NODE_CACHE_FLUSH_INTERVAL"
+	// NodeCachePruneIntervalEnvVar configures the interval (in seconds) in which the node cache is pruned.
+	NodeCachePruneIntervalEnvVar = "DT_NODE_CACHE_PRUNE_INTERVAL"
+)
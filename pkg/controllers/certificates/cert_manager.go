@@ -0,0 +1,92 @@
+package certificates
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/certmanager"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8scertmanager"
+	"github.com/Dynatrace/dynatrace-operator/pkg/webhook"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+const (
+	// CertManagerIssuerNameEnv enables issuing the webhook certificates via cert-manager, signed by the referenced issuer.
+	CertManagerIssuerNameEnv  = "DT_WEBHOOK_CERT_MANAGER_ISSUER_NAME"
+	CertManagerIssuerKindEnv  = "DT_WEBHOOK_CERT_MANAGER_ISSUER_KIND"
+	CertManagerIssuerGroupEnv = "DT_WEBHOOK_CERT_MANAGER_ISSUER_GROUP"
+
+	// Set by cert-manager on every secret it issues a certificate into.
+	certManagerCertificateNameAnnotation = "cert-manager.io/certificate-name"
+
+	certManagerRetryDuration = 10 * time.Second
+
+	errorIssuerWithoutCA = "the cert-manager issuer does not provide a CA certificate (ca.crt), which is needed for the webhook configurations"
+)
+
+func getCertManagerIssuerRef() *certmanager.IssuerRef {
+	name := os.Getenv(CertManagerIssuerNameEnv)
+	if name == "" {
+		return nil
+	}
+
+	return &certmanager.IssuerRef{
+		Name:  name,
+		Kind:  os.Getenv(CertManagerIssuerKindEnv),
+		Group: os.Getenv(CertManagerIssuerGroupEnv),
+	}
+}
+
+// reconcileCertManagerCertificate lets cert-manager issue the webhook certificates into the certificates secret.
+// Returns false as long as cert-manager has not issued them yet.
+func (controller *WebhookCertificateController) reconcileCertManagerCertificate(ctx context.Context, owner *appsv1.Deployment, certSecret *certificateSecret) (bool, error) {
+	certificate, err := k8scertmanager.Build(owner, webhook.DeploymentName, buildSecretName(), *controller.issuerRef,
+		k8scertmanager.SetDNSNames([]string{getDomain(controller.namespace)}),
+		k8scertmanager.SetUsages(k8scertmanager.UsageServerAuth, k8scertmanager.UsageDigitalSignature, k8scertmanager.UsageKeyEncipherment),
+	)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	err = k8scertmanager.CreateOrUpdate(ctx, k8scertmanager.Query(controller.client, controller.apiReader, log), certificate)
+	if err != nil {
+		return false, err
+	}
+
+	if !certSecret.existsInCluster || !isIssuedByCertManager(certSecret) {
+		log.Info("waiting for cert-manager to issue the webhook certificates", "secret", buildSecretName())
+
+		return false, nil
+	}
+
+	if len(certSecret.secret.Data[RootCert]) == 0 {
+		return false, errors.New(errorIssuerWithoutCA)
+	}
+
+	certSecret.certificates = &Certs{Data: certSecret.secret.Data}
+
+	return true, nil
+}
+
+// deleteCertManagerCertificate removes the Certificate if the webhook certificates were issued by cert-manager before,
+// so that cert-manager doesn't overwrite the self-signed certificates again.
+func (controller *WebhookCertificateController) deleteCertManagerCertificate(ctx context.Context, certSecret *certificateSecret) error {
+	if !certSecret.existsInCluster || !isIssuedByCertManager(certSecret) {
+		return nil
+	}
+
+	err := k8scertmanager.Delete(ctx, k8scertmanager.Query(controller.client, controller.apiReader, log), webhook.DeploymentName, controller.namespace)
+	if err != nil {
+		return err
+	}
+
+	delete(certSecret.secret.Annotations, certManagerCertificateNameAnnotation)
+
+	return nil
+}
+
+func isIssuedByCertManager(certSecret *certificateSecret) bool {
+	return certSecret.secret.Annotations[certManagerCertificateNameAnnotation] == webhook.DeploymentName && len(certSecret.secret.Data[ServerCert]) > 0
+}
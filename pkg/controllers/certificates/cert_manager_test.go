@@ -0,0 +1,137 @@
+package certificates
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/certmanager"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8scertmanager"
+	"github.com/Dynatrace/dynatrace-operator/pkg/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestGetCertManagerIssuerRef(t *testing.T) {
+	t.Run("not configured", func(t *testing.T) {
+		t.Setenv(CertManagerIssuerNameEnv, "")
+
+		assert.Nil(t, getCertManagerIssuerRef())
+	})
+	t.Run("configured", func(t *testing.T) {
+		t.Setenv(CertManagerIssuerNameEnv, "issuer")
+		t.Setenv(CertManagerIssuerKindEnv, "ClusterIssuer")
+		t.Setenv(CertManagerIssuerGroupEnv, "")
+
+		assert.Equal(t, &certmanager.IssuerRef{Name: "issuer", Kind: "ClusterIssuer"}, getCertManagerIssuerRef())
+	})
+}
+
+func TestReconcileCertificate_CertManager(t *testing.T) {
+	issuerRef := &certmanager.IssuerRef{Name: "issuer", Kind: "ClusterIssuer"}
+
+	t.Run("waits for cert-manager to issue the certificates", func(t *testing.T) {
+		clt := newFakeClientBuilder().WithCRD().Build()
+		controller, request := prepareController(clt)
+		controller.issuerRef = issuerRef
+
+		res, err := controller.Reconcile(t.Context(), request)
+		require.NoError(t, err)
+		assert.Equal(t, certManagerRetryDuration, res.RequeueAfter)
+
+		certificate := &unstructured.Unstructured{}
+		certificate.SetGroupVersionKind(k8scertmanager.CertificateGVK)
+		require.NoError(t, clt.Get(t.Context(), client.ObjectKey{Name: webhook.DeploymentName, Namespace: testNamespace}, certificate))
+
+		secretName, _, _ := unstructured.NestedString(certificate.Object, "spec", "secretName")
+		assert.Equal(t, expectedSecretName, secretName)
+
+		dnsNames, _, _ := unstructured.NestedStringSlice(certificate.Object, "spec", "dnsNames")
+		assert.Equal(t, []string{testDomain}, dnsNames)
+
+		err = clt.Get(t.Context(), client.ObjectKey{Name: expectedSecretName, Namespace: testNamespace}, &corev1.Secret{})
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+	t.Run("uses the issued certificates", func(t *testing.T) {
+		issued := createIssuedTestSecret(map[string][]byte{RootCert: []byte("ca"), ServerCert: []byte("cert"), ServerKey: []byte("key")})
+		clt := newFakeClientBuilder().WithCRD().Build()
+		require.NoError(t, clt.Create(t.Context(), issued))
+
+		controller, request := prepareController(clt)
+		controller.issuerRef = issuerRef
+
+		res, err := controller.Reconcile(t.Context(), request)
+		require.NoError(t, err)
+		assert.Equal(t, SuccessDuration, res.RequeueAfter)
+
+		var secret corev1.Secret
+		require.NoError(t, clt.Get(t.Context(), client.ObjectKey{Name: expectedSecretName, Namespace: testNamespace}, &secret))
+		assert.Equal(t, issued.Data, secret.Data)
+
+		var mutatingWebhookConfig admissionregistrationv1.MutatingWebhookConfiguration
+		require.NoError(t, clt.Get(t.Context(), client.ObjectKey{Name: webhook.DeploymentName}, &mutatingWebhookConfig))
+
+		for _, mutatingWebhook := range mutatingWebhookConfig.Webhooks {
+			assert.Equal(t, []byte("ca"), mutatingWebhook.ClientConfig.CABundle)
+		}
+	})
+	t.Run("issuer without CA", func(t *testing.T) {
+		clt := newFakeClientBuilder().WithCRD().Build()
+		require.NoError(t, clt.Create(t.Context(), createIssuedTestSecret(map[string][]byte{ServerCert: []byte("cert"), ServerKey: []byte("key")})))
+
+		controller, request := prepareController(clt)
+		controller.issuerRef = issuerRef
+
+		_, err := controller.Reconcile(t.Context(), request)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errorIssuerWithoutCA)
+	})
+	t.Run("switching back to self-signed certificates removes the Certificate", func(t *testing.T) {
+		clt := newFakeClientBuilder().WithCRD().Build()
+		require.NoError(t, clt.Create(t.Context(), createIssuedTestSecret(map[string][]byte{RootCert: []byte("ca"), ServerCert: []byte("cert"), ServerKey: []byte("key")})))
+
+		controller, request := prepareController(clt)
+		controller.issuerRef = issuerRef
+
+		_, err := controller.Reconcile(t.Context(), request)
+		require.NoError(t, err)
+
+		controller.issuerRef = nil
+
+		_, err = controller.Reconcile(t.Context(), request)
+		require.NoError(t, err)
+
+		certificate := &unstructured.Unstructured{}
+		certificate.SetGroupVersionKind(k8scertmanager.CertificateGVK)
+		err = clt.Get(t.Context(), client.ObjectKey{Name: webhook.DeploymentName, Namespace: testNamespace}, certificate)
+		assert.True(t, k8serrors.IsNotFound(err))
+
+		var secret corev1.Secret
+		require.NoError(t, clt.Get(t.Context(), client.ObjectKey{Name: expectedSecretName, Namespace: testNamespace}, &secret))
+		assert.Contains(t, secret.Data, RootKey)
+		assert.NotContains(t, secret.Annotations, certManagerCertificateNameAnnotation)
+
+		// the previously issued CA stays trusted during the transition
+		assert.Equal(t, []byte("ca"), secret.Data[RootCertOld])
+
+		var mutatingWebhookConfig admissionregistrationv1.MutatingWebhookConfiguration
+		require.NoError(t, clt.Get(t.Context(), client.ObjectKey{Name: webhook.DeploymentName}, &mutatingWebhookConfig))
+		assert.Equal(t, slices.Concat(secret.Data[RootCert], secret.Data[RootCertOld]), mutatingWebhookConfig.Webhooks[0].ClientConfig.CABundle)
+	})
+}
+
+func createIssuedTestSecret(data map[string][]byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        expectedSecretName,
+			Namespace:   testNamespace,
+			Annotations: map[string]string{certManagerCertificateNameAnnotation: webhook.DeploymentName},
+		},
+		Data: data,
+	}
+}
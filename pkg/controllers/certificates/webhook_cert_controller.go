@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/certmanager"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/eventfilter"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8scrd"
 	"github.com/Dynatrace/dynatrace-operator/pkg/webhook"
@@ -48,6 +49,7 @@ func newWebhookCertificateController(mgr manager.Manager, cancelMgr context.Canc
 		cancelMgrFunc: cancelMgr,
 		client:        mgr.GetClient(),
 		apiReader:     mgr.GetAPIReader(),
+		issuerRef:     getCertManagerIssuerRef(),
 	}
 }
 
@@ -55,6 +57,7 @@ type WebhookCertificateController struct {
 	client        client.Client
 	apiReader     client.Reader
 	cancelMgrFunc context.CancelFunc
+	issuerRef     *certmanager.IssuerRef
 	namespace     string
 }
 
@@ -91,9 +94,11 @@ func (controller *WebhookCertificateController) Reconcile(ctx context.Context, r
 		return reconcile.Result{}, errors.WithStack(err)
 	}
 
-	err = certSecret.validateCertificates(controller.namespace)
+	ready, err := controller.prepareCertificates(ctx, &webhookDeployment, certSecret)
 	if err != nil {
 		return reconcile.Result{}, errors.WithStack(err)
+	} else if !ready {
+		return reconcile.Result{RequeueAfter: certManagerRetryDuration}, nil
 	}
 
 	mutatingWebhookClientConfigs := getClientConfigsFromMutatingWebhook(mutatingWebhookConfiguration)
@@ -138,6 +143,20 @@ func (controller *WebhookCertificateController) Reconcile(ctx context.Context, r
 	return reconcile.Result{RequeueAfter: SuccessDuration}, nil
 }
 
+// prepareCertificates either validates and renews the self-signed certificates or takes the ones issued by cert-manager.
+func (controller *WebhookCertificateController) prepareCertificates(ctx context.Context, webhookDeployment *appsv1.Deployment, certSecret *certificateSecret) (bool, error) {
+	if controller.issuerRef != nil {
+		return controller.reconcileCertManagerCertificate(ctx, webhookDeployment, certSecret)
+	}
+
+	err := controller.deleteCertManagerCertificate(ctx, certSecret)
+	if err != nil {
+		return false, err
+	}
+
+	return true, certSecret.validateCertificates(controller.namespace)
+}
+
 func (controller *WebhookCertificateController) isUpToDate(certSecret *certificateSecret, mutatingWebhookClientConfigs []*admissionregistrationv1.WebhookClientConfig, validatingWebhookConfigConfigs []*admissionregistrationv1.WebhookClientConfig, crd *apiextensionsv1.CustomResourceDefinition) bool {
 	areMutatingWebhookConfigsValid := certSecret.areWebhookConfigsValid(mutatingWebhookClientConfigs)
 	areValidatingWebhookConfigsValid := certSecret.areWebhookConfigsValid(validatingWebhookConfigConfigs)
@@ -2,11 +2,13 @@ package capability
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/activegate"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/consts"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8slabel"
 	"k8s.io/utils/net"
 	"k8s.io/utils/ptr"
 )
@@ -25,11 +27,14 @@ type Capability interface {
 	Enabled() bool
 	ArgName() string
 	Properties() *activegate.CapabilityProperties
+	// PoolName returns the name of the ActiveGate pool, empty for the main ActiveGate.
+	PoolName() string
 }
 
 type capabilityBase struct {
 	properties *activegate.CapabilityProperties
 	argName    string
+	poolName   string
 }
 
 func (capability *capabilityBase) Enabled() bool {
@@ -44,6 +49,10 @@ func (capability *capabilityBase) ArgName() string {
 	return capability.argName
 }
 
+func (capability *capabilityBase) PoolName() string {
+	return capability.poolName
+}
+
 func CalculateStatefulSetName(dynakubeName string) string {
 	return dynakubeName + "-" + consts.MultiActiveGateName
 }
@@ -66,9 +75,49 @@ func NewMultiCapability(dk *dynakube.DynaKube) Capability {
 		mc.properties.Replicas = ptr.To(int32(1))
 	}
 
+	capabilityArgs := buildCapabilityArgs(dk.Spec.ActiveGate.Capabilities)
+
+	if dk.Extensions().IsAnyEnabled() {
+		capabilityArgs = append(capabilityArgs, "extension_controller")
+	}
+
+	if dk.TelemetryIngest().IsEnabled() || dk.OTLPExporterConfiguration().IsEnabled() {
+		capabilityArgs = append(capabilityArgs, "log_analytics_collector", "generic_ingest", "otlp_ingest")
+	}
+
+	mc.argName = strings.Join(capabilityArgs, ",")
+
+	return &mc
+}
+
+// NewPoolCapability provides the capability of an ActiveGate pool.
+// Only the image and custom properties are taken over from the main ActiveGate, everything else is configured per pool.
+func NewPoolCapability(dk *dynakube.DynaKube, pool activegate.PoolSpec) Capability {
+	return &MultiCapability{
+		capabilityBase{
+			properties: &activegate.CapabilityProperties{
+				CustomProperties:          dk.Spec.ActiveGate.CustomProperties,
+				Image:                     dk.Spec.ActiveGate.Image,
+				ImagePullPolicy:           dk.Spec.ActiveGate.ImagePullPolicy,
+				NodeSelector:              pool.NodeSelector,
+				Labels:                    pool.Labels,
+				Replicas:                  pool.Replicas,
+				Group:                     pool.Group,
+				Resources:                 pool.Resources,
+				Tolerations:               pool.Tolerations,
+				Env:                       pool.Env,
+				TopologySpreadConstraints: pool.TopologySpreadConstraints,
+			},
+			argName:  strings.Join(buildCapabilityArgs(pool.Capabilities), ","),
+			poolName: pool.Name,
+		},
+	}
+}
+
+func buildCapabilityArgs(capabilities []activegate.CapabilityDisplayName) []string {
 	capabilityArgs := []string{}
 
-	for _, capName := range dk.Spec.ActiveGate.Capabilities {
+	for _, capName := range capabilities {
 		argName, ok := activeGateCapabilities[capName]
 		if !ok {
 			continue
@@ -77,17 +126,41 @@ func NewMultiCapability(dk *dynakube.DynaKube) Capability {
 		capabilityArgs = append(capabilityArgs, argName)
 	}
 
-	if dk.Extensions().IsAnyEnabled() {
-		capabilityArgs = append(capabilityArgs, "extension_controller")
+	return capabilityArgs
+}
+
+// IsAutoscaled returns true if the replicas of the ActiveGate the capability belongs to are managed by a HorizontalPodAutoscaler.
+// Pools without fixed replicas are scaled with the autoscaling settings of the main ActiveGate.
+func IsAutoscaled(dk *dynakube.DynaKube, capability Capability) bool {
+	if !dk.ActiveGate().IsAutoscalingEnabled() {
+		return false
 	}
 
-	if dk.TelemetryIngest().IsEnabled() || dk.OTLPExporterConfiguration().IsEnabled() {
-		capabilityArgs = append(capabilityArgs, "log_analytics_collector", "generic_ingest", "otlp_ingest")
+	return capability.PoolName() == "" || capability.Properties().Replicas == nil
+}
+
+// BuildPoolName returns the name of the StatefulSet and Service of the given ActiveGate pool.
+func BuildPoolName(dynakubeName, poolName string) string {
+	return BuildServiceName(dynakubeName) + "-" + poolName
+}
+
+// BuildName returns the name of the StatefulSet and Service of the ActiveGate the capability belongs to.
+func BuildName(dynakubeName string, capability Capability) string {
+	if capability.PoolName() != "" {
+		return BuildPoolName(dynakubeName, capability.PoolName())
 	}
 
-	mc.argName = strings.Join(capabilityArgs, ",")
+	return BuildServiceName(dynakubeName)
+}
 
-	return &mc
+// BuildAppName returns the value of the app name label of the ActiveGate pods the capability belongs to.
+// The pods of each pool get their own app name, so the selectors of the main ActiveGate don't match them.
+func BuildAppName(capability Capability) string {
+	if capability.PoolName() != "" {
+		return k8slabel.ActiveGateComponentLabel + "-" + capability.PoolName()
+	}
+
+	return k8slabel.ActiveGateComponentLabel
 }
 
 func BuildServiceName(dynakubeName string) string {
@@ -97,9 +170,28 @@ func BuildServiceName(dynakubeName string) string {
 // BuildDNSEntryPoint will create a string listing of the full DNS entry points for the Service of the ActiveGate in the provided DynaKube.
 // Example: https://34.118.233.238:443,https://dynakube-activegate.dynatrace:443
 func BuildDNSEntryPoint(dk dynakube.DynaKube) string {
+	return buildDNSEntryPoint(dk.Status.ActiveGate.ServiceIPs, BuildServiceName(dk.Name), dk.Namespace, dk.ActiveGate().IsRoutingEnabled())
+}
+
+// BuildPoolDNSEntryPoint works like BuildDNSEntryPoint, but for the Service of the given ActiveGate pool.
+func BuildPoolDNSEntryPoint(dk dynakube.DynaKube, poolName string) string {
+	var serviceIPs []string
+	if poolStatus := dk.Status.ActiveGate.GetPoolStatus(poolName); poolStatus != nil {
+		serviceIPs = poolStatus.ServiceIPs
+	}
+
+	isRoutingEnabled := false
+	if pool := dk.ActiveGate().GetPool(poolName); pool != nil {
+		isRoutingEnabled = slices.Contains(pool.Capabilities, activegate.RoutingCapability.DisplayName)
+	}
+
+	return buildDNSEntryPoint(serviceIPs, BuildPoolName(dk.Name, poolName), dk.Namespace, isRoutingEnabled)
+}
+
+func buildDNSEntryPoint(serviceIPs []string, serviceName, namespace string, isRoutingEnabled bool) string {
 	entries := []string{}
 
-	for _, ip := range dk.Status.ActiveGate.ServiceIPs {
+	for _, ip := range serviceIPs {
 		if net.IsIPv6String(ip) {
 			ip = "[" + ip + "]"
 		}
@@ -108,8 +200,8 @@ func BuildDNSEntryPoint(dk dynakube.DynaKube) string {
 		entries = append(entries, serviceHostEntry)
 	}
 
-	if dk.ActiveGate().IsRoutingEnabled() {
-		serviceDomain := buildServiceDomainName(dk.Name, dk.Namespace)
+	if isRoutingEnabled {
+		serviceDomain := buildServiceDomainName(serviceName, namespace)
 		serviceDomainEntry := buildDNSEntry(serviceDomain)
 		entries = append(entries, serviceDomainEntry)
 	}
@@ -142,8 +234,8 @@ func buildServiceHostName(host string) string {
 	return fmt.Sprintf("%s:%d", host, consts.HTTPSServicePort)
 }
 
-func buildServiceDomainName(dynakubeName string, namespaceName string) string {
-	return fmt.Sprintf("%s.%s:%d", BuildServiceName(dynakubeName), namespaceName, consts.HTTPSServicePort)
+func buildServiceDomainName(serviceName string, namespaceName string) string {
+	return fmt.Sprintf("%s.%s:%d", serviceName, namespaceName, consts.HTTPSServicePort)
 }
 
 func buildDNSEntry(host string) string {
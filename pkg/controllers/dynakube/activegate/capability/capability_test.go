@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 const (
@@ -168,8 +169,65 @@ func TestNewMultiCapabilityWithDebugging(t *testing.T) {
 	})
 }
 
+func TestNewPoolCapability(t *testing.T) {
+	dk := buildDynakube(capabilities, true, true)
+	dk.Spec.ActiveGate.Image = "custom-image"
+	dk.Spec.ActiveGate.Group = "main-group"
+	pool := activegate.PoolSpec{
+		Name:         "routing",
+		Capabilities: []activegate.CapabilityDisplayName{activegate.RoutingCapability.DisplayName, activegate.MetricsIngestCapability.DisplayName},
+		Group:        "pool-group",
+		Replicas:     ptr.To(int32(3)),
+	}
+
+	pc := NewPoolCapability(dk, pool)
+	require.NotNil(t, pc)
+	assert.True(t, pc.Enabled())
+	assert.Equal(t, "routing", pc.PoolName())
+	assert.Equal(t, "MSGrouter,metrics_ingest", pc.ArgName())
+	assert.Equal(t, "custom-image", pc.Properties().Image)
+	assert.Equal(t, "pool-group", pc.Properties().Group)
+	assert.Equal(t, int32(3), *pc.Properties().Replicas)
+
+	assert.Empty(t, NewMultiCapability(dk).PoolName())
+	assert.Equal(t, testName+"-activegate-routing", BuildName(testName, pc))
+	assert.Equal(t, testName+"-activegate", BuildName(testName, NewMultiCapability(dk)))
+}
+
+func TestBuildPoolDNSEntryPoint(t *testing.T) {
+	dk := &dynakube.DynaKube{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "dynakube",
+			Namespace: "dynatrace",
+		},
+		Spec: dynakube.DynaKubeSpec{
+			ActiveGate: activegate.Spec{
+				Capabilities: []activegate.CapabilityDisplayName{activegate.KubeMonCapability.DisplayName},
+				Pools: []activegate.PoolSpec{
+					{Name: "routing", Capabilities: []activegate.CapabilityDisplayName{activegate.RoutingCapability.DisplayName}},
+					{Name: "api", Capabilities: []activegate.CapabilityDisplayName{activegate.DynatraceAPICapability.DisplayName}},
+				},
+			},
+		},
+		Status: dynakube.DynaKubeStatus{
+			ActiveGate: activegate.Status{
+				ServiceIPs: []string{"1.2.3.4"},
+				Pools: []activegate.PoolStatus{
+					{Name: "routing", ServiceIPs: []string{"5.6.7.8"}},
+					{Name: "api", ServiceIPs: []string{"8.7.6.5"}},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, "https://5.6.7.8:443/communication,https://dynakube-activegate-routing.dynatrace:443/communication", BuildPoolDNSEntryPoint(*dk, "routing"))
+	assert.Equal(t, "https://8.7.6.5:443/communication", BuildPoolDNSEntryPoint(*dk, "api"))
+	assert.Empty(t, BuildPoolDNSEntryPoint(*dk, "missing"))
+	assert.Equal(t, "https://1.2.3.4:443/communication", BuildDNSEntryPoint(*dk))
+}
+
 func TestBuildServiceDomainNameForDNSEntryPoint(t *testing.T) {
-	actual := buildServiceDomainName("test-name", "test-namespace")
+	actual := buildServiceDomainName(BuildServiceName("test-name"), "test-namespace")
 	assert.NotEmpty(t, actual)
 
 	expected := "test-name-activegate.test-namespace:443"
@@ -178,7 +236,7 @@ func TestBuildServiceDomainNameForDNSEntryPoint(t *testing.T) {
 	testStringName := "this---dynakube_string"
 	testNamespace := "this_is---namespace_string"
 	expected = "this---dynakube_string-activegate.this_is---namespace_string:443"
-	actual = buildServiceDomainName(testStringName, testNamespace)
+	actual = buildServiceDomainName(BuildServiceName(testStringName), testNamespace)
 	assert.Equal(t, expected, actual)
 }
 
@@ -27,9 +27,10 @@ const (
 	EnvDtDNSEntryPoint   = "DT_DNS_ENTRY_POINT"
 	EnvDtHTTPPort        = "DT_HTTP_PORT"
 
-	AnnotationActiveGateConfigurationHash = api.InternalFlagPrefix + "activegate-configuration-hash"
-	AnnotationActiveGateTenantTokenHash   = api.InternalFlagPrefix + "activegate-tenant-token-hash"
-	AnnotationActiveGateContainerAppArmor = "container.apparmor.security.beta.kubernetes.io/" + ActiveGateContainerName
+	AnnotationActiveGateConfigurationHash  = api.InternalFlagPrefix + "activegate-configuration-hash"
+	AnnotationActiveGateTenantTokenHash    = api.InternalFlagPrefix + "activegate-tenant-token-hash"
+	AnnotationActiveGateTLSCertificateHash = api.InternalFlagPrefix + "activegate-tls-certificate-hash"
+	AnnotationActiveGateContainerAppArmor  = "container.apparmor.security.beta.kubernetes.io/" + ActiveGateContainerName
 
 	GatewayConfigVolumeName  = "ag-lib-gateway-config"
 	GatewayLibTempVolumeName = "ag-lib-gateway-temp"
@@ -11,21 +11,6 @@ import (
 )
 
 func CreateService(dk *dynakube.DynaKube) *corev1.Service {
-	ports := []corev1.ServicePort{
-		{
-			Name:       consts.HTTPSServicePortName,
-			Protocol:   corev1.ProtocolTCP,
-			Port:       consts.HTTPSServicePort,
-			TargetPort: intstr.FromString(consts.HTTPSServicePortName),
-		},
-		{
-			Name:       consts.HTTPServicePortName,
-			Protocol:   corev1.ProtocolTCP,
-			Port:       consts.HTTPServicePort,
-			TargetPort: intstr.FromString(consts.HTTPServicePortName),
-		},
-	}
-
 	coreLabels := k8slabel.NewCoreLabels(dk.Name, k8slabel.ActiveGateComponentLabel)
 
 	return &corev1.Service{
@@ -37,7 +22,25 @@ func CreateService(dk *dynakube.DynaKube) *corev1.Service {
 		Spec: corev1.ServiceSpec{
 			Type:     corev1.ServiceTypeClusterIP,
 			Selector: buildSelectorLabels(dk.Name),
-			Ports:    ports,
+			Ports:    BuildServicePorts(),
+		},
+	}
+}
+
+// BuildServicePorts returns the ports of the ActiveGate Service, which are the same for the main ActiveGate and the pools.
+func BuildServicePorts() []corev1.ServicePort {
+	return []corev1.ServicePort{
+		{
+			Name:       consts.HTTPSServicePortName,
+			Protocol:   corev1.ProtocolTCP,
+			Port:       consts.HTTPSServicePort,
+			TargetPort: intstr.FromString(consts.HTTPSServicePortName),
+		},
+		{
+			Name:       consts.HTTPServicePortName,
+			Protocol:   corev1.ProtocolTCP,
+			Port:       consts.HTTPServicePort,
+			TargetPort: intstr.FromString(consts.HTTPServicePortName),
 		},
 	}
 }
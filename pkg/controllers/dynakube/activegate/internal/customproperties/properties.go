@@ -0,0 +1,121 @@
+package customproperties
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/activegate"
+)
+
+const (
+	collectorSection          = "collector"
+	clientSection             = "http.client"
+	clientExternalSection     = "http.client.external"
+	collectorModuleNameSuffix = "_enabled"
+
+	proxyServerFieldName       = "proxy-server"
+	proxyPortFieldName         = "proxy-port"
+	maxConnectionsFieldName    = "max-connections"
+	connectTimeoutFieldName    = "connection-timeout"
+	socketTimeoutFieldName     = "socket-timeout"
+	dumpSupportedFieldName     = "DumpSupported"
+	nonProxyHostsValueSplitter = "|"
+)
+
+type property struct {
+	section string
+	key     string
+	value   string
+}
+
+// buildProperties renders the typed properties of the ActiveGate, in a stable order.
+func buildProperties(spec *activegate.PropertiesSpec) []property {
+	if spec == nil {
+		return nil
+	}
+
+	var properties []property
+
+	for _, module := range slices.Sorted(maps.Keys(spec.Collector)) {
+		properties = append(properties, property{collectorSection, module + collectorModuleNameSuffix, strconv.FormatBool(spec.Collector[module])})
+	}
+
+	if spec.Debug != nil && spec.Debug.DumpSupported != nil {
+		properties = append(properties, property{collectorSection, dumpSupportedFieldName, strconv.FormatBool(*spec.Debug.DumpSupported)})
+	}
+
+	if spec.Connections != nil {
+		properties = appendInt32Property(properties, clientSection, maxConnectionsFieldName, spec.Connections.MaxConnections)
+		properties = appendInt32Property(properties, clientSection, connectTimeoutFieldName, spec.Connections.ConnectTimeoutSeconds)
+		properties = appendInt32Property(properties, clientSection, socketTimeoutFieldName, spec.Connections.SocketTimeoutSeconds)
+	}
+
+	if spec.Proxy != nil {
+		properties = append(properties,
+			property{clientExternalSection, proxyServerFieldName, spec.Proxy.Server},
+			property{clientExternalSection, proxyPortFieldName, strconv.Itoa(int(spec.Proxy.Port))},
+		)
+
+		if len(spec.Proxy.NonProxyHosts) > 0 {
+			properties = append(properties, property{clientExternalSection, noProxyFieldName, strings.Join(spec.Proxy.NonProxyHosts, nonProxyHostsValueSplitter)})
+		}
+	}
+
+	return properties
+}
+
+func appendInt32Property(properties []property, section, key string, value *int32) []property {
+	if value == nil {
+		return properties
+	}
+
+	return append(properties, property{section, key, strconv.Itoa(int(*value))})
+}
+
+// mergeProperties sets the properties in the lines of a properties file.
+// Keys already present in a section are overwritten, other keys are added to the end of their section.
+// Sections that are not present yet are added to the end of the file.
+func mergeProperties(lines []string, properties []property) []string {
+	for _, prop := range properties {
+		lines = mergeProperty(lines, prop)
+	}
+
+	return lines
+}
+
+func mergeProperty(lines []string, prop property) []string {
+	entry := fmt.Sprintf("%s=%s", prop.key, prop.value)
+
+	sectionStart := slices.IndexFunc(lines, func(line string) bool {
+		return strings.TrimSpace(line) == "["+prop.section+"]"
+	})
+	if sectionStart < 0 {
+		return append(lines, "["+prop.section+"]", entry)
+	}
+
+	sectionEnd := sectionStart + 1
+
+	for ; sectionEnd < len(lines); sectionEnd++ {
+		line := strings.TrimSpace(lines[sectionEnd])
+		if strings.HasPrefix(line, "[") {
+			break
+		}
+
+		key, _, found := strings.Cut(line, "=")
+		if found && strings.TrimSpace(key) == prop.key {
+			lines[sectionEnd] = entry
+
+			return lines
+		}
+	}
+
+	// keep trailing blank lines between the sections
+	for sectionEnd > sectionStart+1 && strings.TrimSpace(lines[sectionEnd-1]) == "" {
+		sectionEnd--
+	}
+
+	return slices.Insert(lines, sectionEnd, entry)
+}
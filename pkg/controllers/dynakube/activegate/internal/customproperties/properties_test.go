@@ -0,0 +1,78 @@
+package customproperties
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/activegate"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+)
+
+func TestBuildProperties(t *testing.T) {
+	t.Run("no properties", func(t *testing.T) {
+		assert.Empty(t, buildProperties(nil))
+		assert.Empty(t, buildProperties(&activegate.PropertiesSpec{}))
+	})
+
+	t.Run("all properties in stable order", func(t *testing.T) {
+		spec := &activegate.PropertiesSpec{
+			Collector: map[string]bool{"zremote": false, "aws_monitoring": true},
+			Proxy: &activegate.ProxyPropertiesSpec{
+				Server:        "proxy",
+				Port:          8080,
+				NonProxyHosts: []string{"a.com", "b.com"},
+			},
+			Connections: &activegate.ConnectionPropertiesSpec{
+				MaxConnections:       ptr.To(int32(100)),
+				SocketTimeoutSeconds: ptr.To(int32(30)),
+			},
+			Debug: &activegate.DebugPropertiesSpec{DumpSupported: ptr.To(true)},
+		}
+
+		expected := []property{
+			{collectorSection, "aws_monitoring_enabled", "true"},
+			{collectorSection, "zremote_enabled", "false"},
+			{collectorSection, dumpSupportedFieldName, "true"},
+			{clientSection, maxConnectionsFieldName, "100"},
+			{clientSection, socketTimeoutFieldName, "30"},
+			{clientExternalSection, proxyServerFieldName, "proxy"},
+			{clientExternalSection, proxyPortFieldName, "8080"},
+			{clientExternalSection, noProxyFieldName, "a.com|b.com"},
+		}
+
+		assert.Equal(t, expected, buildProperties(spec))
+	})
+}
+
+func TestMergeProperties(t *testing.T) {
+	merge := func(raw string, properties ...property) string {
+		return strings.Join(mergeProperties(strings.Split(raw, "\n"), properties), "\n")
+	}
+
+	t.Run("overwrites existing key", func(t *testing.T) {
+		raw := "[collector]\nDumpSupported = false\nother=1"
+
+		assert.Equal(t, "[collector]\nDumpSupported=true\nother=1", merge(raw, property{collectorSection, dumpSupportedFieldName, "true"}))
+	})
+
+	t.Run("adds key to end of existing section", func(t *testing.T) {
+		raw := "[collector]\nother=1\n\n[http.client]\nsocket-timeout=5"
+
+		assert.Equal(t, "[collector]\nother=1\nDumpSupported=true\n\n[http.client]\nsocket-timeout=5", merge(raw, property{collectorSection, dumpSupportedFieldName, "true"}))
+	})
+
+	t.Run("does not touch same key of another section", func(t *testing.T) {
+		raw := "[http.client.internal]\nproxy-server=internal"
+
+		expected := "[http.client.internal]\nproxy-server=internal\n[http.client.external]\nproxy-server=external"
+
+		assert.Equal(t, expected, merge(raw, property{clientExternalSection, proxyServerFieldName, "external"}))
+	})
+
+	t.Run("adds missing sections to end of file", func(t *testing.T) {
+		expected := "[collector]\na_enabled=true\nb_enabled=false"
+
+		assert.Equal(t, expected, strings.TrimPrefix(merge("", property{collectorSection, "a_enabled", "true"}, property{collectorSection, "b_enabled", "false"}), "\n"))
+	})
+}
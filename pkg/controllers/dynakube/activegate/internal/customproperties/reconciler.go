@@ -47,7 +47,7 @@ func NewReconciler(clt client.Client, apiReader client.Reader, dk *dynakube.Dyna
 }
 
 func (r *Reconciler) Reconcile(ctx context.Context) error {
-	if r.customPropertiesSource == nil && !r.dk.NeedsCustomNoProxy() {
+	if r.customPropertiesSource == nil && r.dk.Spec.ActiveGate.Properties == nil && !r.dk.NeedsCustomNoProxy() {
 		if meta.FindStatusCondition(*r.dk.Conditions(), customPropertiesConditionType) == nil {
 			return nil
 		}
@@ -113,6 +113,7 @@ func (r *Reconciler) buildCustomPropertiesValue(ctx context.Context) ([]byte, er
 	}
 
 	lines := strings.Split(value, "\n")
+	lines = mergeProperties(lines, buildProperties(r.dk.Spec.ActiveGate.Properties))
 
 	if r.dk.NeedsCustomNoProxy() {
 		lines = r.addNonProxyHostsSettingsToValue(lines)
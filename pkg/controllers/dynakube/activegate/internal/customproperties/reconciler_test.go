@@ -5,6 +5,7 @@ import (
 
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/exp"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/activegate"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/value"
 	"github.com/stretchr/testify/assert"
@@ -209,4 +210,35 @@ func TestReconciler_Reconcile(t *testing.T) {
 		assert.Contains(t, customPropertiesSecret.Data, DataKey)
 		assert.Equal(t, customPropertiesSecret.Data[DataKey], []byte(testKey))
 	})
+
+	t.Run("Create merges typed properties into custom properties", func(t *testing.T) {
+		valueSource := value.Source{Value: "[collector]\naws_monitoring_enabled=true\nzremote_enabled=true"}
+		dk := &dynakube.DynaKube{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testName,
+				Namespace: testNamespace,
+			},
+			Spec: dynakube.DynaKubeSpec{
+				ActiveGate: activegate.Spec{
+					Properties: &activegate.PropertiesSpec{
+						Collector: map[string]bool{"aws_monitoring": false},
+						Proxy:     &activegate.ProxyPropertiesSpec{Server: "proxy.example.com", Port: 3128},
+					},
+				},
+			}}
+		fakeClient := fake.NewClient(dk)
+		r := NewReconciler(fakeClient, fakeClient, dk, testOwner, &valueSource)
+		err := r.Reconcile(t.Context())
+
+		require.NoError(t, err)
+
+		var customPropertiesSecret corev1.Secret
+		err = fakeClient.Get(t.Context(), client.ObjectKey{Name: r.buildCustomPropertiesName(testName), Namespace: testNamespace}, &customPropertiesSecret)
+
+		require.NoError(t, err)
+
+		expectedValue := "[collector]\naws_monitoring_enabled=false\nzremote_enabled=true\n[http.client.external]\nproxy-server=proxy.example.com\nproxy-port=3128"
+
+		assert.Equal(t, expectedValue, string(customPropertiesSecret.Data[DataKey]))
+	})
 }
@@ -0,0 +1,201 @@
+package exposure
+
+import (
+	"context"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/activegate"
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers"
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/capability"
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/consts"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8slabel"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8sgatewayroute"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8singress"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8sservice"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ controllers.Reconciler = &Reconciler{}
+
+type Reconciler struct {
+	dk         *dynakube.DynaKube
+	services   k8sservice.QueryObject
+	ingresses  k8singress.QueryObject
+	httpRoutes k8sgatewayroute.QueryObject
+	tlsRoutes  k8sgatewayroute.QueryObject
+}
+
+func NewReconciler(clt client.Client, apiReader client.Reader, dk *dynakube.DynaKube) *Reconciler {
+	return &Reconciler{
+		dk:         dk,
+		services:   k8sservice.QueryByHash(clt, apiReader, log),
+		ingresses:  k8singress.Query(clt, apiReader, log),
+		httpRoutes: k8sgatewayroute.Query(k8sgatewayroute.HTTPRouteGVK, clt, apiReader, log),
+		tlsRoutes:  k8sgatewayroute.Query(k8sgatewayroute.TLSRouteGVK, clt, apiReader, log),
+	}
+}
+
+// Reconcile creates or updates the LoadBalancer Service, Ingress and Gateway API route of the ActiveGate, if configured,
+// otherwise it removes previously created ones.
+func (r *Reconciler) Reconcile(ctx context.Context) error {
+	if err := r.reconcileLoadBalancer(ctx); err != nil {
+		return err
+	}
+
+	if err := r.reconcileIngress(ctx); err != nil {
+		return err
+	}
+
+	return r.reconcileGatewayRoute(ctx)
+}
+
+func (r *Reconciler) reconcileLoadBalancer(ctx context.Context) error {
+	name := r.dk.ActiveGate().GetExternalServiceName()
+
+	if !r.dk.ActiveGate().IsEnabled() || !r.dk.ActiveGate().IsLoadBalancerEnabled() {
+		service, err := r.services.Get(ctx, types.NamespacedName{Name: name, Namespace: r.dk.Namespace})
+		if k8serrors.IsNotFound(err) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		return r.services.Delete(ctx, service)
+	}
+
+	spec := r.dk.Spec.ActiveGate.Exposure.LoadBalancer
+	ports := []corev1.ServicePort{
+		{
+			Name:       consts.HTTPSServicePortName,
+			Protocol:   corev1.ProtocolTCP,
+			Port:       consts.HTTPSServicePort,
+			TargetPort: intstr.FromString(consts.HTTPSServicePortName),
+		},
+	}
+	appLabels := k8slabel.NewAppLabels(k8slabel.ActiveGateComponentLabel, r.dk.Name, "", "")
+
+	desired, err := k8sservice.Build(r.dk, name, appLabels.BuildMatchLabels(), ports,
+		k8sservice.SetType(corev1.ServiceTypeLoadBalancer),
+		k8sservice.SetLabels(r.coreLabels()),
+		k8sservice.SetAnnotations(spec.Annotations),
+		k8sservice.SetLoadBalancerClass(spec.LoadBalancerClass),
+		k8sservice.SetLoadBalancerSourceRanges(spec.LoadBalancerSourceRanges),
+		k8sservice.SetExternalTrafficPolicy(spec.ExternalTrafficPolicy),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.services.CreateOrUpdate(ctx, desired)
+
+	return err
+}
+
+func (r *Reconciler) reconcileIngress(ctx context.Context) error {
+	name := capability.BuildServiceName(r.dk.Name)
+
+	if !r.dk.ActiveGate().IsEnabled() || !r.dk.ActiveGate().IsIngressEnabled() {
+		ingress, err := r.ingresses.Get(ctx, types.NamespacedName{Name: name, Namespace: r.dk.Namespace})
+		if k8serrors.IsNotFound(err) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		return r.ingresses.Delete(ctx, ingress)
+	}
+
+	spec := r.dk.Spec.ActiveGate.Exposure.Ingress
+	backend := networkingv1.IngressServiceBackend{
+		Name: capability.BuildServiceName(r.dk.Name),
+		Port: networkingv1.ServiceBackendPort{Name: consts.HTTPServicePortName},
+	}
+
+	desired, err := k8singress.Build(r.dk, name, spec.Host, backend,
+		k8singress.SetLabels(r.coreLabels()),
+		k8singress.SetAnnotations(spec.Annotations),
+		k8singress.SetIngressClassName(spec.IngressClassName),
+		k8singress.SetTLS(spec.TLSSecretName, spec.Host),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.ingresses.CreateOrUpdate(ctx, desired)
+
+	return err
+}
+
+// reconcileGatewayRoute keeps at most one route, of the configured kind, as switching the kind must not leave the previous route behind.
+func (r *Reconciler) reconcileGatewayRoute(ctx context.Context) error {
+	kind := activegate.GatewayRouteKind("")
+	if r.dk.ActiveGate().IsEnabled() && r.dk.ActiveGate().IsGatewayRouteEnabled() {
+		kind = r.dk.Spec.ActiveGate.Exposure.Gateway.GetRouteKind()
+	}
+
+	if kind != activegate.HTTPRouteKind {
+		if err := r.deleteGatewayRoute(ctx, r.httpRoutes); err != nil {
+			return err
+		}
+	}
+
+	if kind != activegate.TLSRouteKind {
+		if err := r.deleteGatewayRoute(ctx, r.tlsRoutes); err != nil {
+			return err
+		}
+	}
+
+	switch kind {
+	case activegate.HTTPRouteKind:
+		return r.createOrUpdateGatewayRoute(ctx, r.httpRoutes, consts.HTTPServicePort)
+	case activegate.TLSRouteKind:
+		return r.createOrUpdateGatewayRoute(ctx, r.tlsRoutes, consts.HTTPSServicePort)
+	}
+
+	return nil
+}
+
+func (r *Reconciler) createOrUpdateGatewayRoute(ctx context.Context, routes k8sgatewayroute.QueryObject, port int32) error {
+	spec := r.dk.Spec.ActiveGate.Exposure.Gateway
+
+	parentRefs := make([]k8sgatewayroute.ParentRef, len(spec.ParentRefs))
+	for i, parentRef := range spec.ParentRefs {
+		parentRefs[i] = k8sgatewayroute.ParentRef(parentRef)
+	}
+
+	desired, err := k8sgatewayroute.Build(r.dk, routes.Target.GroupVersionKind(), capability.BuildServiceName(r.dk.Name), parentRefs,
+		k8sgatewayroute.SetLabels(r.coreLabels()),
+		k8sgatewayroute.SetAnnotations(spec.Annotations),
+		k8sgatewayroute.SetHostnames(spec.Hostnames),
+		k8sgatewayroute.SetBackend(capability.BuildServiceName(r.dk.Name), port),
+	)
+	if err != nil {
+		return err
+	}
+
+	return k8sgatewayroute.CreateOrUpdate(ctx, routes, desired)
+}
+
+// deleteGatewayRoute only deletes a route that exists, so clusters without the Gateway API or without exposure don't see any deletes.
+func (r *Reconciler) deleteGatewayRoute(ctx context.Context, routes k8sgatewayroute.QueryObject) error {
+	name := capability.BuildServiceName(r.dk.Name)
+
+	_, err := routes.Get(ctx, types.NamespacedName{Name: name, Namespace: r.dk.Namespace})
+	if k8serrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	return k8sgatewayroute.Delete(ctx, routes, name, r.dk.Namespace)
+}
+
+func (r *Reconciler) coreLabels() map[string]string {
+	return k8slabel.NewCoreLabels(r.dk.Name, k8slabel.ActiveGateComponentLabel).BuildLabels()
+}
@@ -0,0 +1,199 @@
+package exposure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/activegate"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8sgatewayroute"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+const (
+	testNamespace    = "test-namespace"
+	testDynakubeName = "test-dynakube"
+	testHost         = "ag.example.com"
+	testServiceName  = testDynakubeName + "-activegate"
+)
+
+func createDynakube(exposure *activegate.ExposureSpec) *dynakube.DynaKube {
+	return &dynakube.DynaKube{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      testDynakubeName,
+		},
+		Spec: dynakube.DynaKubeSpec{
+			ActiveGate: activegate.Spec{
+				Capabilities: []activegate.CapabilityDisplayName{
+					activegate.RoutingCapability.DisplayName,
+				},
+				Exposure: exposure,
+			},
+		},
+	}
+}
+
+func getRoute(t *testing.T, clt client.Client, gvk schema.GroupVersionKind) (*unstructured.Unstructured, error) {
+	t.Helper()
+
+	route := &unstructured.Unstructured{}
+	route.SetGroupVersionKind(gvk)
+	err := clt.Get(t.Context(), client.ObjectKey{Name: testServiceName, Namespace: testNamespace}, route)
+
+	return route, err
+}
+
+func getBackendPort(t *testing.T, route *unstructured.Unstructured) int64 {
+	t.Helper()
+
+	rules, _, _ := unstructured.NestedSlice(route.Object, "spec", "rules")
+	require.Len(t, rules, 1)
+
+	backendRefs, _, _ := unstructured.NestedSlice(rules[0].(map[string]any), "backendRefs")
+	require.Len(t, backendRefs, 1)
+
+	port, _, _ := unstructured.NestedInt64(backendRefs[0].(map[string]any), "port")
+
+	return port
+}
+
+func TestReconcile(t *testing.T) {
+	t.Run("nothing exposed", func(t *testing.T) {
+		dk := createDynakube(nil)
+		clt := fake.NewClient()
+
+		require.NoError(t, NewReconciler(clt, clt, dk).Reconcile(t.Context()))
+
+		services := &corev1.ServiceList{}
+		require.NoError(t, clt.List(t.Context(), services))
+		assert.Empty(t, services.Items)
+
+		ingresses := &networkingv1.IngressList{}
+		require.NoError(t, clt.List(t.Context(), ingresses))
+		assert.Empty(t, ingresses.Items)
+	})
+	t.Run("load balancer", func(t *testing.T) {
+		dk := createDynakube(&activegate.ExposureSpec{
+			LoadBalancer: &activegate.LoadBalancerSpec{
+				Annotations:              map[string]string{"service.beta.kubernetes.io/aws-load-balancer-scheme": "internal"},
+				LoadBalancerSourceRanges: []string{"10.0.0.0/8"},
+				ExternalTrafficPolicy:    corev1.ServiceExternalTrafficPolicyLocal,
+			},
+		})
+		clt := fake.NewClient()
+
+		require.NoError(t, NewReconciler(clt, clt, dk).Reconcile(t.Context()))
+
+		service := &corev1.Service{}
+		require.NoError(t, clt.Get(t.Context(), client.ObjectKey{Name: dk.ActiveGate().GetExternalServiceName(), Namespace: testNamespace}, service))
+		assert.Equal(t, corev1.ServiceTypeLoadBalancer, service.Spec.Type)
+		assert.Equal(t, "internal", service.Annotations["service.beta.kubernetes.io/aws-load-balancer-scheme"])
+		assert.Equal(t, []string{"10.0.0.0/8"}, service.Spec.LoadBalancerSourceRanges)
+		assert.Equal(t, corev1.ServiceExternalTrafficPolicyLocal, service.Spec.ExternalTrafficPolicy)
+		require.Len(t, service.Spec.Ports, 1)
+		assert.Equal(t, int32(443), service.Spec.Ports[0].Port)
+		assert.Equal(t, "activegate", service.Spec.Selector["app.kubernetes.io/name"])
+		assert.Equal(t, testDynakubeName, service.OwnerReferences[0].Name)
+		assert.Len(t, dk.Spec.ActiveGate.Exposure.LoadBalancer.Annotations, 1, "hash annotation must not leak into the DynaKube")
+
+		dk.Spec.ActiveGate.Exposure = nil
+		require.NoError(t, NewReconciler(clt, clt, dk).Reconcile(t.Context()))
+
+		err := clt.Get(t.Context(), client.ObjectKey{Name: dk.ActiveGate().GetExternalServiceName(), Namespace: testNamespace}, service)
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+	t.Run("ingress", func(t *testing.T) {
+		dk := createDynakube(&activegate.ExposureSpec{
+			Ingress: &activegate.IngressSpec{
+				Host:             testHost,
+				IngressClassName: ptr.To("nginx"),
+				TLSSecretName:    "ag-ingress-tls",
+			},
+		})
+		clt := fake.NewClient()
+
+		require.NoError(t, NewReconciler(clt, clt, dk).Reconcile(t.Context()))
+
+		ingress := &networkingv1.Ingress{}
+		require.NoError(t, clt.Get(t.Context(), client.ObjectKey{Name: testServiceName, Namespace: testNamespace}, ingress))
+		assert.Equal(t, "nginx", *ingress.Spec.IngressClassName)
+		assert.Equal(t, testHost, ingress.Spec.Rules[0].Host)
+		assert.Equal(t, "ag-ingress-tls", ingress.Spec.TLS[0].SecretName)
+
+		backend := ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service
+		assert.Equal(t, testServiceName, backend.Name)
+		assert.Equal(t, "http", backend.Port.Name)
+
+		dk.Spec.ActiveGate.Capabilities = nil
+		require.NoError(t, NewReconciler(clt, clt, dk).Reconcile(t.Context()))
+
+		err := clt.Get(t.Context(), client.ObjectKey{Name: testServiceName, Namespace: testNamespace}, ingress)
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+	t.Run("switch from HTTPRoute to TLSRoute", func(t *testing.T) {
+		dk := createDynakube(&activegate.ExposureSpec{
+			Gateway: &activegate.GatewaySpec{
+				ParentRefs: []activegate.GatewayParentRef{{Name: "gateway", Namespace: "infra"}},
+				Hostnames:  []string{testHost},
+			},
+		})
+		clt := fake.NewClient()
+
+		require.NoError(t, NewReconciler(clt, clt, dk).Reconcile(t.Context()))
+
+		route, err := getRoute(t, clt, k8sgatewayroute.HTTPRouteGVK)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(80), getBackendPort(t, route))
+
+		dk.Spec.ActiveGate.Exposure.Gateway.RouteKind = activegate.TLSRouteKind
+		require.NoError(t, NewReconciler(clt, clt, dk).Reconcile(t.Context()))
+
+		_, err = getRoute(t, clt, k8sgatewayroute.HTTPRouteGVK)
+		assert.True(t, k8serrors.IsNotFound(err))
+
+		route, err = getRoute(t, clt, k8sgatewayroute.TLSRouteGVK)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(443), getBackendPort(t, route))
+
+		dk.Spec.ActiveGate.Exposure = nil
+		require.NoError(t, NewReconciler(clt, clt, dk).Reconcile(t.Context()))
+
+		_, err = getRoute(t, clt, k8sgatewayroute.TLSRouteGVK)
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+	t.Run("Gateway API not installed", func(t *testing.T) {
+		noGatewayAPI := func(ctx context.Context, clt client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			if route, ok := obj.(*unstructured.Unstructured); ok {
+				return &meta.NoKindMatchError{GroupKind: route.GroupVersionKind().GroupKind()}
+			}
+
+			return clt.Get(ctx, key, obj, opts...)
+		}
+		clt := fake.NewClientWithInterceptors(interceptor.Funcs{Get: noGatewayAPI})
+
+		dk := createDynakube(nil)
+		require.NoError(t, NewReconciler(clt, clt, dk).Reconcile(t.Context()), "clusters without the Gateway API must not fail without a route")
+
+		dk.Spec.ActiveGate.Exposure = &activegate.ExposureSpec{
+			Gateway: &activegate.GatewaySpec{ParentRefs: []activegate.GatewayParentRef{{Name: "gateway"}}},
+		}
+		err := NewReconciler(clt, clt, dk).Reconcile(t.Context())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "HTTPRoute CRD is missing")
+	})
+}
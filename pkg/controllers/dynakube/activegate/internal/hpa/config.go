@@ -0,0 +1,15 @@
+package hpa
+
+import (
+	"github.com/Dynatrace/dynatrace-operator/pkg/logd"
+)
+
+const (
+	conditionType = "ActiveGateHorizontalPodAutoscaler"
+
+	defaultCPUUtilization int32 = 80
+)
+
+var (
+	log = logd.Get().WithName("activegate-hpa")
+)
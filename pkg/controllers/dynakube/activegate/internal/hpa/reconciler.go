@@ -0,0 +1,125 @@
+package hpa
+
+import (
+	"context"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers"
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/capability"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8sconditions"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8slabel"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8shpa"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ controllers.Reconciler = &Reconciler{}
+
+type Reconciler struct {
+	client    client.Client
+	apiReader client.Reader
+	dk        *dynakube.DynaKube
+}
+
+func NewReconciler(clt client.Client, apiReader client.Reader, dk *dynakube.DynaKube) *Reconciler {
+	return &Reconciler{
+		client:    clt,
+		apiReader: apiReader,
+		dk:        dk,
+	}
+}
+
+// Reconcile creates or updates the HorizontalPodAutoscaler of the ActiveGate StatefulSet if autoscaling is configured,
+// otherwise it removes a previously created one.
+func (r *Reconciler) Reconcile(ctx context.Context) error {
+	if r.dk.ActiveGate().IsEnabled() && r.dk.ActiveGate().IsAutoscalingEnabled() {
+		return r.createOrUpdate(ctx)
+	}
+
+	if meta.FindStatusCondition(*r.dk.Conditions(), conditionType) == nil {
+		return nil
+	}
+	defer meta.RemoveStatusCondition(r.dk.Conditions(), conditionType)
+
+	return r.delete(ctx)
+}
+
+func (r *Reconciler) createOrUpdate(ctx context.Context) error {
+	desired, err := Build(r.dk, capability.CalculateStatefulSetName(r.dk.Name))
+	if err != nil {
+		k8sconditions.SetKubeAPIError(r.dk.Conditions(), conditionType, err)
+
+		return err
+	}
+
+	updated, err := k8shpa.Query(r.client, r.apiReader, log).WithOwner(r.dk).CreateOrUpdate(ctx, desired)
+	if err != nil {
+		k8sconditions.SetKubeAPIError(r.dk.Conditions(), conditionType, err)
+
+		return err
+	} else if updated || meta.FindStatusCondition(*r.dk.Conditions(), conditionType) == nil {
+		k8sconditions.SetHorizontalPodAutoscalerCreated(r.dk.Conditions(), conditionType, desired.Name)
+	}
+
+	return nil
+}
+
+func (r *Reconciler) delete(ctx context.Context) error {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      GetName(r.dk.Name),
+			Namespace: r.dk.Namespace,
+		},
+	}
+
+	return k8shpa.Query(r.client, r.apiReader, log).Delete(ctx, hpa)
+}
+
+// Build creates the HorizontalPodAutoscaler for the given ActiveGate StatefulSet with the autoscaling settings of the DynaKube.
+// The HorizontalPodAutoscaler has the same name as the StatefulSet it targets.
+func Build(dk *dynakube.DynaKube, statefulSetName string) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	autoscaling := dk.Spec.ActiveGate.Autoscaling
+
+	metrics := autoscaling.Metrics
+	if len(metrics) == 0 {
+		metrics = defaultMetrics()
+	}
+
+	target := autoscalingv2.CrossVersionObjectReference{
+		APIVersion: "apps/v1",
+		Kind:       "StatefulSet",
+		Name:       statefulSetName,
+	}
+	coreLabels := k8slabel.NewCoreLabels(dk.Name, k8slabel.ActiveGateComponentLabel)
+
+	return k8shpa.Build(dk, statefulSetName, target, autoscaling.MaxReplicas,
+		k8shpa.SetLabels(coreLabels.BuildLabels()),
+		k8shpa.SetMinReplicas(ptr.To(dk.ActiveGate().GetMinReplicas())),
+		k8shpa.SetMetrics(metrics),
+		k8shpa.SetBehavior(autoscaling.Behavior),
+	)
+}
+
+func defaultMetrics() []autoscalingv2.MetricSpec {
+	return []autoscalingv2.MetricSpec{
+		{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name: corev1.ResourceCPU,
+				Target: autoscalingv2.MetricTarget{
+					Type:               autoscalingv2.UtilizationMetricType,
+					AverageUtilization: ptr.To(defaultCPUUtilization),
+				},
+			},
+		},
+	}
+}
+
+// GetName returns the name of the HorizontalPodAutoscaler, which matches the name of the StatefulSet it targets.
+func GetName(dynakubeName string) string {
+	return capability.CalculateStatefulSetName(dynakubeName)
+}
@@ -0,0 +1,143 @@
+package hpa
+
+import (
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/activegate"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8sconditions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	testNamespace    = "test-namespace"
+	testDynakubeName = "test-dynakube"
+)
+
+func createDynakube(autoscaling *activegate.AutoscalingSpec) *dynakube.DynaKube {
+	return &dynakube.DynaKube{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      testDynakubeName,
+		},
+		Spec: dynakube.DynaKubeSpec{
+			ActiveGate: activegate.Spec{
+				Capabilities: []activegate.CapabilityDisplayName{
+					activegate.RoutingCapability.DisplayName,
+				},
+				Autoscaling: autoscaling,
+			},
+		},
+	}
+}
+
+func getHPA(t *testing.T, clt client.Client) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	t.Helper()
+
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	err := clt.Get(t.Context(), types.NamespacedName{Name: GetName(testDynakubeName), Namespace: testNamespace}, hpa)
+
+	return hpa, err
+}
+
+func TestReconcile(t *testing.T) {
+	t.Run("no autoscaling, nothing to do", func(t *testing.T) {
+		dk := createDynakube(nil)
+		clt := fake.NewClient()
+
+		err := NewReconciler(clt, clt, dk).Reconcile(t.Context())
+		require.NoError(t, err)
+
+		_, err = getHPA(t, clt)
+		assert.True(t, k8serrors.IsNotFound(err))
+		assert.Nil(t, meta.FindStatusCondition(*dk.Conditions(), conditionType))
+	})
+	t.Run("create hpa with default metrics", func(t *testing.T) {
+		dk := createDynakube(&activegate.AutoscalingSpec{MaxReplicas: 4})
+		clt := fake.NewClient()
+
+		err := NewReconciler(clt, clt, dk).Reconcile(t.Context())
+		require.NoError(t, err)
+
+		hpa, err := getHPA(t, clt)
+		require.NoError(t, err)
+		assert.Equal(t, "StatefulSet", hpa.Spec.ScaleTargetRef.Kind)
+		assert.Equal(t, testDynakubeName+"-activegate", hpa.Spec.ScaleTargetRef.Name)
+		assert.Equal(t, int32(1), *hpa.Spec.MinReplicas)
+		assert.Equal(t, int32(4), hpa.Spec.MaxReplicas)
+		require.Len(t, hpa.Spec.Metrics, 1)
+		assert.Equal(t, corev1.ResourceCPU, hpa.Spec.Metrics[0].Resource.Name)
+		assert.Equal(t, defaultCPUUtilization, *hpa.Spec.Metrics[0].Resource.Target.AverageUtilization)
+		require.Len(t, hpa.OwnerReferences, 1)
+		assert.Equal(t, testDynakubeName, hpa.OwnerReferences[0].Name)
+
+		condition := meta.FindStatusCondition(*dk.Conditions(), conditionType)
+		require.NotNil(t, condition)
+		assert.Equal(t, k8sconditions.HorizontalPodAutoscalerCreatedReason, condition.Reason)
+	})
+	t.Run("update hpa", func(t *testing.T) {
+		dk := createDynakube(&activegate.AutoscalingSpec{MaxReplicas: 4})
+		clt := fake.NewClient()
+		r := NewReconciler(clt, clt, dk)
+
+		require.NoError(t, r.Reconcile(t.Context()))
+
+		metrics := []autoscalingv2.MetricSpec{
+			{
+				Type: autoscalingv2.PodsMetricSourceType,
+				Pods: &autoscalingv2.PodsMetricSource{
+					Metric: autoscalingv2.MetricIdentifier{Name: "activegate_throughput"},
+					Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType},
+				},
+			},
+		}
+		dk.Spec.ActiveGate.Autoscaling = &activegate.AutoscalingSpec{MinReplicas: ptr.To(int32(2)), MaxReplicas: 8, Metrics: metrics}
+
+		require.NoError(t, r.Reconcile(t.Context()))
+
+		hpa, err := getHPA(t, clt)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), *hpa.Spec.MinReplicas)
+		assert.Equal(t, int32(8), hpa.Spec.MaxReplicas)
+		assert.Equal(t, metrics, hpa.Spec.Metrics)
+	})
+	t.Run("delete hpa after autoscaling was disabled", func(t *testing.T) {
+		dk := createDynakube(&activegate.AutoscalingSpec{MaxReplicas: 4})
+		clt := fake.NewClient()
+		r := NewReconciler(clt, clt, dk)
+
+		require.NoError(t, r.Reconcile(t.Context()))
+
+		dk.Spec.ActiveGate.Autoscaling = nil
+
+		require.NoError(t, r.Reconcile(t.Context()))
+
+		_, err := getHPA(t, clt)
+		assert.True(t, k8serrors.IsNotFound(err))
+		assert.Nil(t, meta.FindStatusCondition(*dk.Conditions(), conditionType))
+	})
+	t.Run("delete hpa after activegate was disabled", func(t *testing.T) {
+		dk := createDynakube(&activegate.AutoscalingSpec{MaxReplicas: 4})
+		clt := fake.NewClient()
+		r := NewReconciler(clt, clt, dk)
+
+		require.NoError(t, r.Reconcile(t.Context()))
+
+		dk.Spec.ActiveGate.Capabilities = nil
+
+		require.NoError(t, r.Reconcile(t.Context()))
+
+		_, err := getHPA(t, clt)
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+}
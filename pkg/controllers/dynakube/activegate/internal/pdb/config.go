@@ -0,0 +1,9 @@
+package pdb
+
+import (
+	"github.com/Dynatrace/dynatrace-operator/pkg/logd"
+)
+
+var (
+	log = logd.Get().WithName("activegate-pdb")
+)
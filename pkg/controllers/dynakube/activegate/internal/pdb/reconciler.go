@@ -0,0 +1,74 @@
+package pdb
+
+import (
+	"context"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers"
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/capability"
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/consts"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8slabel"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8spdb"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ controllers.Reconciler = &Reconciler{}
+
+type Reconciler struct {
+	client    client.Client
+	apiReader client.Reader
+	dk        *dynakube.DynaKube
+}
+
+func NewReconciler(clt client.Client, apiReader client.Reader, dk *dynakube.DynaKube) *Reconciler {
+	return &Reconciler{
+		client:    clt,
+		apiReader: apiReader,
+		dk:        dk,
+	}
+}
+
+// Reconcile creates or updates the PodDisruptionBudget of the ActiveGate StatefulSet if needed,
+// otherwise it removes a previously created one.
+func (r *Reconciler) Reconcile(ctx context.Context) error {
+	agCapability := capability.NewMultiCapability(r.dk)
+
+	desired, err := Build(r.dk, agCapability)
+	if err != nil {
+		return err
+	}
+
+	enabled := r.dk.ActiveGate().IsEnabled() && IsNeeded(r.dk, agCapability)
+
+	return k8spdb.CreateOrDelete(ctx, k8spdb.Query(r.client, r.apiReader, log), desired, enabled)
+}
+
+// Build creates the PodDisruptionBudget of the StatefulSet of the main ActiveGate or pool the capability belongs to.
+func Build(dk *dynakube.DynaKube, agCapability capability.Capability) (*policyv1.PodDisruptionBudget, error) {
+	appLabels := k8slabel.NewAppLabels(capability.BuildAppName(agCapability), dk.Name, consts.MultiActiveGateName, "")
+	coreLabels := k8slabel.NewCoreLabels(dk.Name, k8slabel.ActiveGateComponentLabel)
+
+	return k8spdb.Build(dk, capability.BuildName(dk.Name, agCapability), appLabels.BuildMatchLabels(),
+		k8spdb.SetLabels(coreLabels.BuildLabels()),
+		k8spdb.SetBudget(dk.Spec.ActiveGate.PodDisruptionBudget),
+	)
+}
+
+// IsNeeded returns true if the configured PodDisruptionBudget applies to the number of replicas of the ActiveGate the capability belongs to.
+func IsNeeded(dk *dynakube.DynaKube, agCapability capability.Capability) bool {
+	return dk.Spec.ActiveGate.PodDisruptionBudget.IsEnabled(maxReplicas(dk, agCapability))
+}
+
+func maxReplicas(dk *dynakube.DynaKube, agCapability capability.Capability) int32 {
+	if capability.IsAutoscaled(dk, agCapability) {
+		return dk.Spec.ActiveGate.Autoscaling.MaxReplicas
+	}
+
+	if agCapability.PoolName() == "" {
+		return dk.ActiveGate().GetReplicas()
+	}
+
+	return ptr.Deref(agCapability.Properties().Replicas, 1)
+}
@@ -0,0 +1,125 @@
+package pdb
+
+import (
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/activegate"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/pdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	policyv1 "k8s.io/api/policy/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	testNamespace    = "test-namespace"
+	testDynakubeName = "test-dynakube"
+)
+
+func createDynakube(replicas int32) *dynakube.DynaKube {
+	return &dynakube.DynaKube{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      testDynakubeName,
+		},
+		Spec: dynakube.DynaKubeSpec{
+			ActiveGate: activegate.Spec{
+				Capabilities: []activegate.CapabilityDisplayName{
+					activegate.RoutingCapability.DisplayName,
+				},
+				CapabilityProperties: activegate.CapabilityProperties{
+					Replicas: ptr.To(replicas),
+				},
+			},
+		},
+	}
+}
+
+func getPDB(t *testing.T, clt client.Client) (*policyv1.PodDisruptionBudget, error) {
+	t.Helper()
+
+	budget := &policyv1.PodDisruptionBudget{}
+	err := clt.Get(t.Context(), client.ObjectKey{Name: testDynakubeName + "-activegate", Namespace: testNamespace}, budget)
+
+	return budget, err
+}
+
+func TestReconcile(t *testing.T) {
+	t.Run("no pdb for single replica", func(t *testing.T) {
+		dk := createDynakube(1)
+		clt := fake.NewClient()
+
+		require.NoError(t, NewReconciler(clt, clt, dk).Reconcile(t.Context()))
+
+		_, err := getPDB(t, clt)
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+	t.Run("default pdb for multiple replicas", func(t *testing.T) {
+		dk := createDynakube(3)
+		clt := fake.NewClient()
+
+		require.NoError(t, NewReconciler(clt, clt, dk).Reconcile(t.Context()))
+
+		budget, err := getPDB(t, clt)
+		require.NoError(t, err)
+		assert.Equal(t, intstr.FromInt32(1), *budget.Spec.MaxUnavailable)
+		assert.Equal(t, "activegate", budget.Spec.Selector.MatchLabels["app.kubernetes.io/name"])
+		assert.Equal(t, testDynakubeName, budget.OwnerReferences[0].Name)
+	})
+	t.Run("default pdb if autoscaling can scale beyond one replica", func(t *testing.T) {
+		dk := createDynakube(1)
+		dk.Spec.ActiveGate.Autoscaling = &activegate.AutoscalingSpec{MaxReplicas: 3}
+		clt := fake.NewClient()
+
+		require.NoError(t, NewReconciler(clt, clt, dk).Reconcile(t.Context()))
+
+		_, err := getPDB(t, clt)
+		require.NoError(t, err)
+	})
+	t.Run("custom pdb for single replica", func(t *testing.T) {
+		dk := createDynakube(1)
+		dk.Spec.ActiveGate.PodDisruptionBudget = &pdb.Spec{MinAvailable: ptr.To(intstr.FromInt32(1))}
+		clt := fake.NewClient()
+
+		require.NoError(t, NewReconciler(clt, clt, dk).Reconcile(t.Context()))
+
+		budget, err := getPDB(t, clt)
+		require.NoError(t, err)
+		assert.Equal(t, intstr.FromInt32(1), *budget.Spec.MinAvailable)
+		assert.Nil(t, budget.Spec.MaxUnavailable)
+	})
+	t.Run("remove pdb after scaling down", func(t *testing.T) {
+		dk := createDynakube(3)
+		clt := fake.NewClient()
+		r := NewReconciler(clt, clt, dk)
+
+		require.NoError(t, r.Reconcile(t.Context()))
+
+		dk.Spec.ActiveGate.Replicas = ptr.To(int32(1))
+
+		require.NoError(t, r.Reconcile(t.Context()))
+
+		_, err := getPDB(t, clt)
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+	t.Run("remove pdb after activegate was disabled", func(t *testing.T) {
+		dk := createDynakube(3)
+		clt := fake.NewClient()
+		r := NewReconciler(clt, clt, dk)
+
+		require.NoError(t, r.Reconcile(t.Context()))
+
+		dk.Spec.ActiveGate.Capabilities = nil
+
+		require.NoError(t, r.Reconcile(t.Context()))
+
+		_, err := getPDB(t, clt)
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+}
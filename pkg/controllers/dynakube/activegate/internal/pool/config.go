@@ -0,0 +1,9 @@
+package pool
+
+import (
+	"github.com/Dynatrace/dynatrace-operator/pkg/logd"
+)
+
+var (
+	log = logd.Get().WithName("activegate-pool")
+)
@@ -0,0 +1,191 @@
+package pool
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/activegate"
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/capability"
+	capabilityInternal "github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/internal/capability"
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/internal/hpa"
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/internal/pdb"
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/internal/statefulset"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8slabel"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8shpa"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8spdb"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8sservice"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8sstatefulset"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Reconciler manages the Services, StatefulSets, PodDisruptionBudgets and HorizontalPodAutoscalers of the ActiveGate pools.
+// The Services are reconciled before the main ActiveGate, so their ClusterIPs are part of the TLS certificate,
+// the StatefulSets afterwards, so they pick up the certificate and custom properties of the main ActiveGate.
+type Reconciler struct {
+	client                       client.Client
+	apiReader                    client.Reader
+	dk                           *dynakube.DynaKube
+	services                     k8sservice.QueryObject
+	statefulSets                 k8sstatefulset.QueryObject
+	disruptionBudgets            k8spdb.QueryObject
+	autoscalers                  k8shpa.QueryObject
+	newStatefulsetReconcilerFunc statefulset.NewReconcilerFunc
+}
+
+func NewReconciler(clt client.Client, apiReader client.Reader, dk *dynakube.DynaKube) *Reconciler {
+	return &Reconciler{
+		client:                       clt,
+		apiReader:                    apiReader,
+		dk:                           dk,
+		services:                     k8sservice.Query(clt, apiReader, log),
+		statefulSets:                 k8sstatefulset.Query(clt, apiReader, log),
+		disruptionBudgets:            k8spdb.Query(clt, apiReader, log),
+		autoscalers:                  k8shpa.Query(clt, apiReader, log),
+		newStatefulsetReconcilerFunc: statefulset.NewReconciler,
+	}
+}
+
+// ReconcileServices creates or updates the Services of the configured pools and stores their ClusterIPs in the status.
+// The Services and StatefulSets of pools that were removed from the DynaKube are deleted.
+func (r *Reconciler) ReconcileServices(ctx context.Context) error {
+	pools := r.desiredPools()
+
+	if err := r.deleteRemovedPools(ctx, pools); err != nil {
+		return err
+	}
+
+	var poolStatuses []activegate.PoolStatus
+
+	for _, pool := range pools {
+		serviceIPs, err := r.createOrUpdateService(ctx, capability.NewPoolCapability(r.dk, pool))
+		if err != nil {
+			return err
+		}
+
+		poolStatuses = append(poolStatuses, activegate.PoolStatus{Name: pool.Name, ServiceIPs: serviceIPs})
+	}
+
+	r.dk.Status.ActiveGate.Pools = poolStatuses
+
+	return nil
+}
+
+// ReconcileStatefulSets creates or updates the StatefulSets of the configured pools,
+// together with their PodDisruptionBudgets and HorizontalPodAutoscalers, which follow the settings of the main ActiveGate.
+func (r *Reconciler) ReconcileStatefulSets(ctx context.Context) error {
+	for _, pool := range r.desiredPools() {
+		poolCapability := capability.NewPoolCapability(r.dk, pool)
+
+		err := r.newStatefulsetReconcilerFunc(r.client, r.apiReader, r.dk, poolCapability).Reconcile(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := r.reconcileDisruptionBudget(ctx, poolCapability); err != nil {
+			return err
+		}
+
+		if err := r.reconcileAutoscaler(ctx, poolCapability); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Reconciler) reconcileDisruptionBudget(ctx context.Context, poolCapability capability.Capability) error {
+	desired, err := pdb.Build(r.dk, poolCapability)
+	if err != nil {
+		return err
+	}
+
+	return k8spdb.CreateOrDelete(ctx, r.disruptionBudgets, desired, pdb.IsNeeded(r.dk, poolCapability))
+}
+
+func (r *Reconciler) reconcileAutoscaler(ctx context.Context, poolCapability capability.Capability) error {
+	name := capability.BuildName(r.dk.Name, poolCapability)
+
+	if !capability.IsAutoscaled(r.dk, poolCapability) {
+		current, err := r.autoscalers.Get(ctx, client.ObjectKey{Name: name, Namespace: r.dk.Namespace})
+		if k8serrors.IsNotFound(err) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		return r.autoscalers.Delete(ctx, current)
+	}
+
+	desired, err := hpa.Build(r.dk, name)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.autoscalers.CreateOrUpdate(ctx, desired)
+
+	return err
+}
+
+func (r *Reconciler) desiredPools() []activegate.PoolSpec {
+	if !r.dk.ActiveGate().IsEnabled() || len(r.dk.Spec.ActiveGate.Capabilities) == 0 {
+		return nil
+	}
+
+	return r.dk.Spec.ActiveGate.Pools
+}
+
+func (r *Reconciler) createOrUpdateService(ctx context.Context, poolCapability capability.Capability) ([]string, error) {
+	appLabels := k8slabel.NewAppLabels(capability.BuildAppName(poolCapability), r.dk.Name, "", "")
+	coreLabels := k8slabel.NewCoreLabels(r.dk.Name, k8slabel.ActiveGateComponentLabel)
+
+	desired, err := k8sservice.Build(r.dk, capability.BuildName(r.dk.Name, poolCapability), appLabels.BuildMatchLabels(), capabilityInternal.BuildServicePorts(),
+		k8sservice.SetType(corev1.ServiceTypeClusterIP),
+		k8sservice.SetLabels(coreLabels.BuildLabels()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = r.services.CreateOrUpdate(ctx, desired)
+	if err != nil {
+		return nil, err
+	}
+
+	present, err := r.services.Get(ctx, client.ObjectKeyFromObject(desired))
+	if err != nil {
+		return nil, err
+	}
+
+	return present.Spec.ClusterIPs, nil
+}
+
+func (r *Reconciler) deleteRemovedPools(ctx context.Context, pools []activegate.PoolSpec) error {
+	for _, poolStatus := range r.dk.Status.ActiveGate.Pools {
+		if slices.ContainsFunc(pools, func(pool activegate.PoolSpec) bool { return pool.Name == poolStatus.Name }) {
+			continue
+		}
+
+		name := capability.BuildPoolName(r.dk.Name, poolStatus.Name)
+
+		if err := r.statefulSets.DeleteForNamespace(ctx, name, r.dk.Namespace); err != nil {
+			return err
+		}
+
+		if err := r.disruptionBudgets.DeleteForNamespace(ctx, name, r.dk.Namespace); err != nil {
+			return err
+		}
+
+		if err := r.autoscalers.DeleteForNamespace(ctx, name, r.dk.Namespace); err != nil {
+			return err
+		}
+
+		if err := r.services.DeleteForNamespace(ctx, name, r.dk.Namespace); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,236 @@
+package pool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/activegate"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/consts"
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/internal/authtoken"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8scontainer"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8senv"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8slabel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+const (
+	testNamespace    = "test-namespace"
+	testDynakubeName = "test-dynakube"
+	testPoolName     = "routing"
+	testPoolFullName = testDynakubeName + "-activegate-" + testPoolName
+	testClusterIP    = "10.0.0.2"
+)
+
+func createDynakube(pools ...activegate.PoolSpec) *dynakube.DynaKube {
+	return &dynakube.DynaKube{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      testDynakubeName,
+		},
+		Spec: dynakube.DynaKubeSpec{
+			NetworkZone: "main-zone",
+			ActiveGate: activegate.Spec{
+				Capabilities: []activegate.CapabilityDisplayName{
+					activegate.KubeMonCapability.DisplayName,
+				},
+				Pools: pools,
+			},
+		},
+	}
+}
+
+func createRoutingPool() activegate.PoolSpec {
+	return activegate.PoolSpec{
+		Name:         testPoolName,
+		Capabilities: []activegate.CapabilityDisplayName{activegate.RoutingCapability.DisplayName},
+		Group:        "routing-group",
+		NetworkZone:  "routing-zone",
+		Replicas:     ptr.To(int32(3)),
+	}
+}
+
+func createAuthTokenSecret(dk *dynakube.DynaKube) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      dk.ActiveGate().GetAuthTokenSecretName(),
+			Namespace: dk.Namespace,
+		},
+		Data: map[string][]byte{authtoken.ActiveGateAuthTokenName: []byte("token")},
+	}
+}
+
+// createClient returns a fake client that assigns a ClusterIP to created Services, like the API server does.
+func createClient(objs ...client.Object) client.Client {
+	return fake.NewClientWithInterceptors(interceptor.Funcs{
+		Create: func(ctx context.Context, clt client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			if service, ok := obj.(*corev1.Service); ok {
+				service.Spec.ClusterIPs = []string{testClusterIP}
+			}
+
+			return clt.Create(ctx, obj, opts...)
+		},
+	}, objs...)
+}
+
+func TestReconcileServices(t *testing.T) {
+	t.Run("no pools", func(t *testing.T) {
+		dk := createDynakube()
+		clt := createClient()
+
+		require.NoError(t, NewReconciler(clt, clt, dk).ReconcileServices(t.Context()))
+
+		services := &corev1.ServiceList{}
+		require.NoError(t, clt.List(t.Context(), services))
+		assert.Empty(t, services.Items)
+		assert.Nil(t, dk.Status.ActiveGate.Pools)
+	})
+
+	t.Run("service created and ClusterIPs added to status", func(t *testing.T) {
+		dk := createDynakube(createRoutingPool())
+		clt := createClient()
+
+		require.NoError(t, NewReconciler(clt, clt, dk).ReconcileServices(t.Context()))
+
+		service := &corev1.Service{}
+		require.NoError(t, clt.Get(t.Context(), client.ObjectKey{Name: testPoolFullName, Namespace: testNamespace}, service))
+		assert.Equal(t, corev1.ServiceTypeClusterIP, service.Spec.Type)
+		assert.Equal(t, "activegate-"+testPoolName, service.Spec.Selector[k8slabel.AppNameLabel])
+		assert.Len(t, service.Spec.Ports, 2)
+		require.Len(t, service.OwnerReferences, 1)
+		assert.Equal(t, testDynakubeName, service.OwnerReferences[0].Name)
+
+		assert.Equal(t, []activegate.PoolStatus{{Name: testPoolName, ServiceIPs: []string{testClusterIP}}}, dk.Status.ActiveGate.Pools)
+	})
+
+	t.Run("removed pool is deleted", func(t *testing.T) {
+		dk := createDynakube(createRoutingPool())
+		clt := createClient(createAuthTokenSecret(dk))
+		r := NewReconciler(clt, clt, dk)
+
+		require.NoError(t, r.ReconcileServices(t.Context()))
+		require.NoError(t, r.ReconcileStatefulSets(t.Context()))
+
+		dk.Spec.ActiveGate.Pools = nil
+		require.NoError(t, r.ReconcileServices(t.Context()))
+
+		err := clt.Get(t.Context(), client.ObjectKey{Name: testPoolFullName, Namespace: testNamespace}, &corev1.Service{})
+		assert.True(t, k8serrors.IsNotFound(err))
+		err = clt.Get(t.Context(), client.ObjectKey{Name: testPoolFullName, Namespace: testNamespace}, &appsv1.StatefulSet{})
+		assert.True(t, k8serrors.IsNotFound(err))
+		err = clt.Get(t.Context(), client.ObjectKey{Name: testPoolFullName, Namespace: testNamespace}, &policyv1.PodDisruptionBudget{})
+		assert.True(t, k8serrors.IsNotFound(err))
+		assert.Nil(t, dk.Status.ActiveGate.Pools)
+	})
+
+	t.Run("pools are deleted with the main ActiveGate", func(t *testing.T) {
+		dk := createDynakube(createRoutingPool())
+		clt := createClient()
+		r := NewReconciler(clt, clt, dk)
+
+		require.NoError(t, r.ReconcileServices(t.Context()))
+
+		dk.Spec.ActiveGate.Capabilities = nil
+		require.NoError(t, r.ReconcileServices(t.Context()))
+
+		err := clt.Get(t.Context(), client.ObjectKey{Name: testPoolFullName, Namespace: testNamespace}, &corev1.Service{})
+		assert.True(t, k8serrors.IsNotFound(err))
+		assert.Nil(t, dk.Status.ActiveGate.Pools)
+	})
+}
+
+func TestReconcileStatefulSets(t *testing.T) {
+	t.Run("statefulset created with pool settings", func(t *testing.T) {
+		dk := createDynakube(createRoutingPool())
+		clt := createClient(createAuthTokenSecret(dk))
+		r := NewReconciler(clt, clt, dk)
+
+		require.NoError(t, r.ReconcileServices(t.Context()))
+		require.NoError(t, r.ReconcileStatefulSets(t.Context()))
+
+		sts := &appsv1.StatefulSet{}
+		require.NoError(t, clt.Get(t.Context(), client.ObjectKey{Name: testPoolFullName, Namespace: testNamespace}, sts))
+		assert.Equal(t, int32(3), *sts.Spec.Replicas)
+		assert.Equal(t, "activegate-"+testPoolName, sts.Spec.Selector.MatchLabels[k8slabel.AppNameLabel])
+
+		container := k8scontainer.FindInPodSpec(&sts.Spec.Template.Spec, consts.ActiveGateContainerName)
+		require.NotNil(t, container)
+		assert.Equal(t, "MSGrouter", k8senv.Find(container.Env, consts.EnvDtCapabilities).Value)
+		assert.Equal(t, "routing-group", k8senv.Find(container.Env, consts.EnvDtGroup).Value)
+		assert.Equal(t, "routing-zone", k8senv.Find(container.Env, consts.EnvDtNetworkZone).Value)
+		assert.Contains(t, k8senv.Find(container.Env, consts.EnvDtDNSEntryPoint).Value, testClusterIP)
+
+		// kubernetes monitoring is only part of the main ActiveGate
+		assert.Empty(t, sts.Spec.Template.Spec.InitContainers)
+	})
+
+	t.Run("pool with multiple replicas gets a pdb", func(t *testing.T) {
+		dk := createDynakube(createRoutingPool())
+		clt := createClient(createAuthTokenSecret(dk))
+		r := NewReconciler(clt, clt, dk)
+
+		require.NoError(t, r.ReconcileServices(t.Context()))
+		require.NoError(t, r.ReconcileStatefulSets(t.Context()))
+
+		budget := &policyv1.PodDisruptionBudget{}
+		require.NoError(t, clt.Get(t.Context(), client.ObjectKey{Name: testPoolFullName, Namespace: testNamespace}, budget))
+		assert.Equal(t, intstr.FromInt32(1), *budget.Spec.MaxUnavailable)
+		assert.Equal(t, "activegate-"+testPoolName, budget.Spec.Selector.MatchLabels[k8slabel.AppNameLabel])
+
+		err := clt.Get(t.Context(), client.ObjectKey{Name: testPoolFullName, Namespace: testNamespace}, &autoscalingv2.HorizontalPodAutoscaler{})
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+
+	t.Run("pool without replicas follows the autoscaling of the main ActiveGate", func(t *testing.T) {
+		pool := createRoutingPool()
+		pool.Replicas = nil
+		dk := createDynakube(pool)
+		dk.Spec.ActiveGate.Autoscaling = &activegate.AutoscalingSpec{MinReplicas: ptr.To(int32(2)), MaxReplicas: 5}
+		clt := createClient(createAuthTokenSecret(dk))
+		r := NewReconciler(clt, clt, dk)
+
+		require.NoError(t, r.ReconcileServices(t.Context()))
+		require.NoError(t, r.ReconcileStatefulSets(t.Context()))
+
+		sts := &appsv1.StatefulSet{}
+		require.NoError(t, clt.Get(t.Context(), client.ObjectKey{Name: testPoolFullName, Namespace: testNamespace}, sts))
+		assert.Equal(t, int32(2), *sts.Spec.Replicas)
+
+		autoscaler := &autoscalingv2.HorizontalPodAutoscaler{}
+		require.NoError(t, clt.Get(t.Context(), client.ObjectKey{Name: testPoolFullName, Namespace: testNamespace}, autoscaler))
+		assert.Equal(t, testPoolFullName, autoscaler.Spec.ScaleTargetRef.Name)
+		assert.Equal(t, int32(5), autoscaler.Spec.MaxReplicas)
+
+		require.NoError(t, clt.Get(t.Context(), client.ObjectKey{Name: testPoolFullName, Namespace: testNamespace}, &policyv1.PodDisruptionBudget{}))
+
+		dk.Spec.ActiveGate.Pools = nil
+		require.NoError(t, r.ReconcileServices(t.Context()))
+
+		err := clt.Get(t.Context(), client.ObjectKey{Name: testPoolFullName, Namespace: testNamespace}, &autoscalingv2.HorizontalPodAutoscaler{})
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+
+	t.Run("no statefulset without main ActiveGate", func(t *testing.T) {
+		dk := createDynakube(createRoutingPool())
+		dk.Spec.ActiveGate.Capabilities = nil
+		clt := createClient()
+
+		require.NoError(t, NewReconciler(clt, clt, dk).ReconcileStatefulSets(t.Context()))
+
+		statefulSets := &appsv1.StatefulSetList{}
+		require.NoError(t, clt.List(t.Context(), statefulSets))
+		assert.Empty(t, statefulSets.Items)
+	})
+}
@@ -35,6 +35,15 @@ func (mod CertificatesModifier) Enabled() bool {
 }
 
 func (mod CertificatesModifier) Modify(sts *appsv1.StatefulSet) error {
+	// Restarts the ActiveGate when its self-signed certificate gets renewed.
+	if certStatus := mod.dk.Status.ActiveGate.TLSCertificate; certStatus != nil {
+		if sts.Spec.Template.Annotations == nil {
+			sts.Spec.Template.Annotations = map[string]string{}
+		}
+
+		sts.Spec.Template.Annotations[consts.AnnotationActiveGateTLSCertificateHash] = certStatus.Hash
+	}
+
 	baseContainer := k8scontainer.FindInPodSpec(&sts.Spec.Template.Spec, consts.ActiveGateContainerName)
 	sts.Spec.Template.Spec.Volumes = append(sts.Spec.Template.Spec.Volumes, mod.getVolumes()...)
 	baseContainer.VolumeMounts = append(baseContainer.VolumeMounts, mod.getVolumeMounts()...)
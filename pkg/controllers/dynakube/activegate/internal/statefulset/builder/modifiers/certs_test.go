@@ -5,6 +5,8 @@ import (
 
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/exp"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/status"
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/consts"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -81,4 +83,16 @@ func TestCertModify(t *testing.T) {
 		isSubset(t, mod.getVolumes(), sts.Spec.Template.Spec.Volumes)
 		isSubset(t, mod.getVolumeMounts(), sts.Spec.Template.Spec.Containers[0].VolumeMounts)
 	})
+	t.Run("certificate hash annotation for self-signed certificate", func(t *testing.T) {
+		dk := getBaseDynakube()
+		enableKubeMonCapability(&dk)
+		dk.Status.ActiveGate.TLSCertificate = &status.CertificateStatus{Hash: "test-hash"}
+		mod := NewCertificatesModifier(dk)
+		builder := createBuilderForTesting()
+
+		sts, _ := builder.AddModifier(mod).Build()
+
+		require.NotEmpty(t, sts)
+		assert.Equal(t, "test-hash", sts.Spec.Template.Annotations[consts.AnnotationActiveGateTLSCertificateHash])
+	})
 }
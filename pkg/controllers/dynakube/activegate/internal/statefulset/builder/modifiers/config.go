@@ -38,7 +38,7 @@ func GenerateAllModifiers(dk dynakube.DynaKube, capability capability.Capability
 		NewReadOnlyModifier(dk),
 		NewServicePortModifier(dk, capability, agBaseContainerEnvMap),
 		NewKubernetesMonitoringModifier(dk, capability),
-		NewEecVolumeModifier(dk),
-		NewKspmModifier(dk),
+		NewEecVolumeModifier(dk, capability),
+		NewKspmModifier(dk, capability),
 	}
 }
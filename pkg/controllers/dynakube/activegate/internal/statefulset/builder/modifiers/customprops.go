@@ -74,7 +74,7 @@ func (mod CustomPropertiesModifier) hasCustomProperties() bool {
 
 	return (customProperties != nil &&
 		(customProperties.Value != "" ||
-			customProperties.ValueFrom != "")) || mod.dk.NeedsCustomNoProxy()
+			customProperties.ValueFrom != "")) || mod.dk.Spec.ActiveGate.Properties != nil || mod.dk.NeedsCustomNoProxy()
 }
 
 func (mod CustomPropertiesModifier) determineCustomPropertiesSource() string {
@@ -2,6 +2,7 @@ package modifiers
 
 import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/capability"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/consts"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/internal/statefulset/builder"
 	eecconsts "github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/extension/consts"
@@ -20,18 +21,21 @@ const (
 	eecFile       = "eec.token"
 )
 
-func NewEecVolumeModifier(dk dynakube.DynaKube) EecModifier {
+func NewEecVolumeModifier(dk dynakube.DynaKube, capability capability.Capability) EecModifier {
 	return EecModifier{
-		dk: dk,
+		dk:         dk,
+		capability: capability,
 	}
 }
 
 type EecModifier struct {
-	dk dynakube.DynaKube
+	capability capability.Capability
+	dk         dynakube.DynaKube
 }
 
 func (mod EecModifier) Enabled() bool {
-	return mod.dk.Extensions().IsAnyEnabled()
+	// the extension controller only runs in the main ActiveGate
+	return mod.dk.Extensions().IsAnyEnabled() && mod.capability.PoolName() == ""
 }
 
 func (mod EecModifier) Modify(sts *appsv1.StatefulSet) error {
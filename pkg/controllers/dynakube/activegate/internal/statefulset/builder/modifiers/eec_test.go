@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/extensions"
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/capability"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -13,7 +14,7 @@ func TestEecEnabled(t *testing.T) {
 		dk := getBaseDynakube()
 		dk.Spec.Extensions = &extensions.Spec{Prometheus: &extensions.PrometheusSpec{}}
 
-		mod := NewEecVolumeModifier(dk)
+		mod := NewEecVolumeModifier(dk, capability.NewMultiCapability(&dk))
 
 		assert.True(t, mod.Enabled())
 	})
@@ -22,7 +23,7 @@ func TestEecEnabled(t *testing.T) {
 		dk := getBaseDynakube()
 		dk.Spec.Extensions = nil
 
-		mod := NewEecVolumeModifier(dk)
+		mod := NewEecVolumeModifier(dk, capability.NewMultiCapability(&dk))
 
 		assert.False(t, mod.Enabled())
 	})
@@ -33,7 +34,7 @@ func TestEecModify(t *testing.T) {
 		dk := getBaseDynakube()
 		dk.Spec.Extensions = &extensions.Spec{Prometheus: &extensions.PrometheusSpec{}}
 
-		mod := NewEecVolumeModifier(dk)
+		mod := NewEecVolumeModifier(dk, capability.NewMultiCapability(&dk))
 		builder := createBuilderForTesting()
 
 		sts, _ := builder.AddModifier(mod).Build()
@@ -4,6 +4,7 @@ import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/api"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/kspm"
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/capability"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/consts"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/internal/statefulset/builder"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8scontainer"
@@ -21,18 +22,20 @@ const (
 	kspmTokenSecretHashAnnotation = api.InternalFlagPrefix + "kspm-token-secret-hash"
 )
 
-func NewKspmModifier(dk dynakube.DynaKube) KspmModifier {
+func NewKspmModifier(dk dynakube.DynaKube, capability capability.Capability) KspmModifier {
 	return KspmModifier{
-		dk: dk,
+		dk:         dk,
+		capability: capability,
 	}
 }
 
 type KspmModifier struct {
-	dk dynakube.DynaKube
+	capability capability.Capability
+	dk         dynakube.DynaKube
 }
 
 func (mod KspmModifier) Enabled() bool {
-	return mod.dk.KSPM().IsEnabled() && mod.dk.ActiveGate().IsKubernetesMonitoringEnabled()
+	return mod.dk.KSPM().IsEnabled() && mod.dk.ActiveGate().IsKubernetesMonitoringEnabled() && mod.capability.PoolName() == ""
 }
 
 func (mod KspmModifier) Modify(sts *appsv1.StatefulSet) error {
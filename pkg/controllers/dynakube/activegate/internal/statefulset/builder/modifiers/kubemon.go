@@ -43,7 +43,8 @@ type KubernetesMonitoringModifier struct {
 }
 
 func (mod KubernetesMonitoringModifier) Enabled() bool {
-	return mod.dk.ActiveGate().IsKubernetesMonitoringEnabled()
+	// kubernetes monitoring is only allowed in the main ActiveGate
+	return mod.dk.ActiveGate().IsKubernetesMonitoringEnabled() && mod.capability.PoolName() == ""
 }
 
 func (mod KubernetesMonitoringModifier) Modify(sts *appsv1.StatefulSet) error {
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/activegate"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/capability"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -26,6 +27,19 @@ func TestKubernetesMonitoringEnabled(t *testing.T) {
 		assert.True(t, mod.Enabled())
 	})
 
+	t.Run("false for pools", func(t *testing.T) {
+		dk := getBaseDynakube()
+		setKubernetesMonitoringUsage(&dk, true)
+		poolCapability := capability.NewPoolCapability(&dk, activegate.PoolSpec{
+			Name:         "routing",
+			Capabilities: []activegate.CapabilityDisplayName{activegate.RoutingCapability.DisplayName},
+		})
+
+		mod := NewKubernetesMonitoringModifier(dk, poolCapability)
+
+		assert.False(t, mod.Enabled())
+	})
+
 	t.Run("false", func(t *testing.T) {
 		dk := getBaseDynakube()
 		setKubernetesMonitoringUsage(&dk, false)
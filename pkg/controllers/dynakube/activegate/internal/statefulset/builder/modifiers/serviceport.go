@@ -62,10 +62,18 @@ func (mod ServicePortModifier) getEnvs() []corev1.EnvVar {
 		[]corev1.EnvVar{
 			{
 				Name:  consts.EnvDtDNSEntryPoint,
-				Value: capability.BuildDNSEntryPoint(mod.dk),
+				Value: mod.buildDNSEntryPoint(),
 			},
 		},
 		prioritymap.WithPriority(modifierEnvPriority))
 
 	return mod.envMap.AsEnvVars()
 }
+
+func (mod ServicePortModifier) buildDNSEntryPoint() string {
+	if mod.capability.PoolName() != "" {
+		return capability.BuildPoolDNSEntryPoint(mod.dk, mod.capability.PoolName())
+	}
+
+	return capability.BuildDNSEntryPoint(mod.dk)
+}
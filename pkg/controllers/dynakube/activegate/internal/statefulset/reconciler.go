@@ -12,12 +12,15 @@ import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/internal/authtoken"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/internal/customproperties"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/internal/statefulset/builder"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/hasher"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8sconditions"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8ssecret"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8sstatefulset"
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -67,6 +70,15 @@ func (r *Reconciler) manageStatefulSet(ctx context.Context) error {
 		return err
 	}
 
+	if capability.IsAutoscaled(r.dk, r.capability) {
+		err = r.keepAutoscaledReplicas(ctx, desiredSts)
+		if err != nil {
+			k8sconditions.SetKubeAPIError(r.dk.Conditions(), ActiveGateStatefulSetConditionType, err)
+
+			return err
+		}
+	}
+
 	updated, err := k8sstatefulset.Query(r.client, r.apiReader, log).WithOwner(r.dk).CreateOrUpdate(ctx, desiredSts)
 	if err != nil {
 		k8sconditions.SetKubeAPIError(r.dk.Conditions(), ActiveGateStatefulSetConditionType, err)
@@ -79,6 +91,30 @@ func (r *Reconciler) manageStatefulSet(ctx context.Context) error {
 	return nil
 }
 
+// keepAutoscaledReplicas makes sure that the replicas set by the HorizontalPodAutoscaler are not overwritten.
+// The replicas are excluded from the hash annotation, so scaling the StatefulSet doesn't trigger an update.
+func (r *Reconciler) keepAutoscaledReplicas(ctx context.Context, desiredSts *appsv1.StatefulSet) error {
+	desiredSts.Spec.Replicas = nil
+
+	err := hasher.AddAnnotation(desiredSts)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	currentSts, err := k8sstatefulset.Query(r.client, r.apiReader, log).Get(ctx, client.ObjectKeyFromObject(desiredSts))
+	if k8serrors.IsNotFound(err) {
+		desiredSts.Spec.Replicas = ptr.To(r.dk.ActiveGate().GetMinReplicas())
+
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	desiredSts.Spec.Replicas = currentSts.Spec.Replicas
+
+	return nil
+}
+
 func (r *Reconciler) buildDesiredStatefulSet(ctx context.Context) (*appsv1.StatefulSet, error) {
 	kubeUID := types.UID(r.dk.Status.KubeSystemUUID)
 
@@ -118,8 +154,13 @@ func (r *Reconciler) calculateActiveGateConfigurationHash(ctx context.Context) (
 }
 
 func (r *Reconciler) getCustomPropertyValue(ctx context.Context) (string, error) {
+	typedPropertiesData, err := r.getTypedPropertiesValue()
+	if err != nil {
+		return "", err
+	}
+
 	if !needsCustomPropertyHash(r.capability.Properties().CustomProperties) {
-		return "", nil
+		return typedPropertiesData, nil
 	}
 
 	customPropertyData, err := r.getDataFromCustomProperty(ctx, r.capability.Properties().CustomProperties)
@@ -127,7 +168,16 @@ func (r *Reconciler) getCustomPropertyValue(ctx context.Context) (string, error)
 		return "", err
 	}
 
-	return customPropertyData, nil
+	return customPropertyData + typedPropertiesData, nil
+}
+
+// getTypedPropertiesValue returns a hash of the typed properties, as they are rendered into the same file as the custom properties.
+func (r *Reconciler) getTypedPropertiesValue() (string, error) {
+	if r.dk.Spec.ActiveGate.Properties == nil {
+		return "", nil
+	}
+
+	return hasher.GenerateHash(r.dk.Spec.ActiveGate.Properties)
 }
 
 func (r *Reconciler) getAuthTokenValue(ctx context.Context) (string, error) {
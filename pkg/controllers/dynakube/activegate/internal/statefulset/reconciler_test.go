@@ -22,6 +22,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
@@ -172,6 +173,23 @@ func TestReconcile_GetCustomPropertyHash(t *testing.T) {
 	assert.NotEmpty(t, hash)
 }
 
+func TestReconcile_GetTypedPropertiesHash(t *testing.T) {
+	ctx := t.Context()
+	r, _, dk := createDefaultReconciler(t)
+	hashWithoutProperties, err := r.calculateActiveGateConfigurationHash(ctx)
+	require.NoError(t, err)
+
+	dk.Spec.ActiveGate.Properties = &activegate.PropertiesSpec{Collector: map[string]bool{"aws_monitoring": false}}
+	hash, err := r.calculateActiveGateConfigurationHash(ctx)
+	require.NoError(t, err)
+	assert.NotEqual(t, hashWithoutProperties, hash)
+
+	dk.Spec.ActiveGate.Properties.Collector["aws_monitoring"] = true
+	changedHash, err := r.calculateActiveGateConfigurationHash(ctx)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, changedHash)
+}
+
 func TestReconcile_GetActiveGateAuthTokenHash(t *testing.T) {
 	ctx := t.Context()
 	r, clt, _ := createDefaultReconciler(t)
@@ -236,6 +254,41 @@ func TestManageStatefulSet(t *testing.T) {
 		actualStatefulSet := getStatefulSet(t, clt, dk)
 		assert.Equal(t, testValue, actualStatefulSet.Spec.Selector.MatchLabels["activegate"])
 	})
+	t.Run("start with min replicas if autoscaling is enabled", func(t *testing.T) {
+		r, clt, dk := createDefaultReconciler(t)
+		dk.Spec.ActiveGate.Replicas = ptr.To(int32(5))
+		dk.Spec.ActiveGate.Autoscaling = &activegate.AutoscalingSpec{MinReplicas: ptr.To(int32(2)), MaxReplicas: 10}
+
+		err := r.manageStatefulSet(ctx)
+		require.NoError(t, err)
+
+		actualStatefulSet := getStatefulSet(t, clt, dk)
+		assert.Equal(t, int32(2), *actualStatefulSet.Spec.Replicas)
+	})
+	t.Run("do not overwrite autoscaled replicas", func(t *testing.T) {
+		r, clt, dk := createDefaultReconciler(t)
+		dk.Spec.ActiveGate.Autoscaling = &activegate.AutoscalingSpec{MaxReplicas: 10}
+
+		err := r.manageStatefulSet(ctx)
+		require.NoError(t, err)
+
+		statefulSet := &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Namespace: dk.Namespace, Name: capability.BuildServiceName(dk.Name)}}
+		result, err := controllerutil.CreateOrUpdate(t.Context(), clt, statefulSet, func() error {
+			statefulSet.Spec.Replicas = ptr.To(int32(7))
+
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, controllerutil.OperationResultUpdated, result)
+
+		dk.Spec.Proxy = &value.Source{Value: testValue}
+		err = r.manageStatefulSet(ctx)
+		require.NoError(t, err)
+
+		actualStatefulSet := getStatefulSet(t, clt, dk)
+		assert.Equal(t, int32(7), *actualStatefulSet.Spec.Replicas)
+		assert.Len(t, actualStatefulSet.Spec.Template.Spec.Volumes, len(statefulSet.Spec.Template.Spec.Volumes)+1)
+	})
 }
 
 func TestStatefulSetUpdateWeakness(t *testing.T) {
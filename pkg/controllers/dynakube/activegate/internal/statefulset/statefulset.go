@@ -4,6 +4,7 @@ import (
 	"strconv"
 
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/sizing"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/status"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/capability"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/consts"
@@ -12,6 +13,7 @@ import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/deploymentmetadata"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8saffinity"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8slabel"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8spodtemplate"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8sstatefulset"
 	maputils "github.com/Dynatrace/dynatrace-operator/pkg/util/map"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/prioritymap"
@@ -57,6 +59,10 @@ func (statefulSetBuilder Builder) CreateStatefulSet(mods []builder.Modifier) (*a
 
 	sts, _ := activeGateBuilder.AddModifier(mods...).Build()
 
+	if err := k8spodtemplate.ApplyOverride(&sts.Spec.Template, statefulSetBuilder.dynakube.Spec.Templates.ActiveGate.GetOverride()); err != nil {
+		return nil, err
+	}
+
 	return &sts, nil
 }
 
@@ -81,7 +87,7 @@ func (statefulSetBuilder Builder) getBase() appsv1.StatefulSet {
 
 func (statefulSetBuilder Builder) getBaseObjectMeta() metav1.ObjectMeta {
 	return metav1.ObjectMeta{
-		Name:        statefulSetBuilder.dynakube.Name + "-" + consts.MultiActiveGateName,
+		Name:        capability.BuildName(statefulSetBuilder.dynakube.Name, statefulSetBuilder.capability),
 		Namespace:   statefulSetBuilder.dynakube.Namespace,
 		Annotations: map[string]string{},
 	}
@@ -113,7 +119,7 @@ func (statefulSetBuilder Builder) addLabels(sts *appsv1.StatefulSet) {
 func (statefulSetBuilder Builder) buildAppLabels() *k8slabel.AppLabels {
 	version := statefulSetBuilder.dynakube.Status.ActiveGate.Version
 
-	return k8slabel.NewAppLabels(k8slabel.ActiveGateComponentLabel, statefulSetBuilder.dynakube.Name, consts.MultiActiveGateName, version)
+	return k8slabel.NewAppLabels(capability.BuildAppName(statefulSetBuilder.capability), statefulSetBuilder.dynakube.Name, consts.MultiActiveGateName, version)
 }
 
 func (statefulSetBuilder Builder) addUserAnnotations(sts *appsv1.StatefulSet) {
@@ -152,7 +158,7 @@ func (statefulSetBuilder Builder) buildVolumes() []corev1.Volume {
 	volumes := []corev1.Volume{}
 
 	if statefulSetBuilder.dynakube.Spec.ActiveGate.VolumeClaimTemplate == nil {
-		if !isDefaultPVCNeeded(statefulSetBuilder.dynakube) {
+		if !statefulSetBuilder.isDefaultPVCNeeded() {
 			volumes = append(volumes, corev1.Volume{
 				Name: consts.GatewayTmpVolumeName,
 				VolumeSource: corev1.VolumeSource{
@@ -249,7 +255,7 @@ func (statefulSetBuilder Builder) buildBaseContainer() []corev1.Container {
 }
 
 func (statefulSetBuilder Builder) buildResources() corev1.ResourceRequirements {
-	return statefulSetBuilder.capability.Properties().Resources
+	return sizing.Merge(statefulSetBuilder.capability.Properties().Resources, statefulSetBuilder.dynakube.Sizing().ActiveGate)
 }
 
 func (statefulSetBuilder Builder) buildCommonEnvs() []corev1.EnvVar {
@@ -273,8 +279,8 @@ func (statefulSetBuilder Builder) buildCommonEnvs() []corev1.EnvVar {
 		prioritymap.Append(statefulSetBuilder.envMap, corev1.EnvVar{Name: consts.EnvDtGroup, Value: statefulSetBuilder.capability.Properties().Group})
 	}
 
-	if statefulSetBuilder.dynakube.Spec.NetworkZone != "" {
-		prioritymap.Append(statefulSetBuilder.envMap, corev1.EnvVar{Name: consts.EnvDtNetworkZone, Value: statefulSetBuilder.dynakube.Spec.NetworkZone})
+	if networkZone := statefulSetBuilder.networkZone(); networkZone != "" {
+		prioritymap.Append(statefulSetBuilder.envMap, corev1.EnvVar{Name: consts.EnvDtNetworkZone, Value: networkZone})
 	}
 
 	prioritymap.Append(statefulSetBuilder.envMap, statefulSetBuilder.capability.Properties().Env, prioritymap.WithPriority(customEnvPriority))
@@ -282,6 +288,15 @@ func (statefulSetBuilder Builder) buildCommonEnvs() []corev1.EnvVar {
 	return statefulSetBuilder.envMap.AsEnvVars()
 }
 
+func (statefulSetBuilder Builder) networkZone() string {
+	pool := statefulSetBuilder.dynakube.ActiveGate().GetPool(statefulSetBuilder.capability.PoolName())
+	if pool != nil && pool.NetworkZone != "" {
+		return pool.NetworkZone
+	}
+
+	return statefulSetBuilder.dynakube.Spec.NetworkZone
+}
+
 func (statefulSetBuilder Builder) nodeAffinity() *corev1.Affinity {
 	var affinity corev1.Affinity
 	if statefulSetBuilder.dynakube.Status.ActiveGate.Source == status.TenantRegistryVersionSource || statefulSetBuilder.dynakube.Status.ActiveGate.Source == status.CustomVersionVersionSource {
@@ -293,8 +308,11 @@ func (statefulSetBuilder Builder) nodeAffinity() *corev1.Affinity {
 	return &affinity
 }
 
-func isDefaultPVCNeeded(dk dynakube.DynaKube) bool {
-	return dk.TelemetryIngest().IsEnabled() && !dk.Spec.ActiveGate.UseEphemeralVolume
+// isDefaultPVCNeeded is only true for the main ActiveGate, as pools don't run the telemetry ingest capabilities.
+func (statefulSetBuilder Builder) isDefaultPVCNeeded() bool {
+	dk := statefulSetBuilder.dynakube
+
+	return dk.TelemetryIngest().IsEnabled() && !dk.Spec.ActiveGate.UseEphemeralVolume && statefulSetBuilder.capability.PoolName() == ""
 }
 
 func (statefulSetBuilder Builder) addPersistentVolumeClaim(sts *appsv1.StatefulSet) {
@@ -309,7 +327,7 @@ func (statefulSetBuilder Builder) addPersistentVolumeClaim(sts *appsv1.StatefulS
 			},
 		}
 		sts.Spec.PersistentVolumeClaimRetentionPolicy = defaultPVCRetentionPolicy()
-	} else if isDefaultPVCNeeded(statefulSetBuilder.dynakube) {
+	} else if statefulSetBuilder.isDefaultPVCNeeded() {
 		sts.Spec.VolumeClaimTemplates = []corev1.PersistentVolumeClaim{
 			{
 				ObjectMeta: metav1.ObjectMeta{
@@ -0,0 +1,193 @@
+package tls
+
+import (
+	"context"
+	"crypto/rand"
+	"slices"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/consts"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8sconditions"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8slabel"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8scertmanager"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8ssecret"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	p12DataName      = "server.p12"
+	passwordDataName = "password"
+)
+
+// reconcileCertManagerTLSSecret lets cert-manager issue the ActiveGate certificate, including the PKCS12 keystore,
+// and copies it into the AG TLS secret in the format the ActiveGate expects.
+func (r *Reconciler) reconcileCertManagerTLSSecret(ctx context.Context) error {
+	password, err := r.reconcileKeystorePassword(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = r.reconcileCertificate(ctx)
+	if err != nil {
+		return err
+	}
+
+	issued, err := r.secrets.Get(ctx, types.NamespacedName{
+		Name:      r.dk.ActiveGate().GetCertManagerTLSSecretName(),
+		Namespace: r.dk.Namespace,
+	})
+
+	switch {
+	case k8serrors.IsNotFound(err) || (err == nil && !isIssued(issued)):
+		log.Info("waiting for cert-manager to issue the ActiveGate TLS certificate", "name", r.dk.ActiveGate().GetCertManagerTLSSecretName())
+		k8sconditions.SetCertificateNotReady(r.dk.Conditions(), conditionType, r.dk.ActiveGate().GetCertManagerTLSSecretName())
+
+		return nil
+	case err != nil:
+		k8sconditions.SetKubeAPIError(r.dk.Conditions(), conditionType, err)
+
+		return err
+	}
+
+	secret, err := r.buildCertManagerTLSSecret(issued, password)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.secrets.CreateOrUpdate(ctx, secret)
+	if err != nil {
+		k8sconditions.SetKubeAPIError(r.dk.Conditions(), conditionType, err)
+
+		return err
+	}
+
+	k8sconditions.SetSecretCreatedOrUpdated(r.dk.Conditions(), conditionType, secret.Name)
+
+	err = r.updateCertificateStatus(secret)
+	if err != nil {
+		return err
+	}
+
+	r.dk.Status.ActiveGate.TLSCertificate.Issuer = r.dk.CertManager().IssuerRef.Name
+
+	return nil
+}
+
+// The password is generated once and kept, cert-manager re-encrypts the keystore on every renewal with it.
+func (r *Reconciler) reconcileKeystorePassword(ctx context.Context) ([]byte, error) {
+	secret, err := r.secrets.Get(ctx, types.NamespacedName{
+		Name:      r.dk.ActiveGate().GetTLSKeystorePasswordSecretName(),
+		Namespace: r.dk.Namespace,
+	})
+	if err == nil {
+		return secret.Data[passwordDataName], nil
+	} else if !k8serrors.IsNotFound(err) {
+		k8sconditions.SetKubeAPIError(r.dk.Conditions(), conditionType, err)
+
+		return nil, err
+	}
+
+	password := []byte(rand.Text())
+	coreLabels := k8slabel.NewCoreLabels(r.dk.Name, k8slabel.ActiveGateComponentLabel)
+
+	secret, err = k8ssecret.Build(r.dk, r.dk.ActiveGate().GetTLSKeystorePasswordSecretName(), map[string][]byte{passwordDataName: password}, k8ssecret.SetLabels(coreLabels.BuildLabels()))
+	if err != nil {
+		k8sconditions.SetSecretGenFailed(r.dk.Conditions(), conditionType, err)
+
+		return nil, err
+	}
+
+	err = r.secrets.Create(ctx, secret)
+	if err != nil {
+		k8sconditions.SetKubeAPIError(r.dk.Conditions(), conditionType, err)
+
+		return nil, err
+	}
+
+	return password, nil
+}
+
+func (r *Reconciler) reconcileCertificate(ctx context.Context) error {
+	name := r.dk.ActiveGate().GetCertManagerTLSSecretName()
+	coreLabels := k8slabel.NewCoreLabels(r.dk.Name, k8slabel.ActiveGateComponentLabel)
+
+	certificate, err := k8scertmanager.Build(r.dk, name, name, r.dk.CertManager().IssuerRef,
+		k8scertmanager.SetDNSNames(r.dnsNames()),
+		k8scertmanager.SetIPAddresses(r.dk.Status.ActiveGate.GetAllServiceIPs()),
+		k8scertmanager.SetUsages(k8scertmanager.UsageServerAuth, k8scertmanager.UsageDigitalSignature, k8scertmanager.UsageKeyEncipherment),
+		k8scertmanager.SetPKCS12Keystore(r.dk.ActiveGate().GetTLSKeystorePasswordSecretName(), passwordDataName),
+		k8scertmanager.SetSecretLabels(coreLabels.BuildLabels()),
+		k8scertmanager.SetLabels(coreLabels.BuildLabels()),
+	)
+	if err != nil {
+		k8sconditions.SetSecretGenFailed(r.dk.Conditions(), conditionType, err)
+
+		return err
+	}
+
+	err = k8scertmanager.CreateOrUpdate(ctx, r.certificates, certificate)
+	if err != nil {
+		k8sconditions.SetKubeAPIError(r.dk.Conditions(), conditionType, err)
+	}
+
+	return err
+}
+
+func (r *Reconciler) buildCertManagerTLSSecret(issued *corev1.Secret, password []byte) (*corev1.Secret, error) {
+	// The CA is appended, so that the OneAgents and KSPM can trust the whole chain via server.crt.
+	serverCrt := slices.Concat(issued.Data[consts.TLSCrtDataName], issued.Data[k8scertmanager.CADataName])
+
+	coreLabels := k8slabel.NewCoreLabels(r.dk.Name, k8slabel.ActiveGateComponentLabel)
+	secretData := map[string][]byte{
+		consts.TLSCrtDataName: issued.Data[consts.TLSCrtDataName],
+		consts.TLSKeyDataName: issued.Data[consts.TLSKeyDataName],
+		tlsCrtDataName:        serverCrt,
+		p12DataName:           issued.Data[k8scertmanager.KeystorePKCS12DataName],
+		passwordDataName:      password,
+	}
+
+	secret, err := k8ssecret.Build(r.dk, r.dk.ActiveGate().GetTLSSecretName(), secretData, k8ssecret.SetLabels(coreLabels.BuildLabels()))
+	if err != nil {
+		k8sconditions.SetSecretGenFailed(r.dk.Conditions(), conditionType, err)
+
+		return nil, err
+	}
+
+	secret.Type = corev1.SecretTypeOpaque
+
+	return secret, nil
+}
+
+// cleanupCertManager removes everything related to cert-manager once the certificate should be self-signed again or is not needed anymore.
+// The AG TLS secret is removed as well, so that it gets recreated with a self-signed certificate.
+func (r *Reconciler) cleanupCertManager(ctx context.Context) error {
+	if certStatus := r.dk.Status.ActiveGate.TLSCertificate; certStatus == nil || certStatus.Issuer == "" {
+		return nil
+	}
+
+	err := k8scertmanager.Delete(ctx, r.certificates, r.dk.ActiveGate().GetCertManagerTLSSecretName(), r.dk.Namespace)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range []string{
+		r.dk.ActiveGate().GetCertManagerTLSSecretName(),
+		r.dk.ActiveGate().GetTLSKeystorePasswordSecretName(),
+		r.dk.ActiveGate().GetAutoTLSSecretName(),
+	} {
+		err = r.secrets.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: r.dk.Namespace}})
+		if err != nil {
+			return err
+		}
+	}
+
+	r.dk.Status.ActiveGate.TLSCertificate = nil
+
+	return nil
+}
+
+func isIssued(secret *corev1.Secret) bool {
+	return len(secret.Data[consts.TLSCrtDataName]) > 0 && len(secret.Data[k8scertmanager.KeystorePKCS12DataName]) > 0
+}
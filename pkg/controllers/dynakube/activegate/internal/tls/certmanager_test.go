@@ -0,0 +1,179 @@
+package tls
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/activegate"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/certmanager"
+	"github.com/Dynatrace/dynatrace-operator/pkg/consts"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/certificates"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8sconditions"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8scertmanager"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/timeprovider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const testIssuerName = "test-issuer"
+
+func TestReconcileCertManager(t *testing.T) {
+	t.Run("waits for cert-manager to issue the certificate", func(t *testing.T) {
+		dk := createCertManagerDynaKube()
+		fakeClient := fake.NewClient()
+		r := NewReconciler(fakeClient, fakeClient, dk)
+
+		require.NoError(t, r.Reconcile(t.Context()))
+
+		certificate := getCertificate(t, fakeClient, dk)
+		passwordRef, _, _ := unstructured.NestedStringMap(certificate.Object, "spec", "keystores", "pkcs12", "passwordSecretRef")
+		assert.Equal(t, dk.ActiveGate().GetTLSKeystorePasswordSecretName(), passwordRef["name"])
+
+		issuerName, _, _ := unstructured.NestedString(certificate.Object, "spec", "issuerRef", "name")
+		assert.Equal(t, testIssuerName, issuerName)
+
+		password := getSecret(t, r, dk.ActiveGate().GetTLSKeystorePasswordSecretName())
+		assert.NotEmpty(t, password.Data[passwordDataName])
+
+		_, err := r.secrets.Get(t.Context(), types.NamespacedName{Name: dk.ActiveGate().GetTLSSecretName(), Namespace: testNamespace})
+		assert.True(t, k8serrors.IsNotFound(err))
+
+		condition := meta.FindStatusCondition(dk.Status.Conditions, conditionType)
+		require.NotNil(t, condition)
+		assert.Equal(t, k8sconditions.CertificateNotReadyReason, condition.Reason)
+		assert.Nil(t, dk.Status.ActiveGate.TLSCertificate)
+	})
+	t.Run("copies the issued certificate into the ActiveGate TLS secret", func(t *testing.T) {
+		dk := createCertManagerDynaKube()
+		fakeClient := fake.NewClient()
+		r := NewReconciler(fakeClient, fakeClient, dk)
+
+		require.NoError(t, r.Reconcile(t.Context()))
+
+		issued := createIssuedSecret(t, fakeClient, dk)
+
+		require.NoError(t, r.Reconcile(t.Context()))
+
+		password := getSecret(t, r, dk.ActiveGate().GetTLSKeystorePasswordSecretName())
+		agTLSSecret := getSecret(t, r, dk.ActiveGate().GetTLSSecretName())
+		assert.Equal(t, issued.Data[consts.TLSCrtDataName], agTLSSecret.Data[consts.TLSCrtDataName])
+		assert.Equal(t, issued.Data[consts.TLSKeyDataName], agTLSSecret.Data[consts.TLSKeyDataName])
+		assert.Equal(t, slices.Concat(issued.Data[consts.TLSCrtDataName], issued.Data[k8scertmanager.CADataName]), agTLSSecret.Data[tlsCrtDataName])
+		assert.Equal(t, issued.Data[k8scertmanager.KeystorePKCS12DataName], agTLSSecret.Data[p12DataName])
+		assert.Equal(t, password.Data[passwordDataName], agTLSSecret.Data[passwordDataName])
+
+		require.NotNil(t, dk.Status.ActiveGate.TLSCertificate)
+		assert.Equal(t, testIssuerName, dk.Status.ActiveGate.TLSCertificate.Issuer)
+		assert.NotEmpty(t, dk.Status.ActiveGate.TLSCertificate.Hash)
+	})
+	t.Run("switching back to self-signed removes the cert-manager resources", func(t *testing.T) {
+		dk := createCertManagerDynaKube()
+		fakeClient := fake.NewClient()
+		r := NewReconciler(fakeClient, fakeClient, dk)
+
+		require.NoError(t, r.Reconcile(t.Context()))
+		createIssuedSecret(t, fakeClient, dk)
+		require.NoError(t, r.Reconcile(t.Context()))
+
+		issuedHash := dk.Status.ActiveGate.TLSCertificate.Hash
+		dk.Spec.CertManager = nil
+
+		require.NoError(t, r.Reconcile(t.Context()))
+
+		err := fakeClient.Get(t.Context(), client.ObjectKey{Name: dk.ActiveGate().GetCertManagerTLSSecretName(), Namespace: testNamespace}, newCertificate())
+		assert.True(t, k8serrors.IsNotFound(err))
+
+		for _, name := range []string{dk.ActiveGate().GetCertManagerTLSSecretName(), dk.ActiveGate().GetTLSKeystorePasswordSecretName()} {
+			_, err = r.secrets.Get(t.Context(), types.NamespacedName{Name: name, Namespace: testNamespace})
+			assert.True(t, k8serrors.IsNotFound(err))
+		}
+
+		agTLSSecret := getSecret(t, r, dk.ActiveGate().GetTLSSecretName())
+		assert.NotContains(t, agTLSSecret.Data, p12DataName)
+
+		require.NotNil(t, dk.Status.ActiveGate.TLSCertificate)
+		assert.Empty(t, dk.Status.ActiveGate.TLSCertificate.Issuer)
+		assert.NotEqual(t, issuedHash, dk.Status.ActiveGate.TLSCertificate.Hash)
+	})
+}
+
+func createCertManagerDynaKube() *dynakube.DynaKube {
+	return &dynakube.DynaKube{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      testDynakubeName,
+		},
+		Spec: dynakube.DynaKubeSpec{
+			CertManager: &certmanager.Spec{
+				IssuerRef: certmanager.IssuerRef{Name: testIssuerName},
+			},
+			ActiveGate: activegate.Spec{
+				Capabilities: []activegate.CapabilityDisplayName{
+					activegate.RoutingCapability.DisplayName,
+				},
+			},
+		},
+	}
+}
+
+// createIssuedSecret simulates cert-manager issuing the certificate.
+func createIssuedSecret(t *testing.T, clt client.Client, dk *dynakube.DynaKube) *corev1.Secret {
+	t.Helper()
+
+	cert, err := certificates.NewRenewal(timeprovider.New(), 10, 80).New()
+	require.NoError(t, err)
+	require.NoError(t, cert.SelfSign())
+
+	pemCert, pemPk, err := cert.ToPEM()
+	require.NoError(t, err)
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      dk.ActiveGate().GetCertManagerTLSSecretName(),
+			Namespace: testNamespace,
+		},
+		Data: map[string][]byte{
+			consts.TLSCrtDataName:                 pemCert,
+			consts.TLSKeyDataName:                 pemPk,
+			k8scertmanager.CADataName:             []byte("ca"),
+			k8scertmanager.KeystorePKCS12DataName: []byte("keystore"),
+		},
+	}
+	require.NoError(t, clt.Create(t.Context(), secret))
+
+	return secret
+}
+
+func getSecret(t *testing.T, r *Reconciler, name string) *corev1.Secret {
+	t.Helper()
+
+	secret, err := r.secrets.Get(t.Context(), types.NamespacedName{Name: name, Namespace: testNamespace})
+	require.NoError(t, err)
+
+	return secret.DeepCopy()
+}
+
+func getCertificate(t *testing.T, clt client.Client, dk *dynakube.DynaKube) *unstructured.Unstructured {
+	t.Helper()
+
+	certificate := newCertificate()
+	require.NoError(t, clt.Get(t.Context(), client.ObjectKey{Name: dk.ActiveGate().GetCertManagerTLSSecretName(), Namespace: testNamespace}, certificate))
+
+	return certificate
+}
+
+func newCertificate() *unstructured.Unstructured {
+	certificate := &unstructured.Unstructured{}
+	certificate.SetGroupVersionKind(k8scertmanager.CertificateGVK)
+
+	return certificate
+}
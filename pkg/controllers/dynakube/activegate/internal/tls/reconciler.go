@@ -10,6 +10,7 @@ import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/certificates"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8sconditions"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8slabel"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8scertmanager"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8ssecret"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/timeprovider"
 	"github.com/pkg/errors"
@@ -31,6 +32,7 @@ type Reconciler struct {
 	dk           *dynakube.DynaKube
 	timeProvider *timeprovider.Provider
 	secrets      k8ssecret.QueryObject
+	certificates k8scertmanager.QueryObject
 }
 
 type ReconcilerBuilder func(client client.Client, apiReader client.Reader, dk *dynakube.DynaKube) *Reconciler
@@ -40,11 +42,21 @@ func NewReconciler(client client.Client, apiReader client.Reader, dk *dynakube.D
 		dk:           dk,
 		timeProvider: timeprovider.New(),
 		secrets:      k8ssecret.Query(client, apiReader, log),
+		certificates: k8scertmanager.Query(client, apiReader, log),
 	}
 }
 
 func (r *Reconciler) Reconcile(ctx context.Context) error {
 	if r.dk.ActiveGate().IsEnabled() && r.dk.ActiveGate().IsAutomaticTLSSecretEnabled() && r.dk.ActiveGate().TLSSecretName == "" {
+		if r.dk.CertManager().IsEnabled() {
+			return r.reconcileCertManagerTLSSecret(ctx)
+		}
+
+		err := r.cleanupCertManager(ctx)
+		if err != nil {
+			return err
+		}
+
 		return r.reconcileSelfSignedTLSSecret(ctx)
 	}
 
@@ -53,24 +65,73 @@ func (r *Reconciler) Reconcile(ctx context.Context) error {
 	}
 	defer meta.RemoveStatusCondition(r.dk.Conditions(), conditionType)
 
+	err := r.cleanupCertManager(ctx)
+	if err != nil {
+		return err
+	}
+
+	r.dk.Status.ActiveGate.TLSCertificate = nil
+	certificates.ClearExpiry(r.dk, activeGateSelfSignedTLSCommonNameSuffix)
+
 	return r.deleteSelfSignedTLSSecret(ctx)
 }
 
 func (r *Reconciler) reconcileSelfSignedTLSSecret(ctx context.Context) error {
-	_, err := r.secrets.Get(ctx, types.NamespacedName{
+	secret, err := r.secrets.Get(ctx, types.NamespacedName{
 		Name:      r.dk.ActiveGate().GetTLSSecretName(),
 		Namespace: r.dk.Namespace,
 	})
-	if err != nil && k8serrors.IsNotFound(err) {
-		return r.createSelfSignedTLSSecret(ctx)
+
+	switch {
+	case k8serrors.IsNotFound(err):
+		secret, err = r.createSelfSignedTLSSecret(ctx)
+	case err != nil:
+		k8sconditions.SetKubeAPIError(r.dk.Conditions(), conditionType, err)
+	case r.renewal().IsDue(secret.Data[consts.TLSCrtDataName]):
+		log.Info("self-signed TLS certificate is about to expire, renewing it", "name", secret.Name)
+
+		secret, err = r.renewSelfSignedTLSSecret(ctx)
+	case !certificates.CoversDNSNames(secret.Data[consts.TLSCrtDataName], r.dnsNames()),
+		!certificates.CoversIPAddresses(secret.Data[consts.TLSCrtDataName], r.dk.Status.ActiveGate.GetAllServiceIPs()):
+		log.Info("self-signed TLS certificate doesn't cover all host names and IP addresses of the ActiveGate, renewing it", "name", secret.Name)
+
+		secret, err = r.renewSelfSignedTLSSecret(ctx)
 	}
 
 	if err != nil {
-		k8sconditions.SetKubeAPIError(r.dk.Conditions(), conditionType, err)
+		return err
+	}
+
+	return r.updateCertificateStatus(secret)
+}
+
+// dnsNames returns the in-cluster names of the ActiveGate services, including the ones of the pools,
+// and the host names the ActiveGate is exposed under with TLS passthrough.
+func (r *Reconciler) dnsNames() []string {
+	dnsNames := certificates.AltNames(r.dk.Name, r.dk.Namespace, activeGateSelfSignedTLSCommonNameSuffix)
+
+	for _, pool := range r.dk.Spec.ActiveGate.Pools {
+		dnsNames = append(dnsNames, certificates.AltNames(r.dk.Name, r.dk.Namespace, activeGateSelfSignedTLSCommonNameSuffix+"-"+pool.Name)...)
+	}
+
+	return append(dnsNames, r.dk.ActiveGate().GetPassthroughHostnames()...)
+}
+
+func (r *Reconciler) renewal() certificates.Renewal {
+	return certificates.NewRenewal(r.timeProvider, r.dk.FF().GetSelfSignedCertificateValidity(), r.dk.FF().GetSelfSignedCertificateRenewalThreshold())
+}
+
+func (r *Reconciler) updateCertificateStatus(secret *corev1.Secret) error {
+	certStatus, err := certificates.GetStatus(secret.Data[consts.TLSCrtDataName])
+	if err != nil {
+		k8sconditions.SetSecretGenFailed(r.dk.Conditions(), conditionType, err)
 
 		return err
 	}
 
+	r.dk.Status.ActiveGate.TLSCertificate = certStatus
+	certificates.RecordExpiry(r.dk, activeGateSelfSignedTLSCommonNameSuffix, certStatus.NotAfter.Time, r.timeProvider.Now().Time)
+
 	return nil
 }
 
@@ -89,24 +150,60 @@ func (r *Reconciler) deleteSelfSignedTLSSecret(ctx context.Context) error {
 	return err
 }
 
-func (r *Reconciler) createSelfSignedTLSSecret(ctx context.Context) error {
-	cert, err := certificates.New(r.timeProvider)
+func (r *Reconciler) createSelfSignedTLSSecret(ctx context.Context) (*corev1.Secret, error) {
+	secret, err := r.buildSelfSignedTLSSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.secrets.Create(ctx, secret)
+	if err != nil {
+		k8sconditions.SetKubeAPIError(r.dk.Conditions(), conditionType, err)
+
+		return nil, err
+	}
+
+	k8sconditions.SetSecretCreated(r.dk.Conditions(), conditionType, secret.Name)
+
+	return secret, nil
+}
+
+func (r *Reconciler) renewSelfSignedTLSSecret(ctx context.Context) (*corev1.Secret, error) {
+	secret, err := r.buildSelfSignedTLSSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.secrets.Update(ctx, secret)
+	if err != nil {
+		k8sconditions.SetKubeAPIError(r.dk.Conditions(), conditionType, err)
+
+		return nil, err
+	}
+
+	k8sconditions.SetSecretCreatedOrUpdated(r.dk.Conditions(), conditionType, secret.Name)
+
+	return secret, nil
+}
+
+func (r *Reconciler) buildSelfSignedTLSSecret() (*corev1.Secret, error) {
+	cert, err := r.renewal().New()
 	if err != nil {
 		k8sconditions.SetSecretGenFailed(r.dk.Conditions(), conditionType, err)
 
-		return err
+		return nil, err
 	}
 
-	cert.Cert.DNSNames = certificates.AltNames(r.dk.Name, r.dk.Namespace, activeGateSelfSignedTLSCommonNameSuffix)
+	cert.Cert.DNSNames = r.dnsNames()
 	cert.Cert.KeyUsage = x509.KeyUsageKeyEncipherment | x509.KeyUsageDataEncipherment
 	cert.Cert.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
 	cert.Cert.Subject.CommonName = certificates.CommonName(r.dk.Name, r.dk.Namespace, activeGateSelfSignedTLSCommonNameSuffix)
 
-	ipAddresses, err := getCertificateAltIPs(r.dk.Status.ActiveGate.ServiceIPs)
+	ipAddresses, err := getCertificateAltIPs(r.dk.Status.ActiveGate.GetAllServiceIPs())
 	if err != nil {
 		k8sconditions.SetSecretGenFailed(r.dk.Conditions(), conditionType, err)
 
-		return err
+		return nil, err
 	}
 
 	cert.Cert.IPAddresses = ipAddresses
@@ -115,14 +212,14 @@ func (r *Reconciler) createSelfSignedTLSSecret(ctx context.Context) error {
 	if err != nil {
 		k8sconditions.SetSecretGenFailed(r.dk.Conditions(), conditionType, err)
 
-		return err
+		return nil, err
 	}
 
 	pemCert, pemPk, err := cert.ToPEM()
 	if err != nil {
 		k8sconditions.SetSecretGenFailed(r.dk.Conditions(), conditionType, err)
 
-		return err
+		return nil, err
 	}
 
 	coreLabels := k8slabel.NewCoreLabels(r.dk.Name, k8slabel.ActiveGateComponentLabel)
@@ -136,21 +233,12 @@ func (r *Reconciler) createSelfSignedTLSSecret(ctx context.Context) error {
 	if err != nil {
 		k8sconditions.SetSecretGenFailed(r.dk.Conditions(), conditionType, err)
 
-		return err
+		return nil, err
 	}
 
 	secret.Type = corev1.SecretTypeOpaque
 
-	err = r.secrets.Create(ctx, secret)
-	if err != nil {
-		k8sconditions.SetKubeAPIError(r.dk.Conditions(), conditionType, err)
-
-		return err
-	}
-
-	k8sconditions.SetSecretCreated(r.dk.Conditions(), conditionType, secret.Name)
-
-	return nil
+	return secret, nil
 }
 
 func getCertificateAltIPs(ips []string) ([]net.IP, error) {
@@ -3,11 +3,14 @@ package tls
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/exp"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/activegate"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
+	"github.com/Dynatrace/dynatrace-operator/pkg/consts"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/certificates"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8sconditions"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -134,6 +137,147 @@ func TestReconciler_Reconcile(t *testing.T) {
 		assert.Equal(t, metav1.ConditionTrue, condition.Status)
 		assert.Equal(t, k8sconditions.SecretCreatedReason, condition.Reason)
 		assert.Equal(t, fmt.Sprintf("%s created", agTLSSecret.Name), condition.Message)
+
+		require.NotNil(t, r.dk.Status.ActiveGate.TLSCertificate)
+		assert.NotEmpty(t, r.dk.Status.ActiveGate.TLSCertificate.Hash)
+	})
+
+	t.Run("secret renewed", func(t *testing.T) {
+		dk := &dynakube.DynaKube{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNamespace,
+				Name:      testDynakubeName,
+				Annotations: map[string]string{
+					exp.SelfSignedCertificateValidityKey: "10",
+				},
+			},
+			Spec: dynakube.DynaKubeSpec{
+				ActiveGate: activegate.Spec{
+					Capabilities: []activegate.CapabilityDisplayName{
+						activegate.RoutingCapability.DisplayName,
+					},
+				},
+			},
+		}
+		fakeClient := fake.NewClient()
+		r := NewReconciler(fakeClient, fakeClient, dk)
+		r.timeProvider.Freeze()
+		issuedAt := r.timeProvider.Now().Time
+
+		require.NoError(t, r.Reconcile(t.Context()))
+
+		initialStatus := *dk.Status.ActiveGate.TLSCertificate
+		assert.Equal(t, issuedAt.Add(10*24*time.Hour).Unix(), initialStatus.NotAfter.Unix())
+
+		r.timeProvider.Set(issuedAt.Add(24 * time.Hour))
+		require.NoError(t, r.Reconcile(t.Context()))
+		assert.Equal(t, initialStatus, *dk.Status.ActiveGate.TLSCertificate)
+
+		r.timeProvider.Set(issuedAt.Add(9 * 24 * time.Hour))
+		require.NoError(t, r.Reconcile(t.Context()))
+
+		renewedStatus := dk.Status.ActiveGate.TLSCertificate
+		assert.NotEqual(t, initialStatus.Hash, renewedStatus.Hash)
+		assert.Equal(t, issuedAt.Add(19*24*time.Hour).Unix(), renewedStatus.NotAfter.Unix())
+
+		agTLSSecret, err := r.secrets.Get(t.Context(), types.NamespacedName{
+			Namespace: r.dk.Namespace,
+			Name:      r.dk.ActiveGate().GetTLSSecretName(),
+		})
+		require.NoError(t, err)
+
+		certStatus, err := certificates.GetStatus(agTLSSecret.Data[consts.TLSCrtDataName])
+		require.NoError(t, err)
+		assert.Equal(t, renewedStatus.Hash, certStatus.Hash)
+	})
+
+	t.Run("secret renewed if TLSRoute host names are missing", func(t *testing.T) {
+		dk := &dynakube.DynaKube{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNamespace,
+				Name:      testDynakubeName,
+			},
+			Spec: dynakube.DynaKubeSpec{
+				ActiveGate: activegate.Spec{
+					Capabilities: []activegate.CapabilityDisplayName{
+						activegate.RoutingCapability.DisplayName,
+					},
+				},
+			},
+		}
+		fakeClient := fake.NewClient()
+		r := NewReconciler(fakeClient, fakeClient, dk)
+		require.NoError(t, r.Reconcile(t.Context()))
+
+		initialHash := dk.Status.ActiveGate.TLSCertificate.Hash
+
+		dk.Spec.ActiveGate.Exposure = &activegate.ExposureSpec{
+			Gateway: &activegate.GatewaySpec{
+				RouteKind:  activegate.TLSRouteKind,
+				ParentRefs: []activegate.GatewayParentRef{{Name: "gateway"}},
+				Hostnames:  []string{"ag.example.com"},
+			},
+		}
+		require.NoError(t, r.Reconcile(t.Context()))
+		assert.NotEqual(t, initialHash, dk.Status.ActiveGate.TLSCertificate.Hash)
+
+		agTLSSecret, err := r.secrets.Get(t.Context(), types.NamespacedName{
+			Namespace: r.dk.Namespace,
+			Name:      r.dk.ActiveGate().GetTLSSecretName(),
+		})
+		require.NoError(t, err)
+		assert.True(t, certificates.CoversDNSNames(agTLSSecret.Data[consts.TLSCrtDataName], []string{"ag.example.com"}))
+
+		renewedHash := dk.Status.ActiveGate.TLSCertificate.Hash
+		require.NoError(t, r.Reconcile(t.Context()))
+		assert.Equal(t, renewedHash, dk.Status.ActiveGate.TLSCertificate.Hash)
+	})
+
+	t.Run("secret renewed if pool is added", func(t *testing.T) {
+		dk := &dynakube.DynaKube{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNamespace,
+				Name:      testDynakubeName,
+			},
+			Spec: dynakube.DynaKubeSpec{
+				ActiveGate: activegate.Spec{
+					Capabilities: []activegate.CapabilityDisplayName{
+						activegate.KubeMonCapability.DisplayName,
+					},
+				},
+			},
+			Status: dynakube.DynaKubeStatus{
+				ActiveGate: activegate.Status{
+					ServiceIPs: []string{"10.0.0.1"},
+				},
+			},
+		}
+		fakeClient := fake.NewClient()
+		r := NewReconciler(fakeClient, fakeClient, dk)
+		require.NoError(t, r.Reconcile(t.Context()))
+
+		initialHash := dk.Status.ActiveGate.TLSCertificate.Hash
+
+		dk.Spec.ActiveGate.Pools = []activegate.PoolSpec{
+			{Name: "routing", Capabilities: []activegate.CapabilityDisplayName{activegate.RoutingCapability.DisplayName}},
+		}
+		dk.Status.ActiveGate.Pools = []activegate.PoolStatus{{Name: "routing", ServiceIPs: []string{"10.0.0.2"}}}
+		require.NoError(t, r.Reconcile(t.Context()))
+		assert.NotEqual(t, initialHash, dk.Status.ActiveGate.TLSCertificate.Hash)
+
+		agTLSSecret, err := r.secrets.Get(t.Context(), types.NamespacedName{
+			Namespace: r.dk.Namespace,
+			Name:      r.dk.ActiveGate().GetTLSSecretName(),
+		})
+		require.NoError(t, err)
+
+		pemCert := agTLSSecret.Data[consts.TLSCrtDataName]
+		assert.True(t, certificates.CoversDNSNames(pemCert, []string{testDynakubeName + "-activegate-routing." + testNamespace + ".svc"}))
+		assert.True(t, certificates.CoversIPAddresses(pemCert, []string{"10.0.0.1", "10.0.0.2"}))
+
+		renewedHash := dk.Status.ActiveGate.TLSCertificate.Hash
+		require.NoError(t, r.Reconcile(t.Context()))
+		assert.Equal(t, renewedHash, dk.Status.ActiveGate.TLSCertificate.Hash)
 	})
 
 	t.Run("secret deleted", func(t *testing.T) {
@@ -174,5 +318,6 @@ func TestReconciler_Reconcile(t *testing.T) {
 		require.Error(t, err)
 
 		assert.True(t, k8serrors.IsNotFound(err))
+		assert.Nil(t, r.dk.Status.ActiveGate.TLSCertificate)
 	})
 }
@@ -11,6 +11,10 @@ import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/internal/authtoken"
 	capabilityInternal "github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/internal/capability"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/internal/customproperties"
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/internal/exposure"
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/internal/hpa"
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/internal/pdb"
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/internal/pool"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/internal/statefulset"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/internal/tls"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/connectioninfo"
@@ -197,8 +201,29 @@ func (r *Reconciler) createCapability(ctx context.Context, agCapability capabili
 	tlsSecretReconciler := tls.NewReconciler(r.client, r.apiReader, r.dk)
 
 	capabilityReconciler := r.newCapabilityReconcilerFunc(r.client, agCapability, r.dk, statefulsetReconciler, customPropertiesReconciler, tlsSecretReconciler)
+	poolReconciler := pool.NewReconciler(r.client, r.apiReader, r.dk)
 
-	return capabilityReconciler.Reconcile(ctx)
+	if err := poolReconciler.ReconcileServices(ctx); err != nil {
+		return err
+	}
+
+	if err := capabilityReconciler.Reconcile(ctx); err != nil {
+		return err
+	}
+
+	if err := poolReconciler.ReconcileStatefulSets(ctx); err != nil {
+		return err
+	}
+
+	if err := pdb.NewReconciler(r.client, r.apiReader, r.dk).Reconcile(ctx); err != nil {
+		return err
+	}
+
+	if err := exposure.NewReconciler(r.client, r.apiReader, r.dk).Reconcile(ctx); err != nil {
+		return err
+	}
+
+	return hpa.NewReconciler(r.client, r.apiReader, r.dk).Reconcile(ctx)
 }
 
 func (r *Reconciler) deleteCapability(ctx context.Context) error {
@@ -210,6 +235,11 @@ func (r *Reconciler) deleteCapability(ctx context.Context) error {
 		return err
 	}
 
+	// the pools can't exist without the main ActiveGate, so all of them are removed
+	if err := pool.NewReconciler(r.client, r.apiReader, r.dk).ReconcileServices(ctx); err != nil {
+		return err
+	}
+
 	// we must run tls reconciler to ensure that the TLS secret is deleted
 	// TODO: consider to not mix two different patterns
 	tlsSecretReconciler := tls.NewReconciler(r.client, r.apiReader, r.dk)
@@ -217,6 +247,22 @@ func (r *Reconciler) deleteCapability(ctx context.Context) error {
 		return err
 	}
 
+	// same as for TLS, the HPA, PDB and exposure reconcilers take care of removing previously created objects
+	hpaReconciler := hpa.NewReconciler(r.client, r.apiReader, r.dk)
+	if err := hpaReconciler.Reconcile(ctx); err != nil {
+		return err
+	}
+
+	pdbReconciler := pdb.NewReconciler(r.client, r.apiReader, r.dk)
+	if err := pdbReconciler.Reconcile(ctx); err != nil {
+		return err
+	}
+
+	exposureReconciler := exposure.NewReconciler(r.client, r.apiReader, r.dk)
+	if err := exposureReconciler.Reconcile(ctx); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -0,0 +1,93 @@
+package oaconnectioninfo
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/capability"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// setCommunicationEndpoints stores the communication endpoints received from the tenant in the status,
+// except the ones of in-cluster ActiveGates that have no ready replicas.
+// The tenant only lists an in-cluster ActiveGate once it has registered, so together with the readiness of its StatefulSet
+// the OneAgents and code modules only connect to it once it is able to handle their traffic.
+// If no other endpoints would remain, all of them are kept, as the OneAgents can't work without any.
+func (r *reconciler) setCommunicationEndpoints(ctx context.Context, tenantEndpoints string) error {
+	unavailableEndpoints, err := r.getUnavailableActiveGateEndpoints(ctx)
+	if err != nil {
+		return err
+	}
+
+	var endpoints, withheldEndpoints []string
+
+	for endpoint := range strings.SplitSeq(tenantEndpoints, ",") {
+		if slices.Contains(unavailableEndpoints, endpoint) {
+			withheldEndpoints = append(withheldEndpoints, endpoint)
+		} else {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+
+	switch {
+	case len(withheldEndpoints) == 0:
+	case len(endpoints) == 0:
+		log.Info("in-cluster ActiveGate is not ready, but it is the only communication endpoint", "endpoints", withheldEndpoints)
+
+		endpoints = withheldEndpoints
+	default:
+		log.Info("withholding communication endpoints of in-cluster ActiveGate until it is ready", "endpoints", withheldEndpoints)
+	}
+
+	r.dk.Status.OneAgent.ConnectionInfo.Endpoints = strings.Join(endpoints, ",")
+
+	return nil
+}
+
+// getUnavailableActiveGateEndpoints returns the communication endpoints of the main ActiveGate and its pools,
+// for which the StatefulSet doesn't exist yet or has no ready replicas.
+func (r *reconciler) getUnavailableActiveGateEndpoints(ctx context.Context) ([]string, error) {
+	if !r.dk.ActiveGate().IsEnabled() {
+		return nil, nil
+	}
+
+	entryPoints := map[string]string{
+		capability.CalculateStatefulSetName(r.dk.Name): capability.BuildDNSEntryPoint(*r.dk),
+	}
+
+	for _, pool := range r.dk.Spec.ActiveGate.Pools {
+		entryPoints[capability.BuildPoolName(r.dk.Name, pool.Name)] = capability.BuildPoolDNSEntryPoint(*r.dk, pool.Name)
+	}
+
+	var unavailableEndpoints []string
+
+	for statefulSetName, entryPoint := range entryPoints {
+		if entryPoint == "" {
+			continue
+		}
+
+		isReady, err := r.isStatefulSetReady(ctx, statefulSetName)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isReady {
+			unavailableEndpoints = append(unavailableEndpoints, strings.Split(entryPoint, ",")...)
+		}
+	}
+
+	return unavailableEndpoints, nil
+}
+
+func (r *reconciler) isStatefulSetReady(ctx context.Context, name string) (bool, error) {
+	statefulSet, err := r.statefulSets.Get(ctx, types.NamespacedName{Name: name, Namespace: r.dk.Namespace})
+	if k8serrors.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return statefulSet.Status.ReadyReplicas > 0, nil
+}
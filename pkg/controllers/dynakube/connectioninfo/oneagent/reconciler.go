@@ -11,9 +11,11 @@ import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/hasher"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8sconditions"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8ssecret"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8sstatefulset"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/timeprovider"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -26,6 +28,7 @@ type reconciler struct {
 	timeProvider *timeprovider.Provider
 	dk           *dynakube.DynaKube
 	secrets      k8ssecret.QueryObject
+	statefulSets k8sstatefulset.QueryObject
 }
 type ReconcilerBuilder func(clt client.Client, apiReader client.Reader, dtc dtclient.Client, dk *dynakube.DynaKube) controllers.Reconciler
 
@@ -38,6 +41,7 @@ func NewReconciler(clt client.Client, apiReader client.Reader, dtc dtclient.Clie
 		dtc:          dtc,
 		timeProvider: timeprovider.New(),
 		secrets:      k8ssecret.Query(clt, apiReader, log),
+		statefulSets: k8sstatefulset.Query(clt, apiReader, log),
 	}
 }
 
@@ -81,19 +85,19 @@ func (r *reconciler) reconcileConnectionInfo(ctx context.Context) error {
 	secretNamespacedName := types.NamespacedName{Name: r.dk.OneAgent().GetTenantSecret(), Namespace: r.dk.Namespace}
 
 	if !k8sconditions.IsOutdated(r.timeProvider, r.dk, oaConnectionInfoConditionType) {
-		isSecretPresent, err := connectioninfo.IsTenantSecretPresent(ctx, r.secrets, secretNamespacedName, log)
+		tenantEndpoints, err := r.getCachedTenantEndpoints(ctx, secretNamespacedName)
 		if err != nil {
 			return err
 		}
 
 		condition := meta.FindStatusCondition(*r.dk.Conditions(), oaConnectionInfoConditionType)
-		if isSecretPresent {
+		if tenantEndpoints != "" {
 			log.Info(dynakube.GetCacheValidMessage(
 				"OneAgent connection info update",
 				condition.LastTransitionTime,
 				r.dk.APIRequestThreshold()))
 
-			return nil
+			return r.setCommunicationEndpoints(ctx, tenantEndpoints)
 		}
 	}
 
@@ -122,6 +126,11 @@ func (r *reconciler) reconcileConnectionInfo(ctx context.Context) error {
 		return err
 	}
 
+	err = r.setCommunicationEndpoints(ctx, connectionInfo.Endpoints)
+	if err != nil {
+		return err
+	}
+
 	r.dk.Status.OneAgent.ConnectionInfo.TenantTokenHash, err = hasher.GenerateHash(connectionInfo.TenantToken)
 	if err != nil {
 		return errors.Wrap(err, "failed to generate TenantTokenHash")
@@ -137,12 +146,30 @@ func (r *reconciler) setDynakubeStatus(connectionInfo dtclient.OneAgentConnectio
 	r.dk.Status.OneAgent.ConnectionInfo.Endpoints = connectionInfo.Endpoints
 }
 
+// getCachedTenantEndpoints returns the communication endpoints stored in the tenant secret.
+// An empty string is returned if the secret is missing or was created before the endpoints were stored in it.
+func (r *reconciler) getCachedTenantEndpoints(ctx context.Context, secretNamespacedName types.NamespacedName) (string, error) {
+	secret, err := r.secrets.Get(ctx, secretNamespacedName)
+	if k8serrors.IsNotFound(err) {
+		log.Info("creating secret, because missing", "secretName", secretNamespacedName.Name)
+
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	return string(secret.Data[connectioninfo.CommunicationEndpointsKey]), nil
+}
+
 func (r *reconciler) createTenantTokenSecret(ctx context.Context, secretName string, connectionInfo dtclient.ConnectionInfo) error {
 	secret, err := connectioninfo.BuildTenantSecret(r.dk, secretName, connectionInfo.TenantToken)
 	if err != nil {
 		return errors.WithStack(err)
 	}
 
+	// the endpoints are kept as received from the tenant, so they can be filtered again on every reconcile
+	secret.Data[connectioninfo.CommunicationEndpointsKey] = []byte(connectionInfo.Endpoints)
+
 	_, err = r.secrets.CreateOrUpdate(ctx, secret)
 	if err != nil {
 		log.Info("could not create or update secret for connection info", "name", secret.Name)
@@ -2,10 +2,12 @@ package oaconnectioninfo
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/activegate"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/oneagent"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/communication"
@@ -18,6 +20,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -147,6 +150,7 @@ func TestReconcile(t *testing.T) {
 		err = fakeClient.Get(ctx, client.ObjectKey{Name: dk.OneAgent().GetTenantSecret(), Namespace: testNamespace}, &actualSecret)
 		require.NoError(t, err)
 		assert.Equal(t, []byte(testTenantToken), actualSecret.Data[connectioninfo.TenantTokenKey])
+		assert.Equal(t, []byte(testTenantEndpoints), actualSecret.Data[connectioninfo.CommunicationEndpointsKey])
 
 		assertCondition(t, dk, metav1.ConditionTrue, k8sconditions.SecretCreatedReason)
 	})
@@ -259,6 +263,105 @@ func TestReconcile(t *testing.T) {
 	})
 }
 
+func TestReconcile_ActiveGateEndpoints(t *testing.T) {
+	const (
+		publicEndpoint    = "https://tenant.dev.dynatracelabs.com:443"
+		agIPEndpoint      = "https://10.0.0.1:443/communication"
+		agServiceEndpoint = "https://" + testName + "-activegate." + testNamespace + ":443/communication"
+		poolIPEndpoint    = "https://10.0.0.2:443/communication"
+	)
+
+	tenantEndpoints := strings.Join([]string{agIPEndpoint, agServiceEndpoint, poolIPEndpoint, publicEndpoint}, ",")
+
+	getActiveGateDynakube := func() *dynakube.DynaKube {
+		dk := getTestDynakube()
+		dk.Spec.ActiveGate = activegate.Spec{
+			Capabilities: []activegate.CapabilityDisplayName{activegate.RoutingCapability.DisplayName},
+			Pools: []activegate.PoolSpec{
+				{Name: "pool", Capabilities: []activegate.CapabilityDisplayName{activegate.RoutingCapability.DisplayName}},
+			},
+		}
+		dk.Status.ActiveGate.ServiceIPs = []string{"10.0.0.1"}
+		dk.Status.ActiveGate.Pools = []activegate.PoolStatus{{Name: "pool", ServiceIPs: []string{"10.0.0.2"}}}
+
+		return dk
+	}
+
+	reconcileWithTenantEndpoints := func(t *testing.T, dk *dynakube.DynaKube, endpoints string, objs ...client.Object) {
+		t.Helper()
+
+		fakeClient := fake.NewClient(append(objs, dk)...)
+		dtc := dtclientmock.NewClient(t)
+		connectionInfo := getTestOneAgentConnectionInfo()
+		connectionInfo.Endpoints = endpoints
+		dtc.EXPECT().GetOneAgentConnectionInfo(anyCtx).Return(connectionInfo, nil).Once()
+
+		require.NoError(t, NewReconciler(fakeClient, fakeClient, dtc, dk).Reconcile(t.Context()))
+	}
+
+	t.Run("endpoints of ActiveGates without StatefulSet are withheld", func(t *testing.T) {
+		dk := getActiveGateDynakube()
+
+		reconcileWithTenantEndpoints(t, dk, tenantEndpoints)
+
+		assert.Equal(t, publicEndpoint, dk.Status.OneAgent.ConnectionInfo.Endpoints)
+	})
+
+	t.Run("only endpoints of ready ActiveGates are included", func(t *testing.T) {
+		dk := getActiveGateDynakube()
+
+		reconcileWithTenantEndpoints(t, dk, tenantEndpoints,
+			createStatefulSet(testName+"-activegate", 1),
+			createStatefulSet(testName+"-activegate-pool", 0),
+		)
+
+		assert.Equal(t, strings.Join([]string{agIPEndpoint, agServiceEndpoint, publicEndpoint}, ","), dk.Status.OneAgent.ConnectionInfo.Endpoints)
+	})
+
+	t.Run("endpoints are kept if the ActiveGate is the only one", func(t *testing.T) {
+		dk := getActiveGateDynakube()
+
+		reconcileWithTenantEndpoints(t, dk, agIPEndpoint)
+
+		assert.Equal(t, agIPEndpoint, dk.Status.OneAgent.ConnectionInfo.Endpoints)
+	})
+
+	t.Run("cached endpoints are updated once the ActiveGate is ready and removed once it becomes unavailable", func(t *testing.T) {
+		dk := getActiveGateDynakube()
+		dk.Spec.ActiveGate.Pools = nil
+		dk.Status.OneAgent.ConnectionInfo.Endpoints = publicEndpoint
+		k8sconditions.SetSecretCreated(dk.Conditions(), oaConnectionInfoConditionType, "testing")
+
+		tenantSecret := buildOneAgentTenantSecret(dk, testTenantToken)
+		tenantSecret.Data[connectioninfo.CommunicationEndpointsKey] = []byte(agIPEndpoint + "," + publicEndpoint)
+		statefulSet := createStatefulSet(testName+"-activegate", 1)
+		fakeClient := fake.NewClient(dk, tenantSecret, statefulSet)
+		dtc := dtclientmock.NewClient(t)
+		r := NewReconciler(fakeClient, fakeClient, dtc, dk)
+
+		require.NoError(t, r.Reconcile(t.Context()))
+		assert.Equal(t, agIPEndpoint+","+publicEndpoint, dk.Status.OneAgent.ConnectionInfo.Endpoints)
+
+		statefulSet.Status.ReadyReplicas = 0
+		require.NoError(t, fakeClient.Status().Update(t.Context(), statefulSet))
+
+		require.NoError(t, r.Reconcile(t.Context()))
+		assert.Equal(t, publicEndpoint, dk.Status.OneAgent.ConnectionInfo.Endpoints)
+	})
+
+	t.Run("connection info is requested if the cached secret has no endpoints", func(t *testing.T) {
+		dk := getActiveGateDynakube()
+		k8sconditions.SetSecretCreated(dk.Conditions(), oaConnectionInfoConditionType, "testing")
+
+		tenantSecret := buildOneAgentTenantSecret(dk, testTenantToken)
+		delete(tenantSecret.Data, connectioninfo.CommunicationEndpointsKey)
+
+		reconcileWithTenantEndpoints(t, dk, publicEndpoint, tenantSecret)
+
+		assert.Equal(t, publicEndpoint, dk.Status.OneAgent.ConnectionInfo.Endpoints)
+	})
+}
+
 func TestReconcile_NoOneAgentCommunicationHosts(t *testing.T) {
 	ctx := t.Context()
 	dk := &dynakube.DynaKube{
@@ -318,7 +421,20 @@ func buildOneAgentTenantSecret(dk *dynakube.DynaKube, token string) *corev1.Secr
 			Namespace: testNamespace,
 		},
 		Data: map[string][]byte{
-			connectioninfo.TenantTokenKey: []byte(token),
+			connectioninfo.TenantTokenKey:            []byte(token),
+			connectioninfo.CommunicationEndpointsKey: []byte(testOutdated),
+		},
+	}
+}
+
+func createStatefulSet(name string, readyReplicas int32) *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testNamespace,
+		},
+		Status: appsv1.StatefulSetStatus{
+			ReadyReplicas: readyReplicas,
 		},
 	}
 }
@@ -23,10 +23,12 @@ import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/kspm"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/logmonitoring"
 	logmondaemonset "github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/logmonitoring/daemonset"
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/networkpolicy"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/oneagent"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/otelc"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/proxy"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/token"
+	"github.com/Dynatrace/dynatrace-operator/pkg/injection/imageindex"
 	"github.com/Dynatrace/dynatrace-operator/pkg/injection/namespace/mapper"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/hasher"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8sconditions"
@@ -34,6 +36,7 @@ import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8scrd"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8sevent"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/system"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/oci/registry"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/timeprovider"
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
@@ -98,10 +101,18 @@ func NewDynaKubeController(kubeClient client.Client, apiReader client.Reader, ev
 		kspmReconciler:      kspm.NewReconciler(kubeClient, apiReader),
 		k8sEntityReconciler: k8sentity.NewReconciler(),
 		otelcReconciler:     otelc.NewReconciler(kubeClient, apiReader),
+
+		networkPolicyReconciler: networkpolicy.NewReconciler(kubeClient, apiReader),
+
+		imageInspector: imageindex.NewInspector(kubeClient, apiReader, registry.NewClient),
 	}
 }
 
 func (controller *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.Add(controller.imageInspector); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&dynakube.DynaKube{}).
 		Named(controllerName).
@@ -135,6 +146,11 @@ type Controller struct {
 	kspmReconciler      dtSettingReconciler
 	otelcReconciler     dynakubeReconciler
 
+	networkPolicyReconciler dynakubeReconciler
+
+	// imageInspector inspects the images of the image index in the background, it's shared by the reconciles of all DynaKubes.
+	imageInspector *imageindex.Inspector
+
 	dynatraceClientBuilder dynatraceclient.Builder
 	config                 *rest.Config
 	istioClientBuilder     istio.ClientBuilder
@@ -375,6 +391,14 @@ func (controller *Controller) reconcileComponents(ctx context.Context, dynatrace
 		componentErrors = append(componentErrors, err)
 	}
 
+	log.Info("start reconciling NetworkPolicies")
+
+	if err := controller.networkPolicyReconciler.Reconcile(ctx, dk); err != nil {
+		log.Info("could not reconcile NetworkPolicies")
+
+		componentErrors = append(componentErrors, err)
+	}
+
 	if err := controller.k8sEntityReconciler.Reconcile(ctx, dynatraceClient.AsV2().Settings, dk); err != nil {
 		componentErrors = append(componentErrors, err)
 	}
@@ -417,6 +441,7 @@ func (controller *Controller) reconcileComponents(ctx context.Context, dynatrace
 		controller.apiReader,
 		dynatraceClient,
 		istioClient,
+		controller.imageInspector,
 		dk,
 	).Reconcile(ctx)
 	if err != nil {
@@ -25,6 +25,7 @@ import (
 	oneagentcontroller "github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/oneagent"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/proxy"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/token"
+	"github.com/Dynatrace/dynatrace-operator/pkg/injection/imageindex"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8senv"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8slabel"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8scrd"
@@ -371,6 +372,7 @@ func TestReconcileComponents(t *testing.T) {
 		mockKSPMReconciler := newMockdtSettingReconciler(t)
 		mockK8sEntityReconciler := newMockdtSettingReconciler(t)
 		mockOtelcReconciler := newMockdynakubeReconciler(t)
+		mockNetworkPolicyReconciler := newMockdynakubeReconciler(t)
 
 		controller := &Controller{
 			client:    fakeClient,
@@ -384,6 +386,7 @@ func TestReconcileComponents(t *testing.T) {
 			otelcReconciler:                mockOtelcReconciler,
 			kspmReconciler:                 mockKSPMReconciler,
 			k8sEntityReconciler:            mockK8sEntityReconciler,
+			networkPolicyReconciler:        mockNetworkPolicyReconciler,
 		}
 		mockedDtc := dtclientmock.NewClient(t)
 		mockedDtc.EXPECT().AsV2().Return(&dtclient.ClientV2{Settings: &settings.Client{}})
@@ -397,6 +400,7 @@ func TestReconcileComponents(t *testing.T) {
 		expectReconcileError(t, mockOtelcReconciler, &err, dk)
 		expectReconcileError(t, mockKSPMReconciler, &err, &settings.Client{}, dk)
 		expectReconcileError(t, mockK8sEntityReconciler, &err, &settings.Client{}, dk)
+		expectReconcileError(t, mockNetworkPolicyReconciler, &err, dk)
 
 		err = controller.reconcileComponents(ctx, mockedDtc, nil, dk)
 		require.Error(t, err)
@@ -410,6 +414,7 @@ func TestReconcileComponents(t *testing.T) {
 		mockExtensionReconciler := newMockdynakubeReconciler(t)
 		mockOtelcReconciler := newMockdynakubeReconciler(t)
 		k8sEntityReconciler := newMockdtSettingReconciler(t)
+		mockNetworkPolicyReconciler := newMockdynakubeReconciler(t)
 
 		mockLogMonitoringReconciler := controllermock.NewReconciler(t)
 		mockLogMonitoringReconciler.EXPECT().Reconcile(anyCtx).Return(oaconnectioninfo.NoOneAgentCommunicationEndpointsError).Once()
@@ -422,6 +427,7 @@ func TestReconcileComponents(t *testing.T) {
 			extensionReconciler:            mockExtensionReconciler,
 			otelcReconciler:                mockOtelcReconciler,
 			k8sEntityReconciler:            k8sEntityReconciler,
+			networkPolicyReconciler:        mockNetworkPolicyReconciler,
 		}
 		mockedDtc := dtclientmock.NewClient(t)
 		mockedDtc.EXPECT().AsV2().Return(&dtclient.ClientV2{Settings: &settings.Client{}})
@@ -431,6 +437,7 @@ func TestReconcileComponents(t *testing.T) {
 		expectReconcileError(t, mockExtensionReconciler, &err, dk)
 		expectReconcileError(t, mockOtelcReconciler, &err, dk)
 		expectReconcileError(t, k8sEntityReconciler, &err, &settings.Client{}, dk)
+		expectReconcileError(t, mockNetworkPolicyReconciler, &err, dk)
 
 		err = controller.reconcileComponents(ctx, mockedDtc, nil, dk)
 		require.Error(t, err)
@@ -492,6 +499,9 @@ func TestReconcileDynaKube(t *testing.T) {
 	mockK8sEntityReconciler := newMockdtSettingReconciler(t)
 	mockK8sEntityReconciler.EXPECT().Reconcile(anyCtx, &settings.Client{}, anyDynaKube).Return(nil)
 
+	mockNetworkPolicyReconciler := newMockdynakubeReconciler(t)
+	mockNetworkPolicyReconciler.EXPECT().Reconcile(anyCtx, anyDynaKube).Return(nil)
+
 	fakeIstio := fakeistio.NewSimpleClientset()
 
 	baseController := &Controller{
@@ -510,6 +520,7 @@ func TestReconcileDynaKube(t *testing.T) {
 		proxyReconcilerBuilder:              createProxyReconcilerBuilder(mockProxyReconciler),
 		kspmReconciler:                      mockKSPMReconciler,
 		k8sEntityReconciler:                 mockK8sEntityReconciler,
+		networkPolicyReconciler:             mockNetworkPolicyReconciler,
 	}
 
 	request := reconcile.Request{
@@ -575,7 +586,7 @@ func createLogMonitoringReconcilerBuilder(reconciler controllers.Reconciler) log
 }
 
 func createInjectionReconcilerBuilder(reconciler controllers.Reconciler) injection.ReconcilerBuilder {
-	return func(client client.Client, apiReader client.Reader, dynatraceClient dtclient.Client, istioClient *istio.Client, dk *dynakube.DynaKube) controllers.Reconciler {
+	return func(client client.Client, apiReader client.Reader, dynatraceClient dtclient.Client, istioClient *istio.Client, imageInspector *imageindex.Inspector, dk *dynakube.DynaKube) controllers.Reconciler {
 		return reconciler
 	}
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/extensions"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/sizing"
 	"github.com/Dynatrace/dynatrace-operator/pkg/consts"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8slabel"
 	appsv1 "k8s.io/api/apps/v1"
@@ -117,7 +118,7 @@ func buildContainer(dk *dynakube.DynaKube, dbSpec extensions.DatabaseSpec) corev
 			FailureThreshold:    3,
 			SuccessThreshold:    1,
 		},
-		Resources:       buildContainerResources(dbSpec.Resources),
+		Resources:       buildContainerResources(dk, dbSpec.Resources),
 		SecurityContext: buildContainerSecurityContext(),
 		VolumeMounts:    buildVolumeMounts(dk, dbSpec),
 	}
@@ -241,12 +242,12 @@ func buildVolumes(dk *dynakube.DynaKube, dbSpec extensions.DatabaseSpec) []corev
 	return append(volumes, dbSpec.Volumes...)
 }
 
-func buildContainerResources(custom *corev1.ResourceRequirements) corev1.ResourceRequirements {
+func buildContainerResources(dk *dynakube.DynaKube, custom *corev1.ResourceRequirements) corev1.ResourceRequirements {
 	if custom != nil {
 		return *custom
 	}
 
-	return corev1.ResourceRequirements{
+	defaults := corev1.ResourceRequirements{
 		Requests: corev1.ResourceList{
 			corev1.ResourceMemory: resource.MustParse("256Mi"),
 			corev1.ResourceCPU:    resource.MustParse("250m"),
@@ -256,6 +257,12 @@ func buildContainerResources(custom *corev1.ResourceRequirements) corev1.Resourc
 			corev1.ResourceCPU:    resource.MustParse("500m"),
 		},
 	}
+
+	if profile := dk.Sizing().SQLExtensionExecutor; profile != nil {
+		return sizing.Merge(*profile, &defaults)
+	}
+
+	return defaults
 }
 
 func buildPodSecurityContext() *corev1.PodSecurityContext {
@@ -0,0 +1,57 @@
+package databases
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/extensions"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8spdb"
+	policyv1 "k8s.io/api/policy/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (r *Reconciler) reconcilePodDisruptionBudget(ctx context.Context, dbSpec extensions.DatabaseSpec, replicas int32) error {
+	deploymentLabels, matchLabels, _ := buildAllLabels(r.dk, dbSpec)
+
+	// All executor deployments share the same base match labels, so the budget has to select on the executor ID as well.
+	selector := maps.Clone(matchLabels)
+	selector[executorIDLabelKey] = dbSpec.ID
+
+	desired, err := k8spdb.Build(r.dk, r.dk.Extensions().GetDatabaseDatasourceName(dbSpec.ID), selector,
+		k8spdb.SetLabels(deploymentLabels),
+		k8spdb.SetBudget(dbSpec.PodDisruptionBudget),
+	)
+	if err != nil {
+		return err
+	}
+
+	return k8spdb.CreateOrDelete(ctx, k8spdb.Query(r.client, r.apiReader, log), desired, dbSpec.PodDisruptionBudget.IsEnabled(replicas))
+}
+
+func (r *Reconciler) deletePodDisruptionBudgets(ctx context.Context, keep []string) error {
+	budgets := &policyv1.PodDisruptionBudgetList{}
+
+	// Same as for the deployments, an empty DB spec is used to find budgets of removed databases.
+	deploymentLabels, _, _ := buildAllLabels(r.dk, extensions.DatabaseSpec{})
+
+	if err := r.client.List(ctx, budgets, client.InNamespace(r.dk.Namespace), sanitizedListLabels(deploymentLabels)); err != nil {
+		return fmt.Errorf("list pod disruption budgets: %w", err)
+	}
+
+	for _, budget := range budgets.Items {
+		if slices.Contains(keep, budget.Name) {
+			continue
+		}
+
+		if err := r.client.Delete(ctx, &budget); err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("delete pod disruption budget %s: %w", budget.Name, err)
+		}
+
+		log.Info("deleted pod disruption budget", "name", budget.Name)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8sconditions"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8spodtemplate"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8sdeployment"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -43,6 +44,12 @@ func (r *Reconciler) Reconcile(ctx context.Context) error {
 		return err
 	}
 
+	if err := r.deletePodDisruptionBudgets(ctx, expectedDeploymentNames); err != nil {
+		k8sconditions.SetKubeAPIError(r.dk.Conditions(), conditionType, err)
+
+		return err
+	}
+
 	for i, dbSpec := range ext.Databases {
 		replicas, err := r.getReplicas(ctx, expectedDeploymentNames[i], dbSpec.Replicas)
 		if err != nil {
@@ -73,6 +80,11 @@ func (r *Reconciler) Reconcile(ctx context.Context) error {
 			return err
 		}
 
+		err = k8spodtemplate.ApplyOverride(&deploy.Spec.Template, r.dk.Spec.Templates.SQLExtensionExecutor.GetOverride())
+		if err != nil {
+			return err
+		}
+
 		changed, err := query.WithOwner(r.dk).CreateOrUpdate(ctx, deploy)
 		if err != nil {
 			k8sconditions.SetKubeAPIError(r.dk.Conditions(), conditionType, err)
@@ -83,6 +95,12 @@ func (r *Reconciler) Reconcile(ctx context.Context) error {
 		if changed {
 			log.Info("deployment created or updated", "name", deploy.Name)
 		}
+
+		if err := r.reconcilePodDisruptionBudget(ctx, dbSpec, replicas); err != nil {
+			k8sconditions.SetKubeAPIError(r.dk.Conditions(), conditionType, err)
+
+			return err
+		}
 	}
 
 	if len(expectedDeploymentNames) > 0 {
@@ -9,16 +9,19 @@ import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/extensions"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/image"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/pdb"
 	"github.com/Dynatrace/dynatrace-operator/pkg/consts"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8slabel"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -201,6 +204,72 @@ func TestReconcileSpec(t *testing.T) {
 	})
 }
 
+func TestReconcilePodDisruptionBudget(t *testing.T) {
+	listBudgets := func(t *testing.T, clt client.Client) []policyv1.PodDisruptionBudget {
+		t.Helper()
+
+		budgets := &policyv1.PodDisruptionBudgetList{}
+		require.NoError(t, clt.List(t.Context(), budgets))
+
+		return budgets.Items
+	}
+
+	t.Run("no budget for single replica", func(t *testing.T) {
+		dk := getTestDynakube()
+		clt := fakeClient()
+
+		require.NoError(t, NewReconciler(clt, clt, dk).Reconcile(t.Context()))
+		assert.Empty(t, listBudgets(t, clt))
+	})
+
+	t.Run("budget per executor", func(t *testing.T) {
+		dk := getTestDynakube()
+		dk.Spec.Extensions.Databases[0].Replicas = ptr.To(int32(2))
+		dk.Spec.Extensions.Databases = append(dk.Spec.Extensions.Databases, extensions.DatabaseSpec{
+			ID:                  "other",
+			Replicas:            ptr.To(int32(1)),
+			PodDisruptionBudget: &pdb.Spec{MinAvailable: ptr.To(intstr.FromInt32(1))},
+		})
+		clt := fakeClient()
+
+		require.NoError(t, NewReconciler(clt, clt, dk).Reconcile(t.Context()))
+
+		budgets := listBudgets(t, clt)
+		require.Len(t, budgets, 2)
+
+		byName := make(map[string]policyv1.PodDisruptionBudget, len(budgets))
+		for _, budget := range budgets {
+			byName[budget.Name] = budget
+		}
+
+		scaled := byName[dk.Extensions().GetDatabaseDatasourceName("test")]
+		assert.Equal(t, "test", scaled.Spec.Selector.MatchLabels[executorIDLabelKey])
+		assert.Equal(t, intstr.FromInt32(1), *scaled.Spec.MaxUnavailable)
+
+		configured := byName[dk.Extensions().GetDatabaseDatasourceName("other")]
+		assert.Equal(t, "other", configured.Spec.Selector.MatchLabels[executorIDLabelKey])
+		assert.Equal(t, intstr.FromInt32(1), *configured.Spec.MinAvailable)
+		assert.Nil(t, configured.Spec.MaxUnavailable)
+	})
+
+	t.Run("budget of removed executor is deleted", func(t *testing.T) {
+		dk := getTestDynakube()
+		dk.Spec.Extensions.Databases[0].Replicas = ptr.To(int32(2))
+		clt := fakeClient()
+
+		require.NoError(t, NewReconciler(clt, clt, dk).Reconcile(t.Context()))
+		require.Len(t, listBudgets(t, clt), 1)
+
+		dk.Spec.Extensions.Databases[0].ID = "foo"
+
+		require.NoError(t, NewReconciler(clt, clt, dk).Reconcile(t.Context()))
+
+		budgets := listBudgets(t, clt)
+		require.Len(t, budgets, 1)
+		assert.Equal(t, dk.Extensions().GetDatabaseDatasourceName("foo"), budgets[0].Name)
+	})
+}
+
 func TestReconcileCondition(t *testing.T) {
 	t.Run("update observed generation", func(t *testing.T) {
 		dk := getTestDynakube()
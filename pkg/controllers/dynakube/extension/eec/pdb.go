@@ -0,0 +1,23 @@
+package eec
+
+import (
+	"context"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8spdb"
+)
+
+// The EEC always runs a single replica, so the PodDisruptionBudget is only created if configured explicitly.
+func (r *reconciler) reconcilePodDisruptionBudget(ctx context.Context, workloadEnabled bool) error {
+	appLabels := buildAppLabels(r.dk.Name)
+	budget := r.dk.Spec.Templates.ExtensionExecutionController.PodDisruptionBudget
+
+	desired, err := k8spdb.Build(r.dk, r.dk.Extensions().GetExecutionControllerStatefulsetName(), appLabels.BuildMatchLabels(),
+		k8spdb.SetLabels(appLabels.BuildLabels()),
+		k8spdb.SetBudget(budget),
+	)
+	if err != nil {
+		return err
+	}
+
+	return k8spdb.CreateOrDelete(ctx, k8spdb.Query(r.client, r.apiReader, log), desired, workloadEnabled && budget.IsEnabled(1))
+}
@@ -54,6 +54,11 @@ func (r *reconciler) Reconcile(ctx context.Context) error {
 			log.Error(err, "failed to clean up "+ext.GetExecutionControllerStatefulsetName()+" statufulset")
 		}
 
+		err = r.reconcilePodDisruptionBudget(ctx, false)
+		if err != nil {
+			log.Error(err, "failed to clean up "+ext.GetExecutionControllerStatefulsetName()+" pod disruption budget")
+		}
+
 		r.deleteLegacyStatefulset(ctx)
 
 		return nil
@@ -73,5 +78,17 @@ func (r *reconciler) Reconcile(ctx context.Context) error {
 
 	defer r.deleteLegacyStatefulset(ctx)
 
-	return r.createOrUpdateStatefulset(ctx)
+	err := r.createOrUpdateStatefulset(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = r.reconcilePodDisruptionBudget(ctx, true)
+	if err != nil {
+		k8sconditions.SetKubeAPIError(r.dk.Conditions(), extensionControllerStatefulSetConditionType, err)
+
+		return err
+	}
+
+	return nil
 }
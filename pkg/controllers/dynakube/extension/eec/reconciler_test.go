@@ -12,6 +12,7 @@ import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/communication"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/image"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/pdb"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/status"
 	"github.com/Dynatrace/dynatrace-operator/pkg/consts"
 	eecConsts "github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/extension/consts"
@@ -24,9 +25,12 @@ import (
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -185,6 +189,46 @@ func TestStatefulsetBase(t *testing.T) {
 	})
 }
 
+func TestPodDisruptionBudget(t *testing.T) {
+	getPDB := func(t *testing.T, clt client.Client, dk *dynakube.DynaKube) (*policyv1.PodDisruptionBudget, error) {
+		t.Helper()
+
+		budget := &policyv1.PodDisruptionBudget{}
+		err := clt.Get(t.Context(), client.ObjectKey{Name: dk.Extensions().GetExecutionControllerStatefulsetName(), Namespace: dk.Namespace}, budget)
+
+		return budget, err
+	}
+
+	t.Run("no pdb by default", func(t *testing.T) {
+		dk := getTestDynakube()
+		mockK8sClient := mockTLSSecret(t, fake.NewClient(dk), dk)
+
+		require.NoError(t, NewReconciler(mockK8sClient, mockK8sClient, dk).Reconcile(t.Context()))
+
+		_, err := getPDB(t, mockK8sClient, dk)
+		assert.True(t, errors.IsNotFound(err))
+	})
+	t.Run("pdb if configured and removed with extensions", func(t *testing.T) {
+		dk := getTestDynakube()
+		dk.Spec.Templates.ExtensionExecutionController.PodDisruptionBudget = &pdb.Spec{MinAvailable: ptr.To(intstr.FromInt32(1))}
+		mockK8sClient := mockTLSSecret(t, fake.NewClient(dk), dk)
+
+		require.NoError(t, NewReconciler(mockK8sClient, mockK8sClient, dk).Reconcile(t.Context()))
+
+		budget, err := getPDB(t, mockK8sClient, dk)
+		require.NoError(t, err)
+		assert.Equal(t, intstr.FromInt32(1), *budget.Spec.MinAvailable)
+		assert.Equal(t, buildAppLabels(dk.Name).BuildMatchLabels(), budget.Spec.Selector.MatchLabels)
+
+		dk.Spec.Extensions = nil
+
+		require.NoError(t, NewReconciler(mockK8sClient, mockK8sClient, dk).Reconcile(t.Context()))
+
+		_, err = getPDB(t, mockK8sClient, dk)
+		assert.True(t, errors.IsNotFound(err))
+	})
+}
+
 func TestSecretHashAnnotation(t *testing.T) {
 	t.Run("annotation is set with self-signed tls secret", func(t *testing.T) {
 		dk := getTestDynakube()
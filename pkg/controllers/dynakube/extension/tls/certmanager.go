@@ -0,0 +1,138 @@
+package tls
+
+import (
+	"context"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/consts"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/certificates"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8sconditions"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8slabel"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8scertmanager"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8ssecret"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// reconcileCertManagerTLSSecret lets cert-manager issue the extensions certificate and copies it into the TLS secret used by the EEC and the OTel collector.
+func (r *reconciler) reconcileCertManagerTLSSecret(ctx context.Context) error {
+	err := r.reconcileCertificate(ctx)
+	if err != nil {
+		return err
+	}
+
+	issued, err := r.secrets.Get(ctx, types.NamespacedName{
+		Name:      r.dk.Extensions().GetCertManagerTLSSecretName(),
+		Namespace: r.dk.Namespace,
+	})
+
+	switch {
+	case k8serrors.IsNotFound(err) || (err == nil && len(issued.Data[consts.TLSCrtDataName]) == 0):
+		log.Info("waiting for cert-manager to issue the extensions TLS certificate", "name", r.dk.Extensions().GetCertManagerTLSSecretName())
+		k8sconditions.SetCertificateNotReady(r.dk.Conditions(), conditionType, r.dk.Extensions().GetCertManagerTLSSecretName())
+
+		return nil
+	case err != nil:
+		k8sconditions.SetKubeAPIError(r.dk.Conditions(), conditionType, err)
+
+		return err
+	}
+
+	secret, err := r.buildCertManagerTLSSecret(issued)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.secrets.CreateOrUpdate(ctx, secret)
+	if err != nil {
+		k8sconditions.SetKubeAPIError(r.dk.Conditions(), conditionType, err)
+
+		return err
+	}
+
+	k8sconditions.SetSecretCreatedOrUpdated(r.dk.Conditions(), conditionType, secret.Name)
+
+	err = r.updateCertificateStatus(secret)
+	if err != nil {
+		return err
+	}
+
+	r.dk.Status.Extensions.TLSCertificate.Issuer = r.dk.CertManager().IssuerRef.Name
+
+	return nil
+}
+
+func (r *reconciler) reconcileCertificate(ctx context.Context) error {
+	name := r.dk.Extensions().GetCertManagerTLSSecretName()
+	coreLabels := k8slabel.NewCoreLabels(r.dk.Name, k8slabel.ExtensionComponentLabel)
+
+	certificate, err := k8scertmanager.Build(r.dk, name, name, r.dk.CertManager().IssuerRef,
+		k8scertmanager.SetDNSNames(certificates.AltNames(r.dk.Name, r.dk.Namespace, extensionsSelfSignedTLSCommonNameSuffix)),
+		k8scertmanager.SetUsages(k8scertmanager.UsageServerAuth, k8scertmanager.UsageDigitalSignature, k8scertmanager.UsageKeyEncipherment),
+		k8scertmanager.SetSecretLabels(coreLabels.BuildLabels()),
+		k8scertmanager.SetLabels(coreLabels.BuildLabels()),
+	)
+	if err != nil {
+		k8sconditions.SetSecretGenFailed(r.dk.Conditions(), conditionType, err)
+
+		return err
+	}
+
+	err = k8scertmanager.CreateOrUpdate(ctx, r.certificates, certificate)
+	if err != nil {
+		k8sconditions.SetKubeAPIError(r.dk.Conditions(), conditionType, err)
+	}
+
+	return err
+}
+
+func (r *reconciler) buildCertManagerTLSSecret(issued *corev1.Secret) (*corev1.Secret, error) {
+	coreLabels := k8slabel.NewCoreLabels(r.dk.Name, k8slabel.ExtensionComponentLabel)
+	secretData := map[string][]byte{
+		consts.TLSCrtDataName: issued.Data[consts.TLSCrtDataName],
+		consts.TLSKeyDataName: issued.Data[consts.TLSKeyDataName],
+	}
+
+	if ca := issued.Data[k8scertmanager.CADataName]; len(ca) > 0 {
+		secretData[k8scertmanager.CADataName] = ca
+	}
+
+	secret, err := k8ssecret.Build(r.dk, r.dk.Extensions().GetSelfSignedTLSSecretName(), secretData, k8ssecret.SetLabels(coreLabels.BuildLabels()))
+	if err != nil {
+		k8sconditions.SetSecretGenFailed(r.dk.Conditions(), conditionType, err)
+
+		return nil, err
+	}
+
+	secret.Type = corev1.SecretTypeTLS
+
+	return secret, nil
+}
+
+// cleanupCertManager removes everything related to cert-manager once the certificate should be self-signed again or is not needed anymore.
+// The TLS secret is removed as well, so that it gets recreated with a self-signed certificate.
+func (r *reconciler) cleanupCertManager(ctx context.Context) error {
+	if certStatus := r.dk.Status.Extensions.TLSCertificate; certStatus == nil || certStatus.Issuer == "" {
+		return nil
+	}
+
+	err := k8scertmanager.Delete(ctx, r.certificates, r.dk.Extensions().GetCertManagerTLSSecretName(), r.dk.Namespace)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range []string{
+		r.dk.Extensions().GetCertManagerTLSSecretName(),
+		r.dk.Extensions().GetSelfSignedTLSSecretName(),
+	} {
+		err = r.secrets.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: r.dk.Namespace}})
+		if err != nil {
+			return err
+		}
+	}
+
+	r.dk.Status.Extensions.TLSCertificate = nil
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package tls
+
+import (
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/certmanager"
+	"github.com/Dynatrace/dynatrace-operator/pkg/consts"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/certificates"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8sconditions"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8scertmanager"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/timeprovider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const testIssuerName = "test-issuer"
+
+func TestReconcileCertManager(t *testing.T) {
+	t.Run("waits for cert-manager, then copies the issued certificate", func(t *testing.T) {
+		dk := getTestDynakube()
+		dk.Spec.CertManager = &certmanager.Spec{IssuerRef: certmanager.IssuerRef{Name: testIssuerName, Kind: "ClusterIssuer"}}
+		fakeClient := fake.NewClient()
+		reconciler := NewReconciler(fakeClient, fakeClient, dk)
+
+		require.NoError(t, reconciler.Reconcile(t.Context()))
+
+		certificate := &unstructured.Unstructured{}
+		certificate.SetGroupVersionKind(k8scertmanager.CertificateGVK)
+		require.NoError(t, fakeClient.Get(t.Context(), client.ObjectKey{Name: dk.Extensions().GetCertManagerTLSSecretName(), Namespace: testNamespaceName}, certificate))
+
+		issuerKind, _, _ := unstructured.NestedString(certificate.Object, "spec", "issuerRef", "kind")
+		assert.Equal(t, "ClusterIssuer", issuerKind)
+
+		condition := meta.FindStatusCondition(*dk.Conditions(), conditionType)
+		require.NotNil(t, condition)
+		assert.Equal(t, k8sconditions.CertificateNotReadyReason, condition.Reason)
+
+		err := fakeClient.Get(t.Context(), client.ObjectKey{Name: dk.Extensions().GetSelfSignedTLSSecretName(), Namespace: testNamespaceName}, &corev1.Secret{})
+		assert.True(t, k8serrors.IsNotFound(err))
+
+		issued := createIssuedSecret(t, fakeClient, dk.Extensions().GetCertManagerTLSSecretName())
+
+		require.NoError(t, reconciler.Reconcile(t.Context()))
+
+		var secret corev1.Secret
+		require.NoError(t, fakeClient.Get(t.Context(), client.ObjectKey{Name: dk.Extensions().GetSelfSignedTLSSecretName(), Namespace: testNamespaceName}, &secret))
+		assert.Equal(t, issued.Data, secret.Data)
+		assert.Equal(t, corev1.SecretTypeTLS, secret.Type)
+
+		require.NotNil(t, dk.Status.Extensions.TLSCertificate)
+		assert.Equal(t, testIssuerName, dk.Status.Extensions.TLSCertificate.Issuer)
+	})
+	t.Run("switching back to self-signed removes the cert-manager resources", func(t *testing.T) {
+		dk := getTestDynakube()
+		dk.Spec.CertManager = &certmanager.Spec{IssuerRef: certmanager.IssuerRef{Name: testIssuerName}}
+		fakeClient := fake.NewClient()
+		reconciler := NewReconciler(fakeClient, fakeClient, dk)
+
+		createIssuedSecret(t, fakeClient, dk.Extensions().GetCertManagerTLSSecretName())
+		require.NoError(t, reconciler.Reconcile(t.Context()))
+
+		issuedHash := dk.Status.Extensions.TLSCertificate.Hash
+		dk.Spec.CertManager = nil
+
+		require.NoError(t, reconciler.Reconcile(t.Context()))
+
+		err := fakeClient.Get(t.Context(), client.ObjectKey{Name: dk.Extensions().GetCertManagerTLSSecretName(), Namespace: testNamespaceName}, &corev1.Secret{})
+		assert.True(t, k8serrors.IsNotFound(err))
+
+		require.NotNil(t, dk.Status.Extensions.TLSCertificate)
+		assert.Empty(t, dk.Status.Extensions.TLSCertificate.Issuer)
+		assert.NotEqual(t, issuedHash, dk.Status.Extensions.TLSCertificate.Hash)
+	})
+}
+
+// createIssuedSecret simulates cert-manager issuing the certificate.
+func createIssuedSecret(t *testing.T, clt client.Client, name string) *corev1.Secret {
+	t.Helper()
+
+	cert, err := certificates.NewRenewal(timeprovider.New(), 10, 80).New()
+	require.NoError(t, err)
+	require.NoError(t, cert.SelfSign())
+
+	pemCert, pemPk, err := cert.ToPEM()
+	require.NoError(t, err)
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testNamespaceName,
+		},
+		Data: map[string][]byte{
+			consts.TLSCrtDataName:     pemCert,
+			consts.TLSKeyDataName:     pemPk,
+			k8scertmanager.CADataName: []byte("ca"),
+		},
+	}
+	require.NoError(t, clt.Create(t.Context(), secret))
+
+	return secret
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/certificates"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8sconditions"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8slabel"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8scertmanager"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8ssecret"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/timeprovider"
 	corev1 "k8s.io/api/core/v1"
@@ -28,6 +29,7 @@ type reconciler struct {
 	timeProvider *timeprovider.Provider
 	dk           *dynakube.DynaKube
 	secrets      k8ssecret.QueryObject
+	certificates k8scertmanager.QueryObject
 }
 
 func NewReconciler(clt client.Client, apiReader client.Reader, dk *dynakube.DynaKube) controllers.Reconciler {
@@ -35,6 +37,7 @@ func NewReconciler(clt client.Client, apiReader client.Reader, dk *dynakube.Dyna
 		dk:           dk,
 		timeProvider: timeprovider.New(),
 		secrets:      k8ssecret.Query(clt, apiReader, log),
+		certificates: k8scertmanager.Query(clt, apiReader, log),
 	}
 }
 
@@ -42,6 +45,15 @@ func (r *reconciler) Reconcile(ctx context.Context) error {
 	if ext := r.dk.Extensions(); ext.IsAnyEnabled() && ext.NeedsSelfSignedTLS() {
 		defer r.deleteLegacySelfSignedTLSSecret(ctx)
 
+		if r.dk.CertManager().IsEnabled() {
+			return r.reconcileCertManagerTLSSecret(ctx)
+		}
+
+		err := r.cleanupCertManager(ctx)
+		if err != nil {
+			return err
+		}
+
 		return r.reconcileSelfSignedTLSSecret(ctx)
 	}
 
@@ -54,24 +66,57 @@ func (r *reconciler) Reconcile(ctx context.Context) error {
 		meta.RemoveStatusCondition(r.dk.Conditions(), conditionType)
 	}()
 
+	err := r.cleanupCertManager(ctx)
+	if err != nil {
+		return err
+	}
+
+	r.dk.Status.Extensions.TLSCertificate = nil
+	certificates.ClearExpiry(r.dk, extensionsSelfSignedTLSCommonNameSuffix)
+
 	return r.deleteSelfSignedTLSSecret(ctx)
 }
 
 func (r *reconciler) reconcileSelfSignedTLSSecret(ctx context.Context) error {
-	_, err := r.secrets.Get(ctx, types.NamespacedName{
+	secret, err := r.secrets.Get(ctx, types.NamespacedName{
 		Name:      r.dk.Extensions().GetSelfSignedTLSSecretName(),
 		Namespace: r.dk.Namespace,
 	})
-	if err != nil && k8serrors.IsNotFound(err) {
-		return r.createSelfSignedTLSSecret(ctx)
+
+	switch {
+	case k8serrors.IsNotFound(err):
+		secret, err = r.createSelfSignedTLSSecret(ctx)
+	case err != nil:
+		k8sconditions.SetKubeAPIError(r.dk.Conditions(), conditionType, err)
+	case r.renewal().IsDue(secret.Data[consts.TLSCrtDataName]):
+		log.Info("self-signed TLS certificate is about to expire, renewing it", "name", secret.Name)
+
+		secret, err = r.renewSelfSignedTLSSecret(ctx)
 	}
 
 	if err != nil {
-		k8sconditions.SetKubeAPIError(r.dk.Conditions(), conditionType, err)
+		return err
+	}
+
+	return r.updateCertificateStatus(secret)
+}
+
+func (r *reconciler) renewal() certificates.Renewal {
+	return certificates.NewRenewal(r.timeProvider, r.dk.FF().GetSelfSignedCertificateValidity(), r.dk.FF().GetSelfSignedCertificateRenewalThreshold())
+}
+
+// The EEC and the OTel collector pods are restarted by the hash of the secret in their template annotations.
+func (r *reconciler) updateCertificateStatus(secret *corev1.Secret) error {
+	certStatus, err := certificates.GetStatus(secret.Data[consts.TLSCrtDataName])
+	if err != nil {
+		k8sconditions.SetSecretGenFailed(r.dk.Conditions(), conditionType, err)
 
 		return err
 	}
 
+	r.dk.Status.Extensions.TLSCertificate = certStatus
+	certificates.RecordExpiry(r.dk, extensionsSelfSignedTLSCommonNameSuffix, certStatus.NotAfter.Time, r.timeProvider.Now().Time)
+
 	return nil
 }
 
@@ -94,12 +139,48 @@ func (r *reconciler) deleteLegacySelfSignedTLSSecret(ctx context.Context) {
 	})
 }
 
-func (r *reconciler) createSelfSignedTLSSecret(ctx context.Context) error {
-	cert, err := certificates.New(r.timeProvider)
+func (r *reconciler) createSelfSignedTLSSecret(ctx context.Context) (*corev1.Secret, error) {
+	secret, err := r.buildSelfSignedTLSSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.secrets.Create(ctx, secret)
+	if err != nil {
+		k8sconditions.SetKubeAPIError(r.dk.Conditions(), conditionType, err)
+
+		return nil, err
+	}
+
+	k8sconditions.SetSecretCreated(r.dk.Conditions(), conditionType, secret.Name)
+
+	return secret, nil
+}
+
+func (r *reconciler) renewSelfSignedTLSSecret(ctx context.Context) (*corev1.Secret, error) {
+	secret, err := r.buildSelfSignedTLSSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.secrets.Update(ctx, secret)
+	if err != nil {
+		k8sconditions.SetKubeAPIError(r.dk.Conditions(), conditionType, err)
+
+		return nil, err
+	}
+
+	k8sconditions.SetSecretCreatedOrUpdated(r.dk.Conditions(), conditionType, secret.Name)
+
+	return secret, nil
+}
+
+func (r *reconciler) buildSelfSignedTLSSecret() (*corev1.Secret, error) {
+	cert, err := r.renewal().New()
 	if err != nil {
 		k8sconditions.SetSecretGenFailed(r.dk.Conditions(), conditionType, err)
 
-		return err
+		return nil, err
 	}
 
 	cert.Cert.DNSNames = certificates.AltNames(r.dk.Name, r.dk.Namespace, extensionsSelfSignedTLSCommonNameSuffix)
@@ -111,14 +192,14 @@ func (r *reconciler) createSelfSignedTLSSecret(ctx context.Context) error {
 	if err != nil {
 		k8sconditions.SetSecretGenFailed(r.dk.Conditions(), conditionType, err)
 
-		return err
+		return nil, err
 	}
 
 	pemCert, pemPk, err := cert.ToPEM()
 	if err != nil {
 		k8sconditions.SetSecretGenFailed(r.dk.Conditions(), conditionType, err)
 
-		return err
+		return nil, err
 	}
 
 	coreLabels := k8slabel.NewCoreLabels(r.dk.Name, k8slabel.ExtensionComponentLabel)
@@ -128,19 +209,10 @@ func (r *reconciler) createSelfSignedTLSSecret(ctx context.Context) error {
 	if err != nil {
 		k8sconditions.SetSecretGenFailed(r.dk.Conditions(), conditionType, err)
 
-		return err
+		return nil, err
 	}
 
 	secret.Type = corev1.SecretTypeTLS
 
-	err = r.secrets.Create(ctx, secret)
-	if err != nil {
-		k8sconditions.SetKubeAPIError(r.dk.Conditions(), conditionType, err)
-
-		return err
-	}
-
-	k8sconditions.SetSecretCreated(r.dk.Conditions(), conditionType, secret.Name)
-
-	return nil
+	return secret, nil
 }
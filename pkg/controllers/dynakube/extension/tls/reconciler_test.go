@@ -2,7 +2,9 @@ package tls
 
 import (
 	"testing"
+	"time"
 
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/exp"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/activegate"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/extensions"
@@ -73,6 +75,49 @@ func TestReconcile(t *testing.T) {
 		assert.Equal(t, "dynakube-extension-controller-tls created", (*dk.Conditions())[0].Message)
 	})
 	t.Run("do not renew self-signed tls secret if it exists", func(t *testing.T) {
+		dk := getTestDynakube()
+		dk.Spec.Templates.ExtensionExecutionController.TLSRefName = ""
+		fakeClient := fake.NewClient()
+
+		reconciler := NewReconciler(fakeClient, fakeClient, dk)
+		require.NoError(t, reconciler.Reconcile(t.Context()))
+
+		var initial corev1.Secret
+
+		key := client.ObjectKey{Name: dk.Extensions().GetSelfSignedTLSSecretName(), Namespace: testNamespaceName}
+		require.NoError(t, fakeClient.Get(t.Context(), key, &initial))
+
+		require.NoError(t, reconciler.Reconcile(t.Context()))
+
+		var secret corev1.Secret
+
+		require.NoError(t, fakeClient.Get(t.Context(), key, &secret))
+		assert.Equal(t, initial.Data, secret.Data)
+		assert.NotEmpty(t, dk.Conditions())
+		require.NotNil(t, dk.Status.Extensions.TLSCertificate)
+	})
+	t.Run("renew self-signed tls secret if it is outdated", func(t *testing.T) {
+		dk := getTestDynakube()
+		dk.Spec.Templates.ExtensionExecutionController.TLSRefName = ""
+		dk.Annotations = map[string]string{exp.SelfSignedCertificateValidityKey: "10"}
+		fakeClient := fake.NewClient()
+
+		r := NewReconciler(fakeClient, fakeClient, dk).(*reconciler)
+		r.timeProvider.Freeze()
+		issuedAt := r.timeProvider.Now().Time
+
+		require.NoError(t, r.Reconcile(t.Context()))
+
+		initialHash := dk.Status.Extensions.TLSCertificate.Hash
+
+		r.timeProvider.Set(issuedAt.Add(9 * 24 * time.Hour))
+		require.NoError(t, r.Reconcile(t.Context()))
+
+		require.NotNil(t, dk.Status.Extensions.TLSCertificate)
+		assert.NotEqual(t, initialHash, dk.Status.Extensions.TLSCertificate.Hash)
+		assert.Equal(t, issuedAt.Add(19*24*time.Hour).Unix(), dk.Status.Extensions.TLSCertificate.NotAfter.Unix())
+	})
+	t.Run("renew self-signed tls secret if it is invalid", func(t *testing.T) {
 		dk := getTestDynakube()
 		dk.Spec.Templates.ExtensionExecutionController.TLSRefName = ""
 		k8sconditions.SetSecretCreated(dk.Conditions(), conditionType, "dynakube-extension-controller-tls")
@@ -91,8 +136,8 @@ func TestReconcile(t *testing.T) {
 		err = fakeClient.Get(t.Context(), key, &secret)
 
 		require.NoError(t, err)
-		require.NotEmpty(t, secret)
-		assert.NotEmpty(t, dk.Conditions())
+		assert.NotEqual(t, []byte("super-cert"), secret.Data[consts.TLSCrtDataName])
+		assert.Equal(t, k8sconditions.SecretCreatedOrUpdatedReason, (*dk.Conditions())[0].Reason)
 	})
 	t.Run("self-signed tls secret is deleted", func(t *testing.T) {
 		dk := getTestDynakube()
@@ -115,6 +160,7 @@ func TestReconcile(t *testing.T) {
 		require.True(t, k8serrors.IsNotFound(err))
 		assert.Empty(t, secret)
 		assert.Empty(t, dk.Conditions())
+		assert.Nil(t, dk.Status.Extensions.TLSCertificate)
 	})
 	t.Run("self-signed tls secret is deleted if extensions are disabled", func(t *testing.T) {
 		dk := getTestDynakube()
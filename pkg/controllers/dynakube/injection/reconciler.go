@@ -9,9 +9,12 @@ import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/clients/dynatrace"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers"
 	oaconnectioninfo "github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/connectioninfo/oneagent"
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/injection/report"
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/injection/rollout"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/istio"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/metadata/rules"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/version"
+	"github.com/Dynatrace/dynatrace-operator/pkg/injection/imageindex"
 	"github.com/Dynatrace/dynatrace-operator/pkg/injection/namespace/bootstrapperconfig"
 	"github.com/Dynatrace/dynatrace-operator/pkg/injection/namespace/mapper"
 	"github.com/Dynatrace/dynatrace-operator/pkg/injection/otlp/exporterconfig"
@@ -31,6 +34,7 @@ type Reconciler struct {
 	connectionInfoReconciler  controllers.Reconciler
 	enrichmentRulesReconciler controllers.Reconciler
 	dynatraceClient           dynatrace.Client
+	imageInspector            *imageindex.Inspector
 }
 
 type ReconcilerBuilder func(
@@ -38,6 +42,7 @@ type ReconcilerBuilder func(
 	apiReader client.Reader,
 	dynatraceClient dynatrace.Client,
 	istioClient *istio.Client,
+	imageInspector *imageindex.Inspector,
 	dk *dynakube.DynaKube,
 ) controllers.Reconciler
 
@@ -47,6 +52,7 @@ func NewReconciler(
 	apiReader client.Reader,
 	dynatraceClient dynatrace.Client,
 	istioClient *istio.Client,
+	imageInspector *imageindex.Inspector,
 	dk *dynakube.DynaKube,
 ) controllers.Reconciler {
 	var istioReconciler istio.Reconciler = nil
@@ -64,6 +70,7 @@ func NewReconciler(
 		versionReconciler:         version.NewReconciler(apiReader, dynatraceClient, timeprovider.New().Freeze()),
 		connectionInfoReconciler:  oaconnectioninfo.NewReconciler(client, apiReader, dynatraceClient, dk),
 		enrichmentRulesReconciler: rules.NewReconciler(dynatraceClient.AsV2().Settings, dk),
+		imageInspector:            imageInspector,
 	}
 }
 
@@ -106,6 +113,18 @@ func (r *Reconciler) Reconcile(ctx context.Context) error {
 		setupErrors = append(setupErrors, err)
 	}
 
+	if err := r.setupImageIndex(ctx, namespaces); err != nil {
+		setupErrors = append(setupErrors, err)
+	}
+
+	if err := rollout.NewReconciler(r.client, r.apiReader).Reconcile(ctx, r.dk, namespaces); err != nil {
+		setupErrors = append(setupErrors, err)
+	}
+
+	if err := report.NewReconciler(r.client, r.apiReader, timeprovider.New()).Reconcile(ctx, r.dk, namespaces); err != nil {
+		setupErrors = append(setupErrors, err)
+	}
+
 	if len(setupErrors) > 0 {
 		return goerrors.Join(setupErrors...)
 	}
@@ -129,6 +148,20 @@ func (r *Reconciler) setupOTLPSecret(ctx context.Context, namespaces []corev1.Na
 	return nil
 }
 
+func (r *Reconciler) setupImageIndex(ctx context.Context, namespaces []corev1.Namespace) error {
+	generator := imageindex.NewGenerator(r.client, r.apiReader, r.imageInspector)
+
+	if r.dk.OneAgent().IsAppInjectionNeeded() && r.dk.FF().IsTechnologyDetection() {
+		return generator.GenerateForDynakube(ctx, r.dk, namespaces)
+	}
+
+	if err := generator.Cleanup(ctx, r.dk); err != nil {
+		log.Error(err, "failed to clean-up image index")
+	}
+
+	return nil
+}
+
 func (r *Reconciler) setupInitSecret(ctx context.Context, namespaces []corev1.Namespace) error {
 	if r.dk.OneAgent().IsAppInjectionNeeded() || r.dk.MetadataEnrichment().IsEnabled() {
 		if err := r.generateInitSecret(ctx, namespaces); err != nil {
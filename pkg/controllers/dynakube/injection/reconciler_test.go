@@ -17,6 +17,7 @@ import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/istio"
 	versions "github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/version"
+	"github.com/Dynatrace/dynatrace-operator/pkg/injection/imageindex"
 	"github.com/Dynatrace/dynatrace-operator/pkg/injection/namespace/bootstrapperconfig"
 	"github.com/Dynatrace/dynatrace-operator/pkg/injection/namespace/mapper"
 	"github.com/Dynatrace/dynatrace-operator/pkg/injection/otlp/exporterconfig"
@@ -134,7 +135,7 @@ func TestReconciler(t *testing.T) {
 
 		istioClient := newIstioTestingClient(fakeistio.NewSimpleClientset(), dk)
 
-		rec := NewReconciler(clt, clt, dtClient, istioClient, dk)
+		rec := NewReconciler(clt, clt, dtClient, istioClient, imageindex.NewInspector(clt, clt, nil), dk)
 
 		err := rec.Reconcile(t.Context())
 		require.NoError(t, err)
@@ -190,7 +191,7 @@ func TestReconciler(t *testing.T) {
 		settingsClient := settingsmock.NewAPIClient(t)
 		dtClient.EXPECT().AsV2().Return(&dtclient.ClientV2{Settings: settingsClient}).Once()
 
-		rec := NewReconciler(clt, clt, dtClient, istioClient, dk)
+		rec := NewReconciler(clt, clt, dtClient, istioClient, imageindex.NewInspector(clt, clt, nil), dk)
 
 		err := rec.Reconcile(t.Context())
 		require.NoError(t, err)
@@ -265,7 +266,7 @@ func TestReconciler(t *testing.T) {
 		settingsClient := settingsmock.NewAPIClient(t)
 		dtClient.EXPECT().AsV2().Return(&dtclient.ClientV2{Settings: settingsClient}).Once()
 
-		rec := NewReconciler(boomClient, boomClient, dtClient, istioClient, dk).(*Reconciler)
+		rec := NewReconciler(boomClient, boomClient, dtClient, istioClient, imageindex.NewInspector(boomClient, boomClient, nil), dk).(*Reconciler)
 		rec.connectionInfoReconciler = fakeReconciler
 		rec.versionReconciler = fakeVersionReconciler
 
@@ -687,6 +688,34 @@ func TestCleanupOTLPInjection(t *testing.T) {
 	})
 }
 
+func TestSetupImageIndex(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("create image index if technology detection is enabled", func(t *testing.T) {
+		clt := fake.NewClientWithIndex()
+		r := createReconciler(clt, testDynakube, testNamespaceDynatrace, oneagent.Spec{CloudNativeFullStack: &oneagent.CloudNativeFullStackSpec{}})
+		r.dk.Annotations = map[string]string{exp.InjectionTechnologyDetectionKey: "true"}
+
+		require.NoError(t, r.setupImageIndex(ctx, nil))
+
+		var configMap corev1.ConfigMap
+		require.NoError(t, clt.Get(ctx, client.ObjectKey{Name: imageindex.GetConfigMapName(testDynakube), Namespace: testNamespaceDynatrace}, &configMap))
+		assert.Contains(t, configMap.Data, imageindex.IndexDataKey)
+	})
+
+	t.Run("remove image index if technology detection is disabled", func(t *testing.T) {
+		clt := fake.NewClientWithIndex(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: imageindex.GetConfigMapName(testDynakube), Namespace: testNamespaceDynatrace},
+		})
+		r := createReconciler(clt, testDynakube, testNamespaceDynatrace, oneagent.Spec{CloudNativeFullStack: &oneagent.CloudNativeFullStackSpec{}})
+
+		require.NoError(t, r.setupImageIndex(ctx, nil))
+
+		err := clt.Get(ctx, client.ObjectKey{Name: imageindex.GetConfigMapName(testDynakube), Namespace: testNamespaceDynatrace}, &corev1.ConfigMap{})
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+}
+
 func newIstioTestingClient(fakeClient *fakeistio.Clientset, dk *dynakube.DynaKube) *istio.Client {
 	return &istio.Client{
 		IstioClientset: fakeClient,
@@ -696,8 +725,9 @@ func newIstioTestingClient(fakeClient *fakeistio.Clientset, dk *dynakube.DynaKub
 
 func createReconciler(clt client.Client, dynakubeName string, dynakubeNamespace string, oneAgentSpec oneagent.Spec) Reconciler {
 	return Reconciler{
-		client:    clt,
-		apiReader: clt,
+		client:         clt,
+		apiReader:      clt,
+		imageInspector: imageindex.NewInspector(clt, clt, nil),
 		dk: &dynakube.DynaKube{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      dynakubeName,
@@ -0,0 +1,62 @@
+package report
+
+import (
+	"github.com/Dynatrace/dynatrace-operator/pkg/logd"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	log = logd.Get().WithName("dynakube-injection-report")
+
+	injectedPodsMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "dynatrace",
+		Subsystem: "operator",
+		Name:      "injected_pods",
+		Help:      "Number of running pods injected with the OneAgent, per namespace",
+	}, []string{"dynakube", "namespace"})
+
+	notInjectedPodsMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "dynatrace",
+		Subsystem: "operator",
+		Name:      "not_injected_pods",
+		Help:      "Number of running pods not injected with the OneAgent, per namespace and reason",
+	}, []string{"dynakube", "namespace", "reason"})
+
+	outdatedPodsMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "dynatrace",
+		Subsystem: "operator",
+		Name:      "outdated_injected_pods",
+		Help:      "Number of running pods injected with a different code modules version than the one of the DynaKube, per namespace",
+	}, []string{"dynakube", "namespace"})
+)
+
+const (
+	configMapSuffix = "-injection-report"
+	// ReportDataKey is the key of the report in the ConfigMap, the value is a JSON object.
+	ReportDataKey = "report.json"
+	// TimestampDataKey is the key of the time the report was created, in RFC 3339 format, it's only recreated after the configured interval.
+	TimestampDataKey = "timestamp"
+
+	// podListPageSize limits how many pods are returned by a single list request.
+	podListPageSize = 500
+	// maxOutdatedWorkloads limits the outdated workloads listed per namespace, the others are only counted.
+	maxOutdatedWorkloads = 50
+	// maxReportSize keeps the report well below the size limit of a ConfigMap (1MiB), if it's exceeded the outdated workloads are only counted.
+	maxReportSize = 768 * 1024
+
+	// NotHandledReason is used for pods that were not handled by the webhook, e.g. because they were created before the injection was configured.
+	NotHandledReason = "NotHandled"
+	// InjectionDisabledReason is used for pods that opted out of any injection.
+	InjectionDisabledReason = "InjectionDisabled"
+	// UnknownReason is used for pods that are not injected, but don't state why.
+	UnknownReason = "Unknown"
+)
+
+func init() {
+	metrics.Registry.MustRegister(injectedPodsMetric, notInjectedPodsMetric, outdatedPodsMetric)
+}
+
+func GetConfigMapName(dkName string) string {
+	return dkName + configMapSuffix
+}
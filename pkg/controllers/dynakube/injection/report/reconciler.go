@@ -0,0 +1,166 @@
+package report
+
+import (
+	"context"
+	"time"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/status"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8sconfigmap"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/timeprovider"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Reconciler maintains the injection report of a DynaKube, so it's visible which workloads still run without or with an outdated OneAgent, e.g. after an upgrade.
+type Reconciler struct {
+	apiReader    client.Reader
+	configMaps   k8sconfigmap.QueryObject
+	timeProvider *timeprovider.Provider
+}
+
+func NewReconciler(client client.Client, apiReader client.Reader, timeProvider *timeprovider.Provider) *Reconciler {
+	return &Reconciler{
+		apiReader:    apiReader,
+		configMaps:   k8sconfigmap.Query(client, apiReader, log),
+		timeProvider: timeProvider,
+	}
+}
+
+// Reconcile stores the report of the pods in the namespaces of the DynaKube in a ConfigMap, and exposes it as metrics.
+// The report is opt-in, as it lists all pods of the namespaces, and it's only recreated after the configured interval.
+func (r *Reconciler) Reconcile(ctx context.Context, dk *dynakube.DynaKube, namespaces []corev1.Namespace) error {
+	if !dk.OneAgent().IsAppInjectionNeeded() || !dk.FF().IsInjectionReport() {
+		return r.cleanup(ctx, dk)
+	}
+
+	if !r.isOutdated(ctx, dk) {
+		log.Debug("injection report is up to date", "dynakube", dk.Name)
+
+		return nil
+	}
+
+	report := newReport(getCodeModulesVersion(dk))
+
+	for _, namespace := range namespaces {
+		pods, err := r.listPods(ctx, namespace.Name)
+		if err != nil {
+			return err
+		}
+
+		report.addNamespace(namespace.Name, pods)
+	}
+
+	recordMetrics(dk.Name, report)
+
+	return r.store(ctx, dk, report)
+}
+
+// getCodeModulesVersion returns the version the pods are compared with, custom code modules images have no version to compare with.
+func getCodeModulesVersion(dk *dynakube.DynaKube) string {
+	version := dk.OneAgent().GetCodeModulesVersion()
+	if version == string(status.CustomImageVersionSource) {
+		return ""
+	}
+
+	return version
+}
+
+func (r *Reconciler) isOutdated(ctx context.Context, dk *dynakube.DynaKube) bool {
+	configMap, err := r.configMaps.Get(ctx, client.ObjectKey{Name: GetConfigMapName(dk.Name), Namespace: dk.Namespace})
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			log.Info("failed to get injection report, recreating it", "error", err.Error())
+		}
+
+		return true
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, configMap.Data[TimestampDataKey])
+	if err != nil {
+		return true
+	}
+
+	return r.timeProvider.IsOutdated(&metav1.Time{Time: timestamp}, dk.FF().GetInjectionReportInterval())
+}
+
+// listPods only lists the running pods, in pages, as namespaces can contain a lot of pods.
+func (r *Reconciler) listPods(ctx context.Context, namespace string) ([]corev1.Pod, error) {
+	var pods []corev1.Pod
+
+	continueToken := ""
+
+	for {
+		var page corev1.PodList
+
+		err := r.apiReader.List(ctx, &page,
+			client.InNamespace(namespace),
+			client.MatchingFields{"status.phase": string(corev1.PodRunning)},
+			client.Limit(podListPageSize),
+			client.Continue(continueToken),
+		)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "failed to list pods in namespace %s", namespace)
+		}
+
+		pods = append(pods, page.Items...)
+
+		continueToken = page.Continue
+		if continueToken == "" {
+			return pods, nil
+		}
+	}
+}
+
+func (r *Reconciler) store(ctx context.Context, dk *dynakube.DynaKube, report *Report) error {
+	data, err := report.marshal()
+	if err != nil {
+		return err
+	}
+
+	configMap, err := k8sconfigmap.Build(dk, GetConfigMapName(dk.Name), map[string]string{
+		ReportDataKey:    string(data),
+		TimestampDataKey: r.timeProvider.Now().UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = r.configMaps.CreateOrUpdate(ctx, configMap)
+
+	return err
+}
+
+func (r *Reconciler) cleanup(ctx context.Context, dk *dynakube.DynaKube) error {
+	clearMetrics(dk.Name)
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: GetConfigMapName(dk.Name), Namespace: dk.Namespace}}
+
+	return r.configMaps.Delete(ctx, configMap)
+}
+
+func recordMetrics(dkName string, report *Report) {
+	// namespaces and reasons that are no longer part of the report must not keep their last value
+	clearMetrics(dkName)
+
+	for namespace, namespaceReport := range report.Namespaces {
+		injectedPodsMetric.WithLabelValues(dkName, namespace).Set(float64(namespaceReport.Injected))
+		outdatedPodsMetric.WithLabelValues(dkName, namespace).Set(float64(namespaceReport.Outdated))
+
+		for reason, count := range namespaceReport.NotInjected {
+			notInjectedPodsMetric.WithLabelValues(dkName, namespace, reason).Set(float64(count))
+		}
+	}
+}
+
+func clearMetrics(dkName string) {
+	labels := prometheus.Labels{"dynakube": dkName}
+
+	injectedPodsMetric.DeletePartialMatch(labels)
+	notInjectedPodsMetric.DeletePartialMatch(labels)
+	outdatedPodsMetric.DeletePartialMatch(labels)
+}
@@ -0,0 +1,133 @@
+package report
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/exp"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/oneagent"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme"
+	dtfake "github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/status"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/timeprovider"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func createDynakube(oneAgentSpec oneagent.Spec) *dynakube.DynaKube {
+	return &dynakube.DynaKube{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "dynakube",
+			Namespace:   "dynatrace",
+			Annotations: map[string]string{exp.InjectionReportKey: "true"},
+		},
+		Spec: dynakube.DynaKubeSpec{OneAgent: oneAgentSpec},
+		Status: dynakube.DynaKubeStatus{
+			CodeModules: oneagent.CodeModulesStatus{VersionStatus: status.VersionStatus{Version: testVersion}},
+		},
+	}
+}
+
+// createClient filters the pods by their phase, like the Kubernetes API does for field selectors.
+func createClient(objs ...client.Object) client.Client {
+	return fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(objs...).
+		WithIndex(&corev1.Pod{}, "status.phase", func(o client.Object) []string {
+			return []string{string(o.(*corev1.Pod).Status.Phase)}
+		}).
+		Build()
+}
+
+func readReport(t *testing.T, clt client.Reader, dk *dynakube.DynaKube) *corev1.ConfigMap {
+	t.Helper()
+
+	var configMap corev1.ConfigMap
+	require.NoError(t, clt.Get(context.Background(), client.ObjectKey{Name: GetConfigMapName(dk.Name), Namespace: dk.Namespace}, &configMap))
+
+	return &configMap
+}
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+	namespaces := []corev1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: testNamespace}}}
+
+	t.Run("report is stored and exposed as metrics", func(t *testing.T) {
+		dk := createDynakube(oneagent.Spec{CloudNativeFullStack: &oneagent.CloudNativeFullStackSpec{}})
+		current := createPod("current", "app", injectedAnnotations(testVersion))
+		outdated := createPod("outdated", "old", injectedAnnotations("1.0.0"))
+		notHandled := createPod("not-handled", "app", nil)
+		completed := createPod("completed", "app", nil)
+		completed.Status.Phase = corev1.PodSucceeded
+		clt := createClient(&current, &outdated, &notHandled, &completed)
+
+		require.NoError(t, NewReconciler(clt, clt, timeprovider.New()).Reconcile(ctx, dk, namespaces))
+
+		configMap := readReport(t, clt, dk)
+		assert.NotEmpty(t, configMap.Data[TimestampDataKey])
+
+		var report Report
+		require.NoError(t, json.Unmarshal([]byte(configMap.Data[ReportDataKey]), &report))
+		assert.Equal(t, testVersion, report.CodeModulesVersion)
+		require.Contains(t, report.Namespaces, testNamespace)
+		assert.Equal(t, 2, report.Namespaces[testNamespace].Injected)
+		assert.Equal(t, []string{"Deployment/old"}, report.Namespaces[testNamespace].OutdatedWorkloads)
+
+		assert.InDelta(t, 2, testutil.ToFloat64(injectedPodsMetric.WithLabelValues(dk.Name, testNamespace)), 0)
+		assert.InDelta(t, 1, testutil.ToFloat64(outdatedPodsMetric.WithLabelValues(dk.Name, testNamespace)), 0)
+		assert.InDelta(t, 1, testutil.ToFloat64(notInjectedPodsMetric.WithLabelValues(dk.Name, testNamespace, NotHandledReason)), 0)
+	})
+
+	t.Run("report is only recreated after the interval", func(t *testing.T) {
+		dk := createDynakube(oneagent.Spec{CloudNativeFullStack: &oneagent.CloudNativeFullStackSpec{}})
+		current := createPod("current", "app", injectedAnnotations(testVersion))
+		clt := createClient(&current)
+		timeProvider := timeprovider.New().Freeze()
+		reconciler := NewReconciler(clt, clt, timeProvider)
+
+		require.NoError(t, reconciler.Reconcile(ctx, dk, namespaces))
+
+		outdated := createPod("outdated", "old", injectedAnnotations("1.0.0"))
+		require.NoError(t, clt.Create(ctx, &outdated))
+
+		timeProvider.Set(timeProvider.Now().Add(exp.DefaultInjectionReportIntervalMinutes * time.Minute / 2))
+		require.NoError(t, reconciler.Reconcile(ctx, dk, namespaces))
+		assert.NotContains(t, readReport(t, clt, dk).Data[ReportDataKey], "Deployment/old")
+
+		timeProvider.Set(timeProvider.Now().Add(exp.DefaultInjectionReportIntervalMinutes * time.Minute))
+		require.NoError(t, reconciler.Reconcile(ctx, dk, namespaces))
+		assert.Contains(t, readReport(t, clt, dk).Data[ReportDataKey], "Deployment/old")
+	})
+
+	t.Run("app injection disabled => report and metrics removed", func(t *testing.T) {
+		dk := createDynakube(oneagent.Spec{})
+		clt := dtfake.NewClient(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: GetConfigMapName(dk.Name), Namespace: dk.Namespace}})
+		recordMetrics(dk.Name, &Report{Namespaces: map[string]*NamespaceReport{testNamespace: {Injected: 1}}})
+
+		require.NoError(t, NewReconciler(clt, clt, timeprovider.New()).Reconcile(ctx, dk, namespaces))
+
+		err := clt.Get(ctx, client.ObjectKey{Name: GetConfigMapName(dk.Name), Namespace: dk.Namespace}, &corev1.ConfigMap{})
+		assert.True(t, k8serrors.IsNotFound(err))
+		assert.Zero(t, testutil.CollectAndCount(injectedPodsMetric))
+	})
+
+	t.Run("report not enabled => report removed", func(t *testing.T) {
+		dk := createDynakube(oneagent.Spec{CloudNativeFullStack: &oneagent.CloudNativeFullStackSpec{}})
+		dk.Annotations = nil
+		clt := dtfake.NewClient(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: GetConfigMapName(dk.Name), Namespace: dk.Namespace}})
+
+		require.NoError(t, NewReconciler(clt, clt, timeprovider.New()).Reconcile(ctx, dk, namespaces))
+
+		err := clt.Get(ctx, client.ObjectKey{Name: GetConfigMapName(dk.Name), Namespace: dk.Namespace}, &corev1.ConfigMap{})
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+}
@@ -0,0 +1,180 @@
+package report
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/Dynatrace/dynatrace-operator/cmd/bootstrapper"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8scontainer"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8spod"
+	maputil "github.com/Dynatrace/dynatrace-operator/pkg/util/map"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/oci/registry"
+	dtwebhook "github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator"
+	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator/oneagent"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Report summarizes the OneAgent injection of the running pods in the namespaces of a DynaKube.
+type Report struct {
+	// CodeModulesVersion is the version of the code modules the pods are expected to be injected with.
+	CodeModulesVersion string `json:"codeModulesVersion,omitempty"`
+
+	// Namespaces maps the names of the namespaces to their summary.
+	Namespaces map[string]*NamespaceReport `json:"namespaces"`
+}
+
+type NamespaceReport struct {
+	// Injected is the number of pods injected with the OneAgent.
+	Injected int `json:"injected"`
+
+	// NotInjected is the number of pods not injected with the OneAgent, per reason.
+	NotInjected map[string]int `json:"notInjected,omitempty"`
+
+	// Outdated is the number of injected pods, that run a different code modules version than CodeModulesVersion.
+	Outdated int `json:"outdated"`
+
+	// OutdatedWorkloads are the workloads with outdated pods, in the format <kind>/<name>.
+	OutdatedWorkloads []string `json:"outdatedWorkloads,omitempty"`
+
+	// OmittedOutdatedWorkloads is the number of workloads with outdated pods, that aren't listed to limit the size of the report.
+	OmittedOutdatedWorkloads int `json:"omittedOutdatedWorkloads,omitempty"`
+
+	// UnknownVersion is the number of injected pods, for which the code modules version can't be determined.
+	UnknownVersion int `json:"unknownVersion,omitempty"`
+}
+
+func newReport(codeModulesVersion string) *Report {
+	return &Report{
+		CodeModulesVersion: codeModulesVersion,
+		Namespaces:         map[string]*NamespaceReport{},
+	}
+}
+
+// addNamespace summarizes the pods of the namespace.
+func (r *Report) addNamespace(namespace string, pods []corev1.Pod) {
+	namespaceReport := &NamespaceReport{NotInjected: map[string]int{}}
+
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+
+		injected, reason := getInjectionState(pod)
+		if !injected {
+			namespaceReport.NotInjected[reason]++
+
+			continue
+		}
+
+		namespaceReport.Injected++
+
+		switch version := getInjectedVersion(pod); {
+		case version == "":
+			namespaceReport.UnknownVersion++
+		case r.CodeModulesVersion != "" && version != r.CodeModulesVersion:
+			namespaceReport.Outdated++
+			namespaceReport.OutdatedWorkloads = append(namespaceReport.OutdatedWorkloads, getWorkloadName(pod))
+		}
+	}
+
+	slices.Sort(namespaceReport.OutdatedWorkloads)
+	namespaceReport.OutdatedWorkloads = slices.Compact(namespaceReport.OutdatedWorkloads)
+
+	if len(namespaceReport.OutdatedWorkloads) > maxOutdatedWorkloads {
+		namespaceReport.OmittedOutdatedWorkloads = len(namespaceReport.OutdatedWorkloads) - maxOutdatedWorkloads
+		namespaceReport.OutdatedWorkloads = namespaceReport.OutdatedWorkloads[:maxOutdatedWorkloads]
+	}
+
+	r.Namespaces[namespace] = namespaceReport
+}
+
+// marshal encodes the report, if it gets too large for a ConfigMap the outdated workloads are only counted.
+func (r *Report) marshal() ([]byte, error) {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if len(data) <= maxReportSize {
+		return data, nil
+	}
+
+	for _, namespaceReport := range r.Namespaces {
+		namespaceReport.OmittedOutdatedWorkloads += len(namespaceReport.OutdatedWorkloads)
+		namespaceReport.OutdatedWorkloads = nil
+	}
+
+	data, err = json.Marshal(r)
+
+	return data, errors.WithStack(err)
+}
+
+func getInjectionState(pod corev1.Pod) (bool, string) {
+	injected, ok := pod.Annotations[oneagent.AnnotationInjected]
+
+	switch {
+	case ok && injected == "true":
+		return true, ""
+	case ok:
+		return false, maputil.GetField(pod.Annotations, oneagent.AnnotationReason, UnknownReason)
+	case !maputil.GetFieldBool(pod.Annotations, dtwebhook.AnnotationDynatraceInject, true):
+		return false, InjectionDisabledReason
+	default:
+		return false, NotHandledReason
+	}
+}
+
+// getInjectedVersion returns the code modules version the pod was injected with.
+// It's taken from the install container, which either downloads the version given in its arguments or is the code modules image itself.
+// Otherwise, e.g. if the code modules are provided by the CSI driver, the version annotation is used.
+// Pods injected by older webhooks don't have the version annotation, their version is unknown.
+func getInjectedVersion(pod corev1.Pod) string {
+	installContainer := k8scontainer.FindInitInPodSpec(&pod.Spec, dtwebhook.InstallContainerName)
+	if installContainer != nil {
+		if version := getInstallContainerVersion(*installContainer); version != "" {
+			return version
+		}
+	}
+
+	return pod.Annotations[oneagent.AnnotationVersion]
+}
+
+func getInstallContainerVersion(installContainer corev1.Container) string {
+	versionArg := "--" + bootstrapper.TargetVersionFlag + "="
+
+	for _, arg := range installContainer.Args {
+		if version, ok := strings.CutPrefix(arg, versionArg); ok {
+			return version
+		}
+	}
+
+	// the bootstrap subcommand is only left out, if the install container is the self-extracting code modules image
+	if len(installContainer.Args) == 0 || installContainer.Args[0] == bootstrapper.Use {
+		return ""
+	}
+
+	return getImageTag(installContainer.Image)
+}
+
+func getImageTag(image string) string {
+	// the digest is not part of the tag, e.g. for <repository>:<tag>@sha256:<digest>
+	image, _, _ = strings.Cut(image, registry.DigestDelimiter)
+
+	tag, err := name.NewTag(image, name.WithDefaultTag(""))
+	if err != nil {
+		return ""
+	}
+
+	return tag.TagStr()
+}
+
+func getWorkloadName(pod corev1.Pod) string {
+	if key := k8spod.GetWorkloadKey(pod); key != "" {
+		return key
+	}
+
+	return "Pod/" + pod.Name
+}
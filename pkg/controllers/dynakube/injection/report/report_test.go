@@ -0,0 +1,152 @@
+package report
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/cmd/bootstrapper"
+	dtwebhook "github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator"
+	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator/oneagent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	testNamespace = "test-namespace"
+	testVersion   = "1.2.3"
+)
+
+func createPod(name, deploymentName string, annotations map[string]string) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   testNamespace,
+			Labels:      map[string]string{appsv1.DefaultDeploymentUniqueLabelKey: "abc"},
+			Annotations: annotations,
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: deploymentName + "-abc", Controller: ptr.To(true)},
+			},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+}
+
+func injectedAnnotations(version string) map[string]string {
+	return map[string]string{oneagent.AnnotationInjected: "true", oneagent.AnnotationVersion: version}
+}
+
+func TestAddNamespace(t *testing.T) {
+	t.Run("pods are summarized per state", func(t *testing.T) {
+		deleted := createPod("deleted", "app", nil)
+		deleted.DeletionTimestamp = ptr.To(metav1.Now())
+
+		report := newReport(testVersion)
+		report.addNamespace(testNamespace, []corev1.Pod{
+			createPod("current", "app", injectedAnnotations(testVersion)),
+			createPod("outdated-1", "old", injectedAnnotations("1.0.0")),
+			createPod("outdated-2", "old", injectedAnnotations("1.0.0")),
+			createPod("failed", "app", map[string]string{oneagent.AnnotationInjected: "false", oneagent.AnnotationReason: oneagent.DynaKubeStatusNotReadyReason}),
+			createPod("disabled", "app", map[string]string{dtwebhook.AnnotationDynatraceInject: "false"}),
+			createPod("not-handled", "app", nil),
+			deleted,
+		})
+
+		assert.Equal(t, &NamespaceReport{
+			Injected: 3,
+			NotInjected: map[string]int{
+				oneagent.DynaKubeStatusNotReadyReason: 1,
+				InjectionDisabledReason:               1,
+				NotHandledReason:                      1,
+			},
+			Outdated:          2,
+			OutdatedWorkloads: []string{"Deployment/old"},
+		}, report.Namespaces[testNamespace])
+	})
+
+	t.Run("no code modules version => nothing is outdated", func(t *testing.T) {
+		report := newReport("")
+		report.addNamespace(testNamespace, []corev1.Pod{createPod("pod", "app", injectedAnnotations("1.0.0"))})
+
+		assert.Equal(t, 1, report.Namespaces[testNamespace].Injected)
+		assert.Zero(t, report.Namespaces[testNamespace].Outdated)
+	})
+}
+
+func TestGetInjectedVersion(t *testing.T) {
+	createInjectedPod := func(installContainer corev1.Container) corev1.Pod {
+		pod := createPod("pod", "app", injectedAnnotations("1.0.0"))
+		installContainer.Name = dtwebhook.InstallContainerName
+		pod.Spec.InitContainers = []corev1.Container{installContainer}
+
+		return pod
+	}
+
+	t.Run("version of the download", func(t *testing.T) {
+		pod := createInjectedPod(corev1.Container{
+			Image: "dynatrace-operator:1.8.0",
+			Args:  []string{bootstrapper.Use, "--" + bootstrapper.TargetVersionFlag + "=" + testVersion, "--source=/opt/dynatrace/oneagent"},
+		})
+
+		assert.Equal(t, testVersion, getInjectedVersion(pod))
+	})
+
+	t.Run("tag of the code modules image", func(t *testing.T) {
+		pod := createInjectedPod(corev1.Container{
+			Image: "registry.example.com/dynatrace/codemodules:" + testVersion + "@sha256:7173b809ca12ec5dee4506cd86be934c4596dd234ee82c0662eac04a8c2c71dc",
+			Args:  []string{"--source=/opt/dynatrace/oneagent"},
+		})
+
+		assert.Equal(t, testVersion, getInjectedVersion(pod))
+	})
+
+	t.Run("code modules of the CSI driver => version annotation", func(t *testing.T) {
+		pod := createInjectedPod(corev1.Container{
+			Image: "dynatrace-operator:1.8.0",
+			Args:  []string{bootstrapper.Use, "--source=/opt/dynatrace/oneagent"},
+		})
+
+		assert.Equal(t, "1.0.0", getInjectedVersion(pod))
+	})
+
+	t.Run("no install container => version annotation", func(t *testing.T) {
+		assert.Equal(t, "1.0.0", getInjectedVersion(createPod("pod", "app", injectedAnnotations("1.0.0"))))
+	})
+}
+
+func TestOutdatedWorkloadsLimit(t *testing.T) {
+	pods := make([]corev1.Pod, 0, maxOutdatedWorkloads+2)
+	for i := range maxOutdatedWorkloads + 2 {
+		pods = append(pods, createPod(fmt.Sprintf("pod-%d", i), fmt.Sprintf("app-%03d", i), injectedAnnotations("1.0.0")))
+	}
+
+	t.Run("outdated workloads are limited per namespace", func(t *testing.T) {
+		report := newReport(testVersion)
+		report.addNamespace(testNamespace, pods)
+
+		namespaceReport := report.Namespaces[testNamespace]
+		assert.Equal(t, maxOutdatedWorkloads+2, namespaceReport.Outdated)
+		assert.Len(t, namespaceReport.OutdatedWorkloads, maxOutdatedWorkloads)
+		assert.Equal(t, 2, namespaceReport.OmittedOutdatedWorkloads)
+	})
+
+	t.Run("too large report => outdated workloads are only counted", func(t *testing.T) {
+		report := newReport(testVersion)
+		for i := range maxReportSize / (maxOutdatedWorkloads * len("Deployment/app-000")) {
+			report.addNamespace(fmt.Sprintf("namespace-%d", i), pods)
+		}
+
+		data, err := report.marshal()
+		require.NoError(t, err)
+
+		assert.LessOrEqual(t, len(data), maxReportSize)
+
+		for _, namespaceReport := range report.Namespaces {
+			assert.Empty(t, namespaceReport.OutdatedWorkloads)
+			assert.Equal(t, maxOutdatedWorkloads+2, namespaceReport.OmittedOutdatedWorkloads)
+		}
+	})
+}
@@ -0,0 +1,52 @@
+package rollout
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	conditionType = "InjectionRollout"
+
+	inProgressReason = "InProgress"
+	completedReason  = "Completed"
+
+	completedMessage = "All pods of the workloads in the monitored namespaces were handled by the webhook"
+)
+
+func setRolloutCondition(conditions *[]metav1.Condition, inProgress, pending []*workload) {
+	condition := metav1.Condition{
+		Type:    conditionType,
+		Status:  metav1.ConditionTrue,
+		Reason:  completedReason,
+		Message: completedMessage,
+	}
+
+	if len(inProgress) > 0 || len(pending) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = inProgressReason
+		condition.Message = fmt.Sprintf("restarting %s; pending %s", formatWorkloads(inProgress), formatWorkloads(pending))
+	}
+
+	_ = meta.SetStatusCondition(conditions, condition)
+}
+
+func formatWorkloads(workloads []*workload) string {
+	if len(workloads) == 0 {
+		return "0 workloads"
+	}
+
+	names := make([]string, 0, min(len(workloads), maxDisplayedWorkloads))
+	for _, w := range workloads[:min(len(workloads), maxDisplayedWorkloads)] {
+		names = append(names, w.String())
+	}
+
+	if len(workloads) > maxDisplayedWorkloads {
+		return fmt.Sprintf("%d workloads: %s (at most %d are displayed)", len(workloads), strings.Join(names, ", "), maxDisplayedWorkloads)
+	}
+
+	return fmt.Sprintf("%d workloads: %s", len(workloads), strings.Join(names, ", "))
+}
@@ -0,0 +1,22 @@
+package rollout
+
+import (
+	"time"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/logd"
+)
+
+var (
+	log = logd.Get().WithName("dynakube-injection-rollout")
+)
+
+const (
+	// AnnotationRestartedAt is set on the pod template of a workload, when it is restarted by the operator.
+	AnnotationRestartedAt = "dynatrace.com/restarted-at"
+
+	// restartTimeout is the time after which a restart, that didn't finish, no longer blocks the restart of other workloads.
+	restartTimeout = 10 * time.Minute
+
+	// maxDisplayedWorkloads limits the number of workloads listed in the condition message.
+	maxDisplayedWorkloads = 10
+)
@@ -0,0 +1,99 @@
+package rollout
+
+import (
+	"context"
+	"time"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/timeprovider"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Reconciler restarts the workloads in the namespaces of a DynaKube, whose pods were created before the injection was configured.
+type Reconciler struct {
+	client       client.Client
+	apiReader    client.Reader
+	timeProvider *timeprovider.Provider
+}
+
+func NewReconciler(client client.Client, apiReader client.Reader) *Reconciler {
+	return &Reconciler{
+		client:       client,
+		apiReader:    apiReader,
+		timeProvider: timeprovider.New(),
+	}
+}
+
+// Reconcile restarts the workloads that have pods which were not handled by the webhook.
+// Only a limited number of workloads is restarted at the same time, the remaining ones are restarted by the following reconciles.
+func (r *Reconciler) Reconcile(ctx context.Context, dk *dynakube.DynaKube, namespaces []corev1.Namespace) error {
+	if !dk.OneAgent().IsAppInjectionNeeded() || !dk.OneAgent().IsRolloutEnabled() {
+		meta.RemoveStatusCondition(dk.Conditions(), conditionType)
+
+		return nil
+	}
+
+	now := r.timeProvider.Now().Time
+
+	var inProgress, pending []*workload
+
+	for _, namespace := range namespaces {
+		workloads, err := listWorkloads(ctx, r.apiReader, namespace.Name)
+		if err != nil {
+			return err
+		}
+
+		podsByWorkload, err := listPodsByWorkload(ctx, r.apiReader, namespace.Name)
+		if err != nil {
+			return err
+		}
+
+		for _, w := range workloads {
+			switch {
+			case dk.OneAgent().IsRolloutExcluded(w.GetNamespace(), w.GetName()):
+				continue
+			case w.isRestartInProgress(now):
+				inProgress = append(inProgress, w)
+			case w.needsRestart(podsByWorkload[w.key()]):
+				pending = append(pending, w)
+			}
+		}
+	}
+
+	for len(pending) > 0 && len(inProgress) < dk.OneAgent().GetRolloutMaxConcurrentRestarts() {
+		w := pending[0]
+
+		if err := r.restart(ctx, w, now); err != nil {
+			return err
+		}
+
+		pending = pending[1:]
+		inProgress = append(inProgress, w)
+	}
+
+	setRolloutCondition(dk.Conditions(), inProgress, pending)
+
+	return nil
+}
+
+// restart sets the restart annotation on the pod template, which triggers a rollout of the workload, same as `kubectl rollout restart`.
+func (r *Reconciler) restart(ctx context.Context, w *workload, now time.Time) error {
+	patch := client.MergeFrom(w.DeepCopyObject().(client.Object))
+
+	if w.template.Annotations == nil {
+		w.template.Annotations = map[string]string{}
+	}
+
+	w.template.Annotations[AnnotationRestartedAt] = now.Format(time.RFC3339)
+
+	if err := r.client.Patch(ctx, w.Object, patch); err != nil {
+		return errors.WithMessagef(err, "failed to restart %s %s", w.kind, w)
+	}
+
+	log.Info("restarted workload to inject into its pods", "kind", w.kind, "name", w.GetName(), "namespace", w.GetNamespace())
+
+	return nil
+}
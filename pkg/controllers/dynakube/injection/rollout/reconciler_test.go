@@ -0,0 +1,183 @@
+package rollout
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/oneagent"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/timeprovider"
+	dtwebhook "github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const testNamespace = "test-namespace"
+
+var testTime = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func createDynakube(rollout *oneagent.RolloutSpec) *dynakube.DynaKube {
+	return &dynakube.DynaKube{
+		ObjectMeta: metav1.ObjectMeta{Name: "dynakube", Namespace: "dynatrace"},
+		Spec: dynakube.DynaKubeSpec{
+			OneAgent: oneagent.Spec{
+				CloudNativeFullStack: &oneagent.CloudNativeFullStackSpec{
+					AppInjectionSpec: oneagent.AppInjectionSpec{Rollout: rollout},
+				},
+			},
+		},
+	}
+}
+
+func createDeployment(name string, restartedAt time.Time, rolledOut bool) *appsv1.Deployment {
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(1))},
+	}
+
+	if !restartedAt.IsZero() {
+		deployment.Spec.Template.Annotations = map[string]string{AnnotationRestartedAt: restartedAt.Format(time.RFC3339)}
+	}
+
+	if rolledOut {
+		deployment.Status = appsv1.DeploymentStatus{Replicas: 1, UpdatedReplicas: 1}
+	}
+
+	return deployment
+}
+
+func createPod(deploymentName string, created time.Time, annotations map[string]string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              deploymentName + "-abc-" + created.Format("150405"),
+			Namespace:         testNamespace,
+			Labels:            map[string]string{appsv1.DefaultDeploymentUniqueLabelKey: "abc"},
+			Annotations:       annotations,
+			CreationTimestamp: metav1.NewTime(created),
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: deploymentName + "-abc", Controller: ptr.To(true)},
+			},
+		},
+	}
+}
+
+func createReconciler(clt client.Client) *Reconciler {
+	timeProvider := timeprovider.New().Freeze()
+	timeProvider.Set(testTime)
+
+	return &Reconciler{client: clt, apiReader: clt, timeProvider: timeProvider}
+}
+
+func isRestarted(t *testing.T, clt client.Client, name string) bool {
+	t.Helper()
+
+	var deployment appsv1.Deployment
+	require.NoError(t, clt.Get(context.Background(), client.ObjectKey{Name: name, Namespace: testNamespace}, &deployment))
+
+	return deployment.Spec.Template.Annotations[AnnotationRestartedAt] == testTime.Format(time.RFC3339)
+}
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+	namespaces := []corev1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: testNamespace}}}
+	created := testTime.Add(-time.Hour)
+
+	t.Run("restart workload with pods that were not handled by the webhook", func(t *testing.T) {
+		dk := createDynakube(&oneagent.RolloutSpec{})
+		clt := fake.NewClient(createDeployment("app", time.Time{}, true), createPod("app", created, nil))
+
+		require.NoError(t, createReconciler(clt).Reconcile(ctx, dk, namespaces))
+
+		assert.True(t, isRestarted(t, clt, "app"))
+
+		condition := meta.FindStatusCondition(*dk.Conditions(), conditionType)
+		require.NotNil(t, condition)
+		assert.Equal(t, metav1.ConditionFalse, condition.Status)
+		assert.Equal(t, inProgressReason, condition.Reason)
+		assert.Equal(t, "restarting 1 workloads: test-namespace/app; pending 0 workloads", condition.Message)
+	})
+
+	t.Run("no restart for handled, opted out or recently restarted pods", func(t *testing.T) {
+		dk := createDynakube(&oneagent.RolloutSpec{})
+		clt := fake.NewClient(
+			createDeployment("injected", time.Time{}, true),
+			createPod("injected", created, map[string]string{dtwebhook.AnnotationDynatraceInjected: "false"}),
+			createDeployment("opted-out", time.Time{}, true),
+			createPod("opted-out", created, map[string]string{dtwebhook.AnnotationDynatraceInject: "false"}),
+			createDeployment("restarted", created.Add(-time.Minute), true),
+			createPod("restarted", created, nil),
+		)
+
+		require.NoError(t, createReconciler(clt).Reconcile(ctx, dk, namespaces))
+
+		assert.False(t, isRestarted(t, clt, "injected"))
+		assert.False(t, isRestarted(t, clt, "opted-out"))
+		assert.False(t, isRestarted(t, clt, "restarted"))
+
+		condition := meta.FindStatusCondition(*dk.Conditions(), conditionType)
+		require.NotNil(t, condition)
+		assert.Equal(t, metav1.ConditionTrue, condition.Status)
+		assert.Equal(t, completedReason, condition.Reason)
+	})
+
+	t.Run("denied workloads are not restarted", func(t *testing.T) {
+		dk := createDynakube(&oneagent.RolloutSpec{Deny: []string{testNamespace + "/app"}})
+		clt := fake.NewClient(createDeployment("app", time.Time{}, true), createPod("app", created, nil))
+
+		require.NoError(t, createReconciler(clt).Reconcile(ctx, dk, namespaces))
+
+		assert.False(t, isRestarted(t, clt, "app"))
+	})
+
+	t.Run("number of concurrent restarts is limited", func(t *testing.T) {
+		dk := createDynakube(&oneagent.RolloutSpec{MaxConcurrentRestarts: ptr.To(int32(2))})
+		clt := fake.NewClient(
+			createDeployment("a", time.Time{}, true),
+			createPod("a", created, nil),
+			createDeployment("b", testTime.Add(-time.Minute), false),
+			createDeployment("c", time.Time{}, true),
+			createPod("c", created, nil),
+		)
+
+		require.NoError(t, createReconciler(clt).Reconcile(ctx, dk, namespaces))
+
+		assert.True(t, isRestarted(t, clt, "a"))
+		assert.False(t, isRestarted(t, clt, "c"))
+
+		condition := meta.FindStatusCondition(*dk.Conditions(), conditionType)
+		require.NotNil(t, condition)
+		assert.Equal(t, "restarting 2 workloads: test-namespace/b, test-namespace/a; pending 1 workloads: test-namespace/c", condition.Message)
+	})
+
+	t.Run("stuck restarts don't block others after the timeout", func(t *testing.T) {
+		dk := createDynakube(&oneagent.RolloutSpec{})
+		clt := fake.NewClient(
+			createDeployment("a", testTime.Add(-restartTimeout), false),
+			createDeployment("b", time.Time{}, true),
+			createPod("b", created, nil),
+		)
+
+		require.NoError(t, createReconciler(clt).Reconcile(ctx, dk, namespaces))
+
+		assert.True(t, isRestarted(t, clt, "b"))
+	})
+
+	t.Run("rollout disabled => condition removed", func(t *testing.T) {
+		dk := createDynakube(nil)
+		setRolloutCondition(dk.Conditions(), nil, nil)
+		clt := fake.NewClient(createDeployment("app", time.Time{}, true), createPod("app", created, nil))
+
+		require.NoError(t, createReconciler(clt).Reconcile(ctx, dk, namespaces))
+
+		assert.False(t, isRestarted(t, clt, "app"))
+		assert.Nil(t, meta.FindStatusCondition(*dk.Conditions(), conditionType))
+	})
+}
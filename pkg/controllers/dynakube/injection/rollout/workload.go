@@ -0,0 +1,152 @@
+package rollout
+
+import (
+	"context"
+	"time"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8spod"
+	maputil "github.com/Dynatrace/dynatrace-operator/pkg/util/map"
+	dtwebhook "github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type workload struct {
+	client.Object
+	template  *corev1.PodTemplateSpec
+	kind      string
+	rolledOut bool
+}
+
+func (w *workload) key() string {
+	return w.kind + "/" + w.GetName()
+}
+
+func (w *workload) String() string {
+	return w.GetNamespace() + "/" + w.GetName()
+}
+
+// restartedAt returns the time of the last restart by the operator, or the zero time if it was never restarted.
+func (w *workload) restartedAt() time.Time {
+	restartedAt, err := time.Parse(time.RFC3339, w.template.Annotations[AnnotationRestartedAt])
+	if err != nil {
+		return time.Time{}
+	}
+
+	return restartedAt
+}
+
+func (w *workload) isRestartInProgress(now time.Time) bool {
+	restartedAt := w.restartedAt()
+
+	return !restartedAt.IsZero() && !w.rolledOut && now.Sub(restartedAt) < restartTimeout
+}
+
+// needsRestart checks if any pod of the workload was not handled by the webhook, and was created before the last restart by the operator.
+// Pods created after the last restart are not considered, otherwise a workload would be restarted over and over, e.g. if the webhook is unavailable.
+func (w *workload) needsRestart(pods []corev1.Pod) bool {
+	if !w.rolledOut {
+		// the pods of a rollout that is in progress are handled by the webhook anyway
+		return false
+	}
+
+	restartedAt := w.restartedAt()
+
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+
+		if _, ok := pod.Annotations[dtwebhook.AnnotationDynatraceInjected]; ok {
+			continue
+		}
+
+		if !maputil.GetFieldBool(pod.Annotations, dtwebhook.AnnotationDynatraceInject, true) {
+			continue
+		}
+
+		if restartedAt.IsZero() || pod.CreationTimestamp.Time.Before(restartedAt) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func listWorkloads(ctx context.Context, apiReader client.Reader, namespace string) ([]*workload, error) {
+	var workloads []*workload
+
+	var deployments appsv1.DeploymentList
+	if err := apiReader.List(ctx, &deployments, client.InNamespace(namespace)); err != nil {
+		return nil, errors.WithMessagef(err, "failed to list deployments in namespace %s", namespace)
+	}
+
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		workloads = append(workloads, &workload{Object: deployment, template: &deployment.Spec.Template, kind: "Deployment", rolledOut: isDeploymentRolledOut(deployment)})
+	}
+
+	var statefulSets appsv1.StatefulSetList
+	if err := apiReader.List(ctx, &statefulSets, client.InNamespace(namespace)); err != nil {
+		return nil, errors.WithMessagef(err, "failed to list statefulsets in namespace %s", namespace)
+	}
+
+	for i := range statefulSets.Items {
+		statefulSet := &statefulSets.Items[i]
+		workloads = append(workloads, &workload{Object: statefulSet, template: &statefulSet.Spec.Template, kind: "StatefulSet", rolledOut: isStatefulSetRolledOut(statefulSet)})
+	}
+
+	var daemonSets appsv1.DaemonSetList
+	if err := apiReader.List(ctx, &daemonSets, client.InNamespace(namespace)); err != nil {
+		return nil, errors.WithMessagef(err, "failed to list daemonsets in namespace %s", namespace)
+	}
+
+	for i := range daemonSets.Items {
+		daemonSet := &daemonSets.Items[i]
+		workloads = append(workloads, &workload{Object: daemonSet, template: &daemonSet.Spec.Template, kind: "DaemonSet", rolledOut: isDaemonSetRolledOut(daemonSet)})
+	}
+
+	return workloads, nil
+}
+
+// listPodsByWorkload groups the pods of the namespace by the key of the workload that controls them.
+func listPodsByWorkload(ctx context.Context, apiReader client.Reader, namespace string) (map[string][]corev1.Pod, error) {
+	var pods corev1.PodList
+	if err := apiReader.List(ctx, &pods, client.InNamespace(namespace)); err != nil {
+		return nil, errors.WithMessagef(err, "failed to list pods in namespace %s", namespace)
+	}
+
+	podsByWorkload := map[string][]corev1.Pod{}
+
+	for _, pod := range pods.Items {
+		if key := k8spod.GetWorkloadKey(pod); key != "" {
+			podsByWorkload[key] = append(podsByWorkload[key], pod)
+		}
+	}
+
+	return podsByWorkload, nil
+}
+
+func isDeploymentRolledOut(deployment *appsv1.Deployment) bool {
+	replicas := ptr.Deref(deployment.Spec.Replicas, 1)
+
+	return deployment.Status.ObservedGeneration >= deployment.Generation &&
+		deployment.Status.UpdatedReplicas >= replicas &&
+		deployment.Status.Replicas == deployment.Status.UpdatedReplicas
+}
+
+func isStatefulSetRolledOut(statefulSet *appsv1.StatefulSet) bool {
+	replicas := ptr.Deref(statefulSet.Spec.Replicas, 1)
+
+	return statefulSet.Status.ObservedGeneration >= statefulSet.Generation &&
+		statefulSet.Status.UpdatedReplicas >= replicas
+}
+
+func isDaemonSetRolledOut(daemonSet *appsv1.DaemonSet) bool {
+	return daemonSet.Status.ObservedGeneration >= daemonSet.Generation &&
+		daemonSet.Status.UpdatedNumberScheduled >= daemonSet.Status.DesiredNumberScheduled &&
+		daemonSet.Status.NumberAvailable >= daemonSet.Status.DesiredNumberScheduled
+}
@@ -2,6 +2,7 @@ package daemonset
 
 import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/sizing"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8sresource"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/ptr"
@@ -54,19 +55,10 @@ func getResources(dk dynakube.DynaKube) corev1.ResourceRequirements {
 		defaultMemory = "128Mi"
 	)
 
-	limits := k8sresource.NewResourceList(defaultCPU, defaultMemory)
-	requests := k8sresource.NewResourceList(defaultCPU, defaultMemory)
-
-	if dk.KSPM().Resources.Limits != nil {
-		limits = dk.KSPM().Resources.Limits
-	}
-
-	if dk.KSPM().Resources.Requests != nil {
-		requests = dk.KSPM().Resources.Requests
+	defaults := corev1.ResourceRequirements{
+		Requests: k8sresource.NewResourceList(defaultCPU, defaultMemory),
+		Limits:   k8sresource.NewResourceList(defaultCPU, defaultMemory),
 	}
 
-	return corev1.ResourceRequirements{
-		Requests: requests,
-		Limits:   limits,
-	}
+	return sizing.Merge(sizing.Merge(dk.KSPM().Resources, dk.Sizing().KSPM), &defaults)
 }
@@ -5,9 +5,12 @@ import (
 
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/kspm"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/sizing"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/image"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8sresource"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 )
 
 func TestGetContainer(t *testing.T) {
@@ -53,3 +56,41 @@ func TestGetContainer(t *testing.T) {
 		assert.Equal(t, expectedRepo+":"+expectedTag, mainContainer.Image)
 	})
 }
+
+func TestGetResources(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		resources := getResources(dynakube.DynaKube{})
+
+		assert.Equal(t, k8sresource.NewResourceList("100m", "128Mi"), resources.Requests)
+		assert.Equal(t, k8sresource.NewResourceList("100m", "128Mi"), resources.Limits)
+	})
+
+	t.Run("sizing profile", func(t *testing.T) {
+		dk := dynakube.DynaKube{
+			Spec: dynakube.DynaKubeSpec{
+				Sizing: &sizing.Spec{Profile: sizing.LargeProfile},
+			},
+		}
+
+		assert.Equal(t, *dk.Sizing().KSPM, getResources(dk))
+	})
+
+	t.Run("resources of the component take precedence", func(t *testing.T) {
+		dk := dynakube.DynaKube{
+			Spec: dynakube.DynaKubeSpec{
+				Sizing: &sizing.Spec{
+					Profile: sizing.CustomProfile,
+					Custom: &sizing.Resources{
+						KSPM: &corev1.ResourceRequirements{Requests: k8sresource.NewResourceList("200m", "256Mi")},
+					},
+				},
+			},
+		}
+		dk.Spec.Templates.KspmNodeConfigurationCollector.Resources.Limits = k8sresource.NewResourceList("1", "1Gi")
+
+		resources := getResources(dk)
+
+		assert.Equal(t, k8sresource.NewResourceList("200m", "256Mi"), resources.Requests)
+		assert.Equal(t, k8sresource.NewResourceList("1", "1Gi"), resources.Limits)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/kspm"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/communication"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/podtemplate"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/hasher"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8sconditions"
 	"github.com/pkg/errors"
@@ -19,6 +20,7 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
@@ -243,6 +245,21 @@ func TestGenerateDaemonSet(t *testing.T) {
 
 		assert.Equal(t, daemonset.Spec.Template.Spec.Affinity.NodeAffinity, customNodeAffinity)
 	})
+
+	t.Run("apply pod template override", func(t *testing.T) {
+		dk := createDynakube(true)
+		dk.Spec.Templates.KspmNodeConfigurationCollector.PodTemplateOverride = &podtemplate.Override{
+			RawExtension: runtime.RawExtension{Raw: []byte(`{"spec": {"priorityClassName": "high"}}`)},
+		}
+		reconciler := NewReconciler(nil, nil)
+		daemonset, err := reconciler.generateDaemonSet(dk)
+		require.NoError(t, err)
+		require.NotNil(t, daemonset)
+
+		assert.Equal(t, "high", daemonset.Spec.Template.Spec.PriorityClassName)
+		assert.Equal(t, serviceAccountName, daemonset.Spec.Template.Spec.ServiceAccountName)
+		assert.Contains(t, daemonset.Spec.Template.Annotations, tokenSecretHashAnnotation)
+	})
 }
 
 func createDynakube(isEnabled bool) *dynakube.DynaKube {
@@ -2,6 +2,7 @@ package daemonset
 
 import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/sizing"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/ptr"
 )
@@ -37,7 +38,7 @@ func getContainer(dk dynakube.DynaKube, tenantUUID string) corev1.Container {
 		ImagePullPolicy: dk.LogMonitoring().Template().ImageRef.GetPullPolicy(),
 		VolumeMounts:    getVolumeMounts(tenantUUID),
 		Env:             getEnvs(),
-		Resources:       dk.LogMonitoring().Template().Resources,
+		Resources:       sizing.Merge(dk.LogMonitoring().Template().Resources, dk.Sizing().LogMonitoring),
 		SecurityContext: &securityContext,
 	}
 
@@ -56,7 +57,7 @@ func getInitContainer(dk dynakube.DynaKube, tenantUUID string) corev1.Container
 		Command:         []string{bootstrapCommand},
 		Env:             getInitEnvs(dk),
 		Args:            getInitArgs(dk),
-		Resources:       dk.LogMonitoring().Template().Resources,
+		Resources:       sizing.Merge(dk.LogMonitoring().Template().Resources, dk.Sizing().LogMonitoring),
 		SecurityContext: &securityContext,
 	}
 
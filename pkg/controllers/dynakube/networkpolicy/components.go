@@ -0,0 +1,187 @@
+package networkpolicy
+
+import (
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/consts"
+	agconsts "github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/activegate/consts"
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/istio"
+	"github.com/Dynatrace/dynatrace-operator/pkg/otelcgen"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8slabel"
+	"github.com/Dynatrace/dynatrace-operator/pkg/version"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+const (
+	activeGateSuffix          = "-activegate"
+	extensionControllerSuffix = "-extension-controller"
+	sqlExecutorSuffix         = "-sql-extension-executor"
+	otelCollectorSuffix       = "-otel-collector"
+	logMonitoringSuffix       = "-logmonitoring"
+	kspmSuffix                = "-kspm"
+)
+
+// component describes the traffic of the pods of an operator-managed component.
+// The OneAgent DaemonSet is not part of the components, as it runs in the host network, where NetworkPolicies don't apply.
+type component struct {
+	name           string
+	labelComponent string
+	podSelector    map[string]string
+	ingress        []networkingv1.NetworkPolicyIngressRule
+
+	// egress to other pods in the cluster
+	egress []networkingv1.NetworkPolicyEgressRule
+
+	// hosts outside the cluster the pods connect to, like the Dynatrace environment or the proxy
+	externalHosts []istio.CommunicationHost
+
+	enabled   bool
+	kubeAPI   bool
+	egressAll bool
+}
+
+func (c component) policyName(dk *dynakube.DynaKube) string {
+	return dk.Name + c.name
+}
+
+// hosts are the communication hosts the components connect to outside the cluster
+type hosts struct {
+	tenant     []istio.CommunicationHost
+	activeGate []istio.CommunicationHost
+	oneAgent   []istio.CommunicationHost
+}
+
+func buildComponents(dk *dynakube.DynaKube, externalHosts hosts) []component {
+	return []component{
+		buildActiveGate(dk, externalHosts),
+		buildExtensionController(dk),
+		buildSQLExecutor(dk),
+		buildOtelCollector(dk, externalHosts),
+		buildLogMonitoring(dk, externalHosts),
+		buildKSPM(dk),
+	}
+}
+
+// The ActiveGate is reached by the OneAgents in the host network and, if exposed, from outside the cluster, so its ports are open for all sources.
+func buildActiveGate(dk *dynakube.DynaKube, externalHosts hosts) component {
+	return component{
+		name:           activeGateSuffix,
+		labelComponent: k8slabel.ActiveGateComponentLabel,
+		enabled:        dk.ActiveGate().IsEnabled(),
+		podSelector:    activeGateSelector(dk),
+		ingress:        []networkingv1.NetworkPolicyIngressRule{newIngressRule(newTCPPorts(agconsts.HTTPSContainerPort, agconsts.HTTPContainerPort))},
+		externalHosts:  externalHosts.activeGate,
+		kubeAPI:        true,
+	}
+}
+
+// The extension controller executes the extensions, which connect to arbitrary monitored endpoints, so its egress is not restricted.
+func buildExtensionController(dk *dynakube.DynaKube) component {
+	return component{
+		name:           extensionControllerSuffix,
+		labelComponent: k8slabel.ExtensionComponentLabel,
+		enabled:        dk.Extensions().IsAnyEnabled(),
+		podSelector:    appSelector(dk, k8slabel.ExtensionComponentLabel),
+		ingress: []networkingv1.NetworkPolicyIngressRule{newIngressRule(newTCPPorts(consts.ExtensionsDatasourceTargetPort),
+			newPodPeer(activeGateSelector(dk)),
+			newPodPeer(appSelector(dk, k8slabel.DatabaseSQLExecutorLabel)),
+			newPodPeer(appSelector(dk, k8slabel.OtelCComponentLabel)),
+		)},
+		egressAll: true,
+	}
+}
+
+// The SQL executors connect to the monitored databases, which can be anywhere, so their egress is not restricted.
+func buildSQLExecutor(dk *dynakube.DynaKube) component {
+	return component{
+		name:           sqlExecutorSuffix,
+		labelComponent: k8slabel.DatabaseSQLExecutorLabel,
+		enabled:        dk.Extensions().IsDatabasesEnabled(),
+		podSelector:    appSelector(dk, k8slabel.DatabaseSQLExecutorLabel),
+		egressAll:      true,
+	}
+}
+
+// The collector scrapes arbitrary Prometheus endpoints if the Prometheus extension is enabled, so only then its egress is not restricted.
+func buildOtelCollector(dk *dynakube.DynaKube, externalHosts hosts) component {
+	otelc := component{
+		name:           otelCollectorSuffix,
+		labelComponent: k8slabel.OtelCComponentLabel,
+		enabled:        dk.Extensions().IsPrometheusEnabled() || dk.TelemetryIngest().IsEnabled(),
+		podSelector:    appSelector(dk, k8slabel.OtelCComponentLabel),
+		egress:         activeGateEgress(dk),
+		externalHosts:  externalHosts.tenant,
+		kubeAPI:        true,
+		egressAll:      dk.Extensions().IsPrometheusEnabled(),
+	}
+
+	if receiverPorts := getReceiverPorts(dk); len(receiverPorts) > 0 {
+		otelc.ingress = []networkingv1.NetworkPolicyIngressRule{newIngressRule(receiverPorts)}
+	}
+
+	return otelc
+}
+
+func buildLogMonitoring(dk *dynakube.DynaKube, externalHosts hosts) component {
+	return component{
+		name:           logMonitoringSuffix,
+		labelComponent: k8slabel.LogMonitoringComponentLabel,
+		enabled:        dk.LogMonitoring().IsStandalone(),
+		podSelector:    k8slabel.NewCoreLabels(dk.Name, k8slabel.LogMonitoringComponentLabel).BuildMatchLabels(),
+		egress:         activeGateEgress(dk),
+		externalHosts:  externalHosts.oneAgent,
+		kubeAPI:        true,
+	}
+}
+
+// KSPM only sends its findings to the in-cluster ActiveGate.
+func buildKSPM(dk *dynakube.DynaKube) component {
+	return component{
+		name:           kspmSuffix,
+		labelComponent: k8slabel.KSPMComponentLabel,
+		enabled:        dk.KSPM().IsEnabled(),
+		podSelector:    k8slabel.NewCoreLabels(dk.Name, k8slabel.KSPMComponentLabel).BuildMatchLabels(),
+		egress:         activeGateEgress(dk),
+		kubeAPI:        true,
+	}
+}
+
+// activeGateSelector matches the pods of the main ActiveGate and all of its pools.
+func activeGateSelector(dk *dynakube.DynaKube) map[string]string {
+	return map[string]string{
+		k8slabel.AppCreatedByLabel: dk.Name,
+		k8slabel.AppManagedByLabel: version.AppName,
+		k8slabel.AppComponentLabel: agconsts.MultiActiveGateName,
+	}
+}
+
+func appSelector(dk *dynakube.DynaKube, appName string) map[string]string {
+	return k8slabel.NewAppLabels(appName, dk.Name, appName, "").BuildMatchLabels()
+}
+
+func activeGateEgress(dk *dynakube.DynaKube) []networkingv1.NetworkPolicyEgressRule {
+	if !dk.ActiveGate().IsEnabled() {
+		return nil
+	}
+
+	return []networkingv1.NetworkPolicyEgressRule{newEgressRule(newTCPPorts(agconsts.HTTPSContainerPort), newPodPeer(activeGateSelector(dk)))}
+}
+
+func getReceiverPorts(dk *dynakube.DynaKube) []networkingv1.NetworkPolicyPort {
+	if !dk.TelemetryIngest().IsEnabled() {
+		return nil
+	}
+
+	var ports []networkingv1.NetworkPolicyPort
+
+	for _, receiverPort := range otelcgen.ReceiverPorts(dk.TelemetryIngest().GetProtocols()) {
+		protocol := corev1.ProtocolTCP
+		if receiverPort.UDP {
+			protocol = corev1.ProtocolUDP
+		}
+
+		ports = append(ports, newPort(protocol, receiverPort.Port))
+	}
+
+	return ports
+}
@@ -0,0 +1,5 @@
+package networkpolicy
+
+const (
+	conditionType = "NetworkPolicies"
+)
@@ -0,0 +1,208 @@
+package networkpolicy
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/istio"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8sconditions"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8slabel"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8sciliumpolicy"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8snetworkpolicy"
+	"github.com/pkg/errors"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Reconciler manages the NetworkPolicies, and optionally the CiliumNetworkPolicies, of the components deployed for a DynaKube.
+type Reconciler struct {
+	apiReader       client.Reader
+	networkPolicies k8snetworkpolicy.QueryObject
+	ciliumPolicies  k8sciliumpolicy.QueryObject
+}
+
+func NewReconciler(clt client.Client, apiReader client.Reader) *Reconciler {
+	return &Reconciler{
+		apiReader:       apiReader,
+		networkPolicies: k8snetworkpolicy.Query(clt, apiReader, log),
+		ciliumPolicies:  k8sciliumpolicy.Query(clt, apiReader, log),
+	}
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, dk *dynakube.DynaKube) error {
+	if !dk.NetworkPolicies().IsEnabled() {
+		if meta.FindStatusCondition(*dk.Conditions(), conditionType) == nil {
+			return nil // no condition == nothing is there to clean up
+		}
+
+		defer meta.RemoveStatusCondition(dk.Conditions(), conditionType)
+
+		r.cleanup(ctx, dk)
+
+		return nil // clean-up shouldn't cause a failure
+	}
+
+	externalHosts, err := r.getHosts(ctx, dk)
+	if err != nil {
+		return err
+	}
+
+	var applied []string
+
+	for _, c := range buildComponents(dk, externalHosts) {
+		if !c.enabled {
+			err = r.delete(ctx, dk, c)
+		} else {
+			err = r.createOrUpdate(ctx, dk, c)
+			applied = append(applied, c.policyName(dk))
+		}
+
+		if err != nil {
+			k8sconditions.SetKubeAPIError(dk.Conditions(), conditionType, err)
+
+			return err
+		}
+	}
+
+	k8sconditions.SetNetworkPoliciesApplied(dk.Conditions(), conditionType, applied)
+
+	return nil
+}
+
+func (r *Reconciler) cleanup(ctx context.Context, dk *dynakube.DynaKube) {
+	for _, c := range buildComponents(dk, hosts{}) {
+		if err := r.delete(ctx, dk, c); err != nil {
+			log.Error(err, "failed to clean-up network policies", "component", c.labelComponent)
+		}
+	}
+}
+
+func (r *Reconciler) createOrUpdate(ctx context.Context, dk *dynakube.DynaKube, c component) error {
+	cilium := dk.NetworkPolicies().IsCiliumEnabled()
+	labels := k8slabel.NewCoreLabels(dk.Name, c.labelComponent).BuildLabels()
+
+	networkPolicy, err := k8snetworkpolicy.Build(dk, c.policyName(dk), metav1.LabelSelector{MatchLabels: c.podSelector},
+		k8snetworkpolicy.SetLabels(labels),
+		k8snetworkpolicy.SetIngress(c.ingress...),
+		k8snetworkpolicy.SetEgress(buildEgress(c, cilium)...),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := k8snetworkpolicy.CreateOrDelete(ctx, r.networkPolicies, networkPolicy, true); err != nil {
+		return err
+	}
+
+	if !needsCiliumPolicy(c, cilium) {
+		return k8sciliumpolicy.Delete(ctx, r.ciliumPolicies, c.policyName(dk), dk.Namespace)
+	}
+
+	ciliumPolicy, err := k8sciliumpolicy.Build(dk, c.policyName(dk), c.podSelector,
+		k8sciliumpolicy.SetLabels(labels),
+		k8sciliumpolicy.SetEgress(buildCiliumEgress(c)...),
+	)
+	if err != nil {
+		return err
+	}
+
+	return k8sciliumpolicy.CreateOrUpdate(ctx, r.ciliumPolicies, ciliumPolicy)
+}
+
+func (r *Reconciler) delete(ctx context.Context, dk *dynakube.DynaKube, c component) error {
+	networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: c.policyName(dk), Namespace: dk.Namespace}}
+
+	if err := k8snetworkpolicy.CreateOrDelete(ctx, r.networkPolicies, networkPolicy, false); err != nil {
+		return err
+	}
+
+	return k8sciliumpolicy.Delete(ctx, r.ciliumPolicies, c.policyName(dk), dk.Namespace)
+}
+
+// buildEgress returns the egress rules of the NetworkPolicy of the component.
+// If a CiliumNetworkPolicy is created for the component, it takes care of DNS and the egress outside the cluster,
+// otherwise the NetworkPolicy has to allow the ports of these hosts for all IPs.
+func buildEgress(c component, cilium bool) []networkingv1.NetworkPolicyEgressRule {
+	if c.egressAll {
+		return []networkingv1.NetworkPolicyEgressRule{{}}
+	}
+
+	if needsCiliumPolicy(c, cilium) {
+		return c.egress
+	}
+
+	egress := append([]networkingv1.NetworkPolicyEgressRule{newDNSEgressRule()}, c.egress...)
+
+	if len(c.externalHosts) > 0 {
+		egress = append(egress, newAnyIPEgressRule(newTCPPorts(getHostPorts(c.externalHosts)...)))
+	}
+
+	if c.kubeAPI {
+		egress = append(egress, newAnyIPEgressRule(newTCPPorts(kubeAPIPorts...)))
+	}
+
+	return egress
+}
+
+func needsCiliumPolicy(c component, cilium bool) bool {
+	return cilium && !c.egressAll && (len(c.externalHosts) > 0 || c.kubeAPI)
+}
+
+func buildCiliumEgress(c component) []k8sciliumpolicy.EgressRule {
+	egress := append([]k8sciliumpolicy.EgressRule{k8sciliumpolicy.NewDNSProxyRule()}, newCiliumHostRules(c.externalHosts)...)
+
+	if c.kubeAPI {
+		egress = append(egress, k8sciliumpolicy.EgressRule{ToEntities: []string{k8sciliumpolicy.EntityKubeAPIServer}})
+	}
+
+	return egress
+}
+
+// getHosts collects the hosts outside the cluster the components connect to.
+// The connection info might not be available on the first reconcile, the hosts are then added on one of the next ones.
+func (r *Reconciler) getHosts(ctx context.Context, dk *dynakube.DynaKube) (hosts, error) {
+	endpoints := []string{dk.APIURL()}
+
+	if dk.HasProxy() {
+		proxy, err := dk.Proxy(ctx, r.apiReader)
+		if err != nil {
+			return hosts{}, err
+		}
+
+		endpoints = append(endpoints, proxy)
+	}
+
+	tenant, err := newCommunicationHosts(endpoints...)
+	if err != nil {
+		return hosts{}, err
+	}
+
+	activeGate, err := newCommunicationHosts(append(endpoints, dk.Status.ActiveGate.ConnectionInfo.Endpoints)...)
+	if err != nil {
+		return hosts{}, err
+	}
+
+	oneAgent, err := newCommunicationHosts(append(endpoints, dk.Status.OneAgent.ConnectionInfo.Endpoints)...)
+	if err != nil {
+		return hosts{}, err
+	}
+
+	return hosts{tenant: tenant, activeGate: activeGate, oneAgent: oneAgent}, nil
+}
+
+func newCommunicationHosts(endpoints ...string) ([]istio.CommunicationHost, error) {
+	var nonEmpty []string
+
+	for _, endpoint := range endpoints {
+		if endpoint != "" {
+			nonEmpty = append(nonEmpty, endpoint)
+		}
+	}
+
+	communicationHosts, err := istio.NewCommunicationHosts(strings.Join(nonEmpty, ","))
+
+	return communicationHosts, errors.WithMessage(err, "failed to parse communication hosts for network policies")
+}
@@ -0,0 +1,191 @@
+package networkpolicy
+
+import (
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/activegate"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/extensions"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/telemetryingest"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/networkpolicy"
+	"github.com/Dynatrace/dynatrace-operator/pkg/otelcgen"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8sconditions"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8sciliumpolicy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	testName      = "test-dynakube"
+	testNamespace = "test-namespace"
+	testAPIURL    = "https://tenant.test/api"
+
+	testActiveGatePolicy = testName + activeGateSuffix
+)
+
+func createDynakube(networkPolicies *networkpolicy.Spec) *dynakube.DynaKube {
+	dk := &dynakube.DynaKube{
+		ObjectMeta: metav1.ObjectMeta{Name: testName, Namespace: testNamespace},
+		Spec: dynakube.DynaKubeSpec{
+			APIURL:          testAPIURL,
+			NetworkPolicies: networkPolicies,
+			ActiveGate: activegate.Spec{
+				Capabilities: []activegate.CapabilityDisplayName{activegate.KubeMonCapability.DisplayName},
+			},
+		},
+	}
+	dk.Status.ActiveGate.ConnectionInfo.Endpoints = "https://tenant.test/communication,https://10.0.0.1:9999/communication"
+
+	return dk
+}
+
+func getNetworkPolicy(t *testing.T, clt client.Client, name string) (*networkingv1.NetworkPolicy, error) {
+	policy := &networkingv1.NetworkPolicy{}
+	err := clt.Get(t.Context(), client.ObjectKey{Name: name, Namespace: testNamespace}, policy)
+
+	return policy, err
+}
+
+func getCiliumPolicy(t *testing.T, clt client.Client, name string) (*unstructured.Unstructured, error) {
+	policy := &unstructured.Unstructured{}
+	policy.SetGroupVersionKind(k8sciliumpolicy.CiliumNetworkPolicyGVK)
+	err := clt.Get(t.Context(), client.ObjectKey{Name: name, Namespace: testNamespace}, policy)
+
+	return policy, err
+}
+
+func getPorts(ports []networkingv1.NetworkPolicyPort) []intstr.IntOrString {
+	out := make([]intstr.IntOrString, len(ports))
+	for i, port := range ports {
+		out[i] = *port.Port
+	}
+
+	return out
+}
+
+func TestReconcile(t *testing.T) {
+	t.Run("nothing to clean up if disabled", func(t *testing.T) {
+		dk := createDynakube(nil)
+		clt := fake.NewClient()
+
+		require.NoError(t, NewReconciler(clt, clt).Reconcile(t.Context(), dk))
+
+		policies := &networkingv1.NetworkPolicyList{}
+		require.NoError(t, clt.List(t.Context(), policies))
+		assert.Empty(t, policies.Items)
+		assert.Nil(t, meta.FindStatusCondition(*dk.Conditions(), conditionType))
+	})
+
+	t.Run("ActiveGate policy allows its ports and the egress to the tenant and the API server", func(t *testing.T) {
+		dk := createDynakube(&networkpolicy.Spec{})
+		clt := fake.NewClient()
+
+		require.NoError(t, NewReconciler(clt, clt).Reconcile(t.Context(), dk))
+
+		policy, err := getNetworkPolicy(t, clt, testActiveGatePolicy)
+		require.NoError(t, err)
+		assert.Equal(t, activeGateSelector(dk), policy.Spec.PodSelector.MatchLabels)
+
+		require.Len(t, policy.Spec.Ingress, 1)
+		assert.Empty(t, policy.Spec.Ingress[0].From)
+		assert.Equal(t, []intstr.IntOrString{intstr.FromInt32(9999), intstr.FromInt32(9998)}, getPorts(policy.Spec.Ingress[0].Ports))
+
+		require.Len(t, policy.Spec.Egress, 3)
+		assert.Equal(t, newDNSEgressRule(), policy.Spec.Egress[0])
+		assert.Equal(t, []intstr.IntOrString{intstr.FromInt32(443), intstr.FromInt32(9999)}, getPorts(policy.Spec.Egress[1].Ports))
+		assert.Equal(t, anyIPv4, policy.Spec.Egress[1].To[0].IPBlock.CIDR)
+		assert.Equal(t, []intstr.IntOrString{intstr.FromInt32(443), intstr.FromInt32(6443)}, getPorts(policy.Spec.Egress[2].Ports))
+
+		_, err = getCiliumPolicy(t, clt, testActiveGatePolicy)
+		assert.True(t, k8serrors.IsNotFound(err))
+
+		condition := meta.FindStatusCondition(*dk.Conditions(), conditionType)
+		require.NotNil(t, condition)
+		assert.Equal(t, k8sconditions.NetworkPoliciesAppliedReason, condition.Reason)
+	})
+
+	t.Run("Cilium policy allows the egress to the tenant by host name", func(t *testing.T) {
+		dk := createDynakube(&networkpolicy.Spec{Cilium: true})
+		clt := fake.NewClient()
+
+		require.NoError(t, NewReconciler(clt, clt).Reconcile(t.Context(), dk))
+
+		policy, err := getNetworkPolicy(t, clt, testActiveGatePolicy)
+		require.NoError(t, err)
+		assert.Empty(t, policy.Spec.Egress)
+		assert.Contains(t, policy.Spec.PolicyTypes, networkingv1.PolicyTypeEgress)
+
+		ciliumPolicy, err := getCiliumPolicy(t, clt, testActiveGatePolicy)
+		require.NoError(t, err)
+
+		egress, _, _ := unstructured.NestedSlice(ciliumPolicy.Object, "spec", "egress")
+		require.Len(t, egress, 4)
+		assert.Contains(t, egress[1], "toCIDR")
+		assert.Equal(t, []any{map[string]any{"matchName": "tenant.test"}}, egress[2].(map[string]any)["toFQDNs"])
+		assert.Equal(t, []any{k8sciliumpolicy.EntityKubeAPIServer}, egress[3].(map[string]any)["toEntities"])
+	})
+
+	t.Run("policies of other components", func(t *testing.T) {
+		dk := createDynakube(&networkpolicy.Spec{})
+		dk.Spec.Extensions = &extensions.Spec{Prometheus: &extensions.PrometheusSpec{}}
+		dk.Spec.TelemetryIngest = &telemetryingest.Spec{Protocols: []string{string(otelcgen.StatsdProtocol)}}
+		clt := fake.NewClient()
+
+		require.NoError(t, NewReconciler(clt, clt).Reconcile(t.Context(), dk))
+
+		eec, err := getNetworkPolicy(t, clt, testName+extensionControllerSuffix)
+		require.NoError(t, err)
+		require.Len(t, eec.Spec.Ingress, 1)
+		assert.Len(t, eec.Spec.Ingress[0].From, 3)
+		assert.Equal(t, []networkingv1.NetworkPolicyEgressRule{{}}, eec.Spec.Egress)
+
+		otelc, err := getNetworkPolicy(t, clt, testName+otelCollectorSuffix)
+		require.NoError(t, err)
+		require.Len(t, otelc.Spec.Ingress, 1)
+		assert.Equal(t, []networkingv1.NetworkPolicyPort{newPort(corev1.ProtocolUDP, otelcgen.StatsdPort)}, otelc.Spec.Ingress[0].Ports)
+
+		_, err = getNetworkPolicy(t, clt, testName+sqlExecutorSuffix)
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+
+	t.Run("policy of disabled component is removed", func(t *testing.T) {
+		dk := createDynakube(&networkpolicy.Spec{Cilium: true})
+		clt := fake.NewClient()
+		r := NewReconciler(clt, clt)
+
+		require.NoError(t, r.Reconcile(t.Context(), dk))
+
+		dk.Spec.ActiveGate.Capabilities = nil
+		require.NoError(t, r.Reconcile(t.Context(), dk))
+
+		_, err := getNetworkPolicy(t, clt, testActiveGatePolicy)
+		assert.True(t, k8serrors.IsNotFound(err))
+		_, err = getCiliumPolicy(t, clt, testActiveGatePolicy)
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+
+	t.Run("policies are removed once disabled", func(t *testing.T) {
+		dk := createDynakube(&networkpolicy.Spec{})
+		clt := fake.NewClient()
+		r := NewReconciler(clt, clt)
+
+		require.NoError(t, r.Reconcile(t.Context(), dk))
+
+		dk.Spec.NetworkPolicies = nil
+		require.NoError(t, r.Reconcile(t.Context(), dk))
+
+		policies := &networkingv1.NetworkPolicyList{}
+		require.NoError(t, clt.List(t.Context(), policies))
+		assert.Empty(t, policies.Items)
+		assert.Nil(t, meta.FindStatusCondition(*dk.Conditions(), conditionType))
+	})
+}
@@ -0,0 +1,102 @@
+package networkpolicy
+
+import (
+	"net"
+	"slices"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/istio"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8sciliumpolicy"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	dnsPort = 53
+
+	anyIPv4 = "0.0.0.0/0"
+	anyIPv6 = "::/0"
+)
+
+// The API server is reached via the kubernetes Service on 443, most distributions forward it to 6443 on the control plane.
+var kubeAPIPorts = []int32{443, 6443}
+
+func newPort(protocol corev1.Protocol, port int32) networkingv1.NetworkPolicyPort {
+	portValue := intstr.FromInt32(port)
+
+	return networkingv1.NetworkPolicyPort{Protocol: &protocol, Port: &portValue}
+}
+
+func newTCPPorts(ports ...int32) []networkingv1.NetworkPolicyPort {
+	out := make([]networkingv1.NetworkPolicyPort, len(ports))
+	for i, port := range ports {
+		out[i] = newPort(corev1.ProtocolTCP, port)
+	}
+
+	return out
+}
+
+func newPodPeer(matchLabels map[string]string) networkingv1.NetworkPolicyPeer {
+	return networkingv1.NetworkPolicyPeer{PodSelector: &metav1.LabelSelector{MatchLabels: matchLabels}}
+}
+
+// newIngressRule allows the ingress on the given ports, from all sources if no peers are given.
+func newIngressRule(ports []networkingv1.NetworkPolicyPort, peers ...networkingv1.NetworkPolicyPeer) networkingv1.NetworkPolicyIngressRule {
+	return networkingv1.NetworkPolicyIngressRule{Ports: ports, From: peers}
+}
+
+// newEgressRule allows the egress on the given ports, to all destinations if no peers are given.
+func newEgressRule(ports []networkingv1.NetworkPolicyPort, peers ...networkingv1.NetworkPolicyPeer) networkingv1.NetworkPolicyEgressRule {
+	return networkingv1.NetworkPolicyEgressRule{Ports: ports, To: peers}
+}
+
+func newDNSEgressRule() networkingv1.NetworkPolicyEgressRule {
+	return newEgressRule([]networkingv1.NetworkPolicyPort{
+		newPort(corev1.ProtocolUDP, dnsPort),
+		newPort(corev1.ProtocolTCP, dnsPort),
+	})
+}
+
+// newAnyIPEgressRule allows the egress to all IPs on the given ports.
+// NetworkPolicies can't select host names, so this is the closest they get to the hosts outside the cluster.
+func newAnyIPEgressRule(ports []networkingv1.NetworkPolicyPort) networkingv1.NetworkPolicyEgressRule {
+	return newEgressRule(ports,
+		networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: anyIPv4}},
+		networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: anyIPv6}},
+	)
+}
+
+func getHostPorts(hosts []istio.CommunicationHost) []int32 {
+	ports := make([]int32, 0, len(hosts))
+	for _, host := range hosts {
+		ports = append(ports, int32(host.Port)) //nolint:gosec
+	}
+
+	slices.Sort(ports)
+
+	return slices.Compact(ports)
+}
+
+// newCiliumHostRules allows the egress to the hosts by name, or by address if the host is an IP.
+func newCiliumHostRules(hosts []istio.CommunicationHost) []k8sciliumpolicy.EgressRule {
+	rules := make([]k8sciliumpolicy.EgressRule, 0, len(hosts))
+
+	for _, host := range hosts {
+		rule := k8sciliumpolicy.EgressRule{
+			Ports: []k8sciliumpolicy.Port{{Protocol: k8sciliumpolicy.ProtocolTCP, Port: host.Port}},
+		}
+
+		if ip := net.ParseIP(host.Host); ip == nil {
+			rule.ToFQDNs = []string{host.Host}
+		} else if ip.To4() != nil {
+			rule.ToCIDRs = []string{host.Host + "/32"}
+		} else {
+			rule.ToCIDRs = []string{host.Host + "/128"}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
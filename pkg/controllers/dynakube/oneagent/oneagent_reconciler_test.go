@@ -8,6 +8,7 @@ import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/oneagent"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/communication"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/podtemplate"
 	dtclient "github.com/Dynatrace/dynatrace-operator/pkg/clients/dynatrace"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/connectioninfo"
@@ -32,6 +33,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -563,6 +565,26 @@ func TestNewDaemonset_Affinity(t *testing.T) {
 	})
 }
 
+func TestNewDaemonset_PodTemplateOverride(t *testing.T) {
+	r := Reconciler{}
+	dk := newDynaKube()
+	dk.Spec.Templates.OneAgent.PodTemplateOverride = &podtemplate.Override{
+		RawExtension: runtime.RawExtension{Raw: []byte(`{"metadata": {"labels": {"team": "a"}}, "spec": {"terminationGracePeriodSeconds": 60}}`)},
+	}
+
+	ds, err := r.buildDesiredDaemonSet(dk)
+	require.NoError(t, err)
+
+	assert.Equal(t, "a", ds.Spec.Template.Labels["team"])
+	assert.Subset(t, ds.Spec.Template.Labels, ds.Spec.Selector.MatchLabels)
+	require.NotNil(t, ds.Spec.Template.Spec.TerminationGracePeriodSeconds)
+	assert.Equal(t, int64(60), *ds.Spec.Template.Spec.TerminationGracePeriodSeconds)
+
+	withoutOverride, err := r.buildDesiredDaemonSet(newDynaKube())
+	require.NoError(t, err)
+	assert.True(t, hasher.IsAnnotationDifferent(ds, withoutOverride))
+}
+
 func newResourceRequirements() corev1.ResourceRequirements {
 	return corev1.ResourceRequirements{
 		Limits: corev1.ResourceList{
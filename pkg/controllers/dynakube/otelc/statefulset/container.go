@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/sizing"
 	"github.com/Dynatrace/dynatrace-operator/pkg/consts"
 	corev1 "k8s.io/api/core/v1"
 )
@@ -21,7 +22,7 @@ func getContainer(dk *dynakube.DynaKube) corev1.Container {
 		ImagePullPolicy: dk.Spec.Templates.OpenTelemetryCollector.ImageRef.GetPullPolicy(),
 		SecurityContext: buildSecurityContext(),
 		Env:             getEnvs(dk),
-		Resources:       dk.Spec.Templates.OpenTelemetryCollector.Resources,
+		Resources:       sizing.Merge(dk.Spec.Templates.OpenTelemetryCollector.Resources, dk.Sizing().OpenTelemetryCollector),
 		Args:            buildArgs(dk),
 		VolumeMounts:    buildContainerVolumeMounts(dk),
 	}
@@ -0,0 +1,23 @@
+package statefulset
+
+import (
+	"context"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8spdb"
+)
+
+func (r *Reconciler) reconcilePodDisruptionBudget(ctx context.Context, dk *dynakube.DynaKube, workloadEnabled bool) error {
+	appLabels := buildAppLabels(dk.Name)
+	budget := dk.Spec.Templates.OpenTelemetryCollector.PodDisruptionBudget
+
+	desired, err := k8spdb.Build(dk, dk.OtelCollectorStatefulsetName(), appLabels.BuildMatchLabels(),
+		k8spdb.SetLabels(appLabels.BuildLabels()),
+		k8spdb.SetBudget(budget),
+	)
+	if err != nil {
+		return err
+	}
+
+	return k8spdb.CreateOrDelete(ctx, k8spdb.Query(r.client, r.apiReader, log), desired, workloadEnabled && budget.IsEnabled(getReplicas(dk)))
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8saffinity"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8sconditions"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8slabel"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8spodtemplate"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8stopology"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8sconfigmap"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8ssecret"
@@ -29,6 +30,7 @@ const (
 	serviceAccountName                                  = "dynatrace" + consts.OTELCollectorNameSuffix
 	annotationTelemetryIngestSecretHash                 = api.InternalFlagPrefix + "telemetry-ingest-secret-hash"
 	annotationTelemetryIngestConfigurationConfigMapHash = api.InternalFlagPrefix + "telemetry-ingest-config-hash"
+	annotationActiveGateTLSCertificateHash              = api.InternalFlagPrefix + "activegate-tls-certificate-hash"
 
 	runAs int64 = 10001
 )
@@ -68,6 +70,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, dk *dynakube.DynaKube) error
 			return nil
 		}
 
+		err = r.reconcilePodDisruptionBudget(ctx, dk, false)
+		if err != nil {
+			log.Error(err, "failed to clean up "+dk.OtelCollectorStatefulsetName()+" pod disruption budget")
+		}
+
 		return nil
 	}
 }
@@ -116,6 +123,11 @@ func (r *Reconciler) createOrUpdateStatefulset(ctx context.Context, dk *dynakube
 		return err
 	}
 
+	err = k8spodtemplate.ApplyOverride(&sts.Spec.Template, dk.Spec.Templates.OpenTelemetryCollector.GetOverride())
+	if err != nil {
+		return err
+	}
+
 	_, err = k8sstatefulset.Query(r.client, r.apiReader, log).WithOwner(dk).CreateOrUpdate(ctx, sts)
 	if err != nil {
 		log.Info("failed to create/update " + dk.OtelCollectorStatefulsetName() + " statefulset")
@@ -124,6 +136,14 @@ func (r *Reconciler) createOrUpdateStatefulset(ctx context.Context, dk *dynakube
 		return err
 	}
 
+	err = r.reconcilePodDisruptionBudget(ctx, dk, true)
+	if err != nil {
+		log.Info("failed to create/update " + dk.OtelCollectorStatefulsetName() + " pod disruption budget")
+		k8sconditions.SetKubeAPIError(dk.Conditions(), conditionType, err)
+
+		return err
+	}
+
 	k8sconditions.SetStatefulSetCreated(dk.Conditions(), conditionType, sts.Name)
 
 	return nil
@@ -163,6 +183,11 @@ func (r *Reconciler) buildTemplateAnnotations(ctx context.Context, dk *dynakube.
 		templateAnnotations[annotationTelemetryIngestConfigurationConfigMapHash] = configConfigMapHash
 	}
 
+	// The self-signed ActiveGate certificate is mounted as well, so a renewal must restart the collector.
+	if certStatus := dk.Status.ActiveGate.TLSCertificate; dk.TelemetryIngest().IsEnabled() && dk.IsAGCertificateNeeded() && certStatus != nil {
+		templateAnnotations[annotationActiveGateTLSCertificateHash] = certStatus.Hash
+	}
+
 	return templateAnnotations, nil
 }
 
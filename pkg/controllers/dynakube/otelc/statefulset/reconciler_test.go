@@ -5,8 +5,10 @@ import (
 
 	"github.com/Dynatrace/dynatrace-operator/pkg/api"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/activegate"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/extensions"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/status"
 	dtclient "github.com/Dynatrace/dynatrace-operator/pkg/clients/dynatrace"
 	"github.com/Dynatrace/dynatrace-operator/pkg/consts"
 	otelcconsts "github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/otelc/consts"
@@ -19,11 +21,14 @@ import (
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -96,6 +101,49 @@ func TestReconcile(t *testing.T) {
 	})
 }
 
+func TestPodDisruptionBudget(t *testing.T) {
+	ctx := t.Context()
+
+	getPDB := func(t *testing.T, clt client.Client, dk *dynakube.DynaKube) (*policyv1.PodDisruptionBudget, error) {
+		t.Helper()
+
+		budget := &policyv1.PodDisruptionBudget{}
+		err := clt.Get(ctx, types.NamespacedName{Name: dk.OtelCollectorStatefulsetName(), Namespace: dk.Namespace}, budget)
+
+		return budget, err
+	}
+
+	t.Run("no pdb for single replica", func(t *testing.T) {
+		dk := getTestDynakubeWithExtensions()
+		mockK8sClient := mockTLSSecret(t, fake.NewClient(), dk)
+
+		require.NoError(t, NewReconciler(mockK8sClient, mockK8sClient).Reconcile(ctx, dk))
+
+		_, err := getPDB(t, mockK8sClient, dk)
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+	t.Run("default pdb for multiple replicas, removed on cleanup", func(t *testing.T) {
+		dk := getTestDynakubeWithExtensions()
+		dk.Spec.Templates.OpenTelemetryCollector.Replicas = ptr.To(int32(3))
+		mockK8sClient := mockTLSSecret(t, fake.NewClient(), dk)
+		reconciler := NewReconciler(mockK8sClient, mockK8sClient)
+
+		require.NoError(t, reconciler.Reconcile(ctx, dk))
+
+		budget, err := getPDB(t, mockK8sClient, dk)
+		require.NoError(t, err)
+		assert.Equal(t, intstr.FromInt32(1), *budget.Spec.MaxUnavailable)
+		assert.Equal(t, buildAppLabels(dk.Name).BuildMatchLabels(), budget.Spec.Selector.MatchLabels)
+
+		dk.Spec.Extensions = nil
+
+		require.NoError(t, reconciler.Reconcile(ctx, dk))
+
+		_, err = getPDB(t, mockK8sClient, dk)
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+}
+
 func TestSecretHashAnnotation(t *testing.T) {
 	t.Run("annotation is set with self-signed tls secret", func(t *testing.T) {
 		dk := getTestDynakubeWithExtensions()
@@ -145,6 +193,20 @@ func TestSecretHashAnnotation(t *testing.T) {
 		// original hash and resulting hash should be different, value got updated on reconcile
 		assert.NotEqual(t, originalSecretHash, resultingSecretHash)
 	})
+	t.Run("annotation is set for self-signed ActiveGate certificate", func(t *testing.T) {
+		dk := getTestDynakubeWithTelemetryIngest()
+		dk.Spec.ActiveGate = activegate.Spec{
+			Capabilities: []activegate.CapabilityDisplayName{
+				activegate.DynatraceAPICapability.DisplayName,
+			},
+		}
+		dk.Status.ActiveGate.TLSCertificate = &status.CertificateStatus{Hash: "test-hash"}
+		tokensSecret := getTokens(dk.Name, dk.Namespace)
+		configMap := getConfigConfigMap(dk.Name, dk.Namespace)
+		statefulSet := getStatefulset(t, dk, &tokensSecret, &configMap)
+
+		assert.Equal(t, "test-hash", statefulSet.Spec.Template.Annotations[annotationActiveGateTLSCertificateHash])
+	})
 }
 
 func TestStatefulsetBase(t *testing.T) {
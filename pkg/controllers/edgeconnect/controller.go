@@ -18,6 +18,7 @@ import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/dttoken"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/hasher"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8sconditions"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8spodtemplate"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8scrd"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8sdeployment"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8sevent"
@@ -399,6 +400,10 @@ func (controller *Controller) reconcileEdgeConnectRegular(ctx context.Context, e
 
 	desiredDeployment.Spec.Template.Annotations[consts.EdgeConnectAnnotationSecretHash] = secretHash
 
+	if err := k8spodtemplate.ApplyOverride(&desiredDeployment.Spec.Template, ec.Spec.GetOverride()); err != nil {
+		return err
+	}
+
 	_, err = k8sdeployment.Query(controller.client, controller.apiReader, log).WithOwner(ec).CreateOrUpdate(ctx, desiredDeployment)
 	if err != nil {
 		_log.Info("could not create or update deployment for EdgeConnect")
@@ -406,6 +411,18 @@ func (controller *Controller) reconcileEdgeConnectRegular(ctx context.Context, e
 		return err
 	}
 
+	if err := controller.reconcilePodDisruptionBudget(ctx, ec, desiredDeployment); err != nil {
+		_log.Info("could not create or update pod disruption budget for EdgeConnect")
+
+		return err
+	}
+
+	if err := controller.reconcileNetworkPolicy(ctx, ec, desiredDeployment); err != nil {
+		_log.Info("could not create or update network policy for EdgeConnect")
+
+		return err
+	}
+
 	return nil
 }
 
@@ -711,6 +728,10 @@ func (controller *Controller) createOrUpdateEdgeConnectDeploymentAndSettings(ctx
 		return errors.WithStack(err)
 	}
 
+	if err := k8spodtemplate.ApplyOverride(&desiredDeployment.Spec.Template, ec.Spec.GetOverride()); err != nil {
+		return err
+	}
+
 	_, err = k8sdeployment.Query(controller.client, controller.apiReader, _log).WithOwner(ec).CreateOrUpdate(ctx, desiredDeployment)
 	if err != nil {
 		_log.Debug("could not create or update deployment for EdgeConnect")
@@ -718,6 +739,18 @@ func (controller *Controller) createOrUpdateEdgeConnectDeploymentAndSettings(ctx
 		return err
 	}
 
+	if err := controller.reconcilePodDisruptionBudget(ctx, ec, desiredDeployment); err != nil {
+		_log.Debug("could not create or update pod disruption budget for EdgeConnect")
+
+		return err
+	}
+
+	if err := controller.reconcileNetworkPolicy(ctx, ec, desiredDeployment); err != nil {
+		_log.Debug("could not create or update network policy for EdgeConnect")
+
+		return err
+	}
+
 	if ec.IsK8SAutomationEnabled() {
 		edgeConnectClient, err := controller.buildEdgeConnectClient(ctx, ec)
 		if err != nil {
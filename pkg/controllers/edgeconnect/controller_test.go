@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/networkpolicy"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/status"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/v1alpha2/edgeconnect"
 	edgeconnectClient "github.com/Dynatrace/dynatrace-operator/pkg/clients/edgeconnect"
@@ -26,12 +27,16 @@ import (
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -335,6 +340,127 @@ func TestReconcile(t *testing.T) {
 	})
 }
 
+func TestReconcilePodDisruptionBudget(t *testing.T) {
+	newEdgeConnect := func(replicas int32) *edgeconnect.EdgeConnect {
+		return &edgeconnect.EdgeConnect{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testName,
+				Namespace: testNamespace,
+			},
+			Spec: edgeconnect.EdgeConnectSpec{
+				APIServer: "abc12345.dynatrace.com",
+				Replicas:  &replicas,
+				OAuth: edgeconnect.OAuthSpec{
+					Endpoint:     "https://test.com/sso/oauth2/token",
+					Resource:     "urn:dtenvironment:test12345",
+					ClientSecret: testOauthClientSecret,
+				},
+			},
+		}
+	}
+
+	getBudget := func(t *testing.T, controller *Controller) (*policyv1.PodDisruptionBudget, error) {
+		t.Helper()
+
+		budget := &policyv1.PodDisruptionBudget{}
+		err := controller.client.Get(t.Context(), client.ObjectKey{Name: testName, Namespace: testNamespace}, budget)
+
+		return budget, err
+	}
+
+	t.Run("no budget for single replica", func(t *testing.T) {
+		ec := newEdgeConnect(1)
+		controller := createFakeClientAndReconciler(t, ec, createClientSecret(testOauthClientSecret, ec.Namespace), createKubeSystemNamespace())
+
+		require.NoError(t, controller.reconcileEdgeConnectRegular(t.Context(), ec))
+
+		_, err := getBudget(t, controller)
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+
+	t.Run("default budget for multiple replicas, removed when scaled down", func(t *testing.T) {
+		ec := newEdgeConnect(3)
+		controller := createFakeClientAndReconciler(t, ec, createClientSecret(testOauthClientSecret, ec.Namespace), createKubeSystemNamespace())
+
+		require.NoError(t, controller.reconcileEdgeConnectRegular(t.Context(), ec))
+
+		budget, err := getBudget(t, controller)
+		require.NoError(t, err)
+		assert.Equal(t, intstr.FromInt32(1), *budget.Spec.MaxUnavailable)
+
+		deploy := &appsv1.Deployment{}
+		require.NoError(t, controller.client.Get(t.Context(), client.ObjectKey{Name: testName, Namespace: testNamespace}, deploy))
+		assert.Equal(t, deploy.Spec.Selector.MatchLabels, budget.Spec.Selector.MatchLabels)
+
+		ec.Spec.Replicas = ptr.To(int32(1))
+		require.NoError(t, controller.reconcileEdgeConnectRegular(t.Context(), ec))
+
+		_, err = getBudget(t, controller)
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+}
+
+func TestReconcileNetworkPolicy(t *testing.T) {
+	newEdgeConnect := func(networkPolicies *networkpolicy.Spec) *edgeconnect.EdgeConnect {
+		return &edgeconnect.EdgeConnect{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testName,
+				Namespace: testNamespace,
+			},
+			Spec: edgeconnect.EdgeConnectSpec{
+				APIServer:       "abc12345.dynatrace.com",
+				NetworkPolicies: networkPolicies,
+				OAuth: edgeconnect.OAuthSpec{
+					Endpoint:     "https://test.com/sso/oauth2/token",
+					Resource:     "urn:dtenvironment:test12345",
+					ClientSecret: testOauthClientSecret,
+				},
+			},
+		}
+	}
+
+	getPolicy := func(t *testing.T, controller *Controller) (*networkingv1.NetworkPolicy, error) {
+		t.Helper()
+
+		policy := &networkingv1.NetworkPolicy{}
+		err := controller.client.Get(t.Context(), client.ObjectKey{Name: testName, Namespace: testNamespace}, policy)
+
+		return policy, err
+	}
+
+	t.Run("no policy by default", func(t *testing.T) {
+		ec := newEdgeConnect(nil)
+		controller := createFakeClientAndReconciler(t, ec, createClientSecret(testOauthClientSecret, ec.Namespace), createKubeSystemNamespace())
+
+		require.NoError(t, controller.reconcileEdgeConnectRegular(t.Context(), ec))
+
+		_, err := getPolicy(t, controller)
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+
+	t.Run("ingress denied, removed when disabled", func(t *testing.T) {
+		ec := newEdgeConnect(&networkpolicy.Spec{})
+		controller := createFakeClientAndReconciler(t, ec, createClientSecret(testOauthClientSecret, ec.Namespace), createKubeSystemNamespace())
+
+		require.NoError(t, controller.reconcileEdgeConnectRegular(t.Context(), ec))
+
+		policy, err := getPolicy(t, controller)
+		require.NoError(t, err)
+		assert.Empty(t, policy.Spec.Ingress)
+		assert.Equal(t, []networkingv1.NetworkPolicyEgressRule{{}}, policy.Spec.Egress)
+
+		deploy := &appsv1.Deployment{}
+		require.NoError(t, controller.client.Get(t.Context(), client.ObjectKey{Name: testName, Namespace: testNamespace}, deploy))
+		assert.Equal(t, deploy.Spec.Selector.MatchLabels, policy.Spec.PodSelector.MatchLabels)
+
+		ec.Spec.NetworkPolicies = nil
+		require.NoError(t, controller.reconcileEdgeConnectRegular(t.Context(), ec))
+
+		_, err = getPolicy(t, controller)
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+}
+
 func TestReconcileProvisionerCreate(t *testing.T) {
 	ctx := context.Background()
 
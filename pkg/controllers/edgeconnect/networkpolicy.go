@@ -0,0 +1,25 @@
+package edgeconnect
+
+import (
+	"context"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/v1alpha2/edgeconnect"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8snetworkpolicy"
+	appsv1 "k8s.io/api/apps/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// reconcileNetworkPolicy denies all ingress to the pods of the given EdgeConnect deployment, the policy shares its name and selector.
+// EdgeConnect only opens connections itself, to the tenant and to the hosts it forwards the requests to, so its egress stays unrestricted.
+func (controller *Controller) reconcileNetworkPolicy(ctx context.Context, ec *edgeconnect.EdgeConnect, desiredDeployment *appsv1.Deployment) error {
+	desired, err := k8snetworkpolicy.Build(ec, desiredDeployment.Name, metav1.LabelSelector{MatchLabels: desiredDeployment.Spec.Selector.MatchLabels},
+		k8snetworkpolicy.SetLabels(desiredDeployment.Labels),
+		k8snetworkpolicy.SetEgress(networkingv1.NetworkPolicyEgressRule{}),
+	)
+	if err != nil {
+		return err
+	}
+
+	return k8snetworkpolicy.CreateOrDelete(ctx, k8snetworkpolicy.Query(controller.client, controller.apiReader, log), desired, ec.Spec.NetworkPolicies.IsEnabled())
+}
@@ -0,0 +1,25 @@
+package edgeconnect
+
+import (
+	"context"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/v1alpha2/edgeconnect"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8spdb"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/utils/ptr"
+)
+
+// reconcilePodDisruptionBudget protects the pods of the given EdgeConnect deployment, the budget shares its name and selector.
+func (controller *Controller) reconcilePodDisruptionBudget(ctx context.Context, ec *edgeconnect.EdgeConnect, desiredDeployment *appsv1.Deployment) error {
+	desired, err := k8spdb.Build(ec, desiredDeployment.Name, desiredDeployment.Spec.Selector.MatchLabels,
+		k8spdb.SetLabels(desiredDeployment.Labels),
+		k8spdb.SetBudget(ec.Spec.PodDisruptionBudget),
+	)
+	if err != nil {
+		return err
+	}
+
+	enabled := ec.Spec.PodDisruptionBudget.IsEnabled(ptr.Deref(ec.Spec.Replicas, 1))
+
+	return k8spdb.CreateOrDelete(ctx, k8spdb.Query(controller.client, controller.apiReader, log), desired, enabled)
+}
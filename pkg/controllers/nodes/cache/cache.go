@@ -3,6 +3,9 @@ package cache
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"maps"
 	"slices"
 	"time"
 
@@ -17,7 +20,7 @@ import (
 
 const (
 	ConfigMapName         = "dynatrace-node-cache"
-	pruneInterval         = 10 * time.Minute
+	DefaultPruneInterval  = 10 * time.Minute
 	lastUpdatedAnnotation = "DTOperatorLastUpdated"
 )
 
@@ -28,6 +31,7 @@ var ErrEntryNotFound = errors.New("node entry not found")
 type Entry struct {
 	LastSeen                 time.Time `json:"seen"`
 	LastMarkedForTermination time.Time `json:"marked"`
+	MaintenanceStarted       time.Time `json:"maintenance"`
 	IPAddress                string    `json:"ip"`
 
 	// Only informational
@@ -48,6 +52,19 @@ func (entry *Entry) SetLastMarkedForTerminationTimestamp(now time.Time) {
 	entry.LastMarkedForTermination = now
 }
 
+// IsInMaintenance checks if a maintenance start event was sent for the node, that was not yet ended.
+func (entry *Entry) IsInMaintenance() bool {
+	return !entry.MaintenanceStarted.IsZero()
+}
+
+func (entry *Entry) SetMaintenanceStartedTimestamp(now time.Time) {
+	entry.MaintenanceStarted = now
+}
+
+func (entry *Entry) ClearMaintenanceStartedTimestamp() {
+	entry.MaintenanceStarted = time.Time{}
+}
+
 // Cache manages information about Nodes where Dynatrace OneAgents are/were running.
 // There is a reason behind not directly having a `map[string]Entry`: "lazy parsing".
 // The `map` within the ConfigMap can have 100+ entries, if the k8s cluster was big enough.
@@ -55,47 +72,191 @@ func (entry *Entry) SetLastMarkedForTerminationTimestamp(now time.Time) {
 // The Reconcile loop will only work with 1 Node at a time, so it make sense to not always parse the other n-1 entries for no good reason.
 //
 // Every now and then, we will need to clean up the cache, that is when we will need to parse all of the Entries, but this only happens every 10m.
+//
+// For big clusters the entries can be spread over several ConfigMaps (shards), the shard of an entry is determined by the hash of the node name.
+// In memory all entries are kept in `obj`, which is persisted as the first shard, only shards with changed entries are written on Store.
+// Shards that fail to be written stay dirty, so they are written again on the next Store.
 // Note: This logic is old, and was only cleaned up for (hopefully) better understandability.
 type Cache struct {
-	obj    *corev1.ConfigMap
-	create bool
-	update bool
+	obj           *corev1.ConfigMap
+	dirty         map[int]bool
+	shards        []*corev1.ConfigMap
+	surplus       []*corev1.ConfigMap
+	pruneInterval time.Duration
+	create        bool
 }
 
-func New(ctx context.Context, apiReader client.Reader, ns string, owner client.Object) (*Cache, error) {
-	var cm corev1.ConfigMap
+type options struct {
+	shards        int
+	pruneInterval time.Duration
+}
 
-	err := apiReader.Get(ctx, client.ObjectKey{Name: ConfigMapName, Namespace: ns}, &cm)
-	if err == nil {
-		return newCache(&cm, false), nil
+// Option is a functional option for configuring the Cache.
+type Option func(*options)
+
+// WithShards sets the number of ConfigMaps the cache entries are spread over.
+// When the number of shards is reduced, the entries of the removed shards are moved to the remaining ones and the removed shards are deleted on the next Store.
+func WithShards(shards int) Option {
+	return func(o *options) {
+		if shards > 0 {
+			o.shards = shards
+		}
 	}
+}
 
-	if k8serrors.IsNotFound(err) {
-		cm := &corev1.ConfigMap{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      ConfigMapName,
-				Namespace: ns,
-			},
-			Data: map[string]string{},
+// WithPruneInterval sets the interval after which the cache is considered outdated and should be pruned.
+func WithPruneInterval(interval time.Duration) Option {
+	return func(o *options) {
+		if interval > 0 {
+			o.pruneInterval = interval
 		}
-		// If running locally, don't set the controller.
-		if owner != nil {
-			if err = controllerutil.SetControllerReference(owner, cm, scheme.Scheme); err != nil {
-				return nil, err
+	}
+}
+
+func New(ctx context.Context, apiReader client.Reader, ns string, owner client.Object, opts ...Option) (*Cache, error) {
+	o := options{
+		shards:        1,
+		pruneInterval: DefaultPruneInterval,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	cm, create, err := getShard(ctx, apiReader, ShardName(0), ns, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	cache := newCache(cm, create)
+	cache.pruneInterval = o.pruneInterval
+	persisted := []map[string]string{maps.Clone(cm.Data)}
+
+	for i := 1; i < o.shards; i++ {
+		shard, _, err := getShard(ctx, apiReader, ShardName(i), ns, owner)
+		if err != nil {
+			return nil, err
+		}
+
+		maps.Copy(cache.obj.Data, shard.Data)
+		cache.shards = append(cache.shards, shard)
+		persisted = append(persisted, shard.Data)
+	}
+
+	// shards above the configured number are left over from a previous configuration, their entries are kept and the shards are deleted on the next Store
+	for i := o.shards; ; i++ {
+		shard, missing, err := getShard(ctx, apiReader, ShardName(i), ns, owner)
+		if err != nil {
+			return nil, err
+		}
+
+		if missing {
+			break
+		}
+
+		for node, raw := range shard.Data {
+			if _, ok := cache.obj.Data[node]; !ok {
+				cache.obj.Data[node] = raw
 			}
 		}
 
-		return newCache(cm, true), nil
+		cache.surplus = append(cache.surplus, shard)
+	}
+
+	// entries are moved to their correct shard on the next Store, in case the number of shards has changed
+	for i, data := range cache.splitData() {
+		if !maps.Equal(data, persisted[i]) {
+			cache.markDirty(i)
+		}
+	}
+
+	return cache, nil
+}
+
+func getShard(ctx context.Context, apiReader client.Reader, name, ns string, owner client.Object) (*corev1.ConfigMap, bool, error) {
+	var cm corev1.ConfigMap
+
+	err := apiReader.Get(ctx, client.ObjectKey{Name: name, Namespace: ns}, &cm)
+	if err == nil {
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+
+		return &cm, false, nil
+	}
+
+	if !k8serrors.IsNotFound(err) {
+		return nil, false, err
+	}
+
+	newCM := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+		},
+		Data: map[string]string{},
+	}
+	// If running locally, don't set the controller.
+	if owner != nil {
+		if err = controllerutil.SetControllerReference(owner, newCM, scheme.Scheme); err != nil {
+			return nil, false, err
+		}
+	}
+
+	return newCM, true, nil
+}
+
+// ShardName returns the name of the ConfigMap for the given shard, the first shard keeps the original name for compatibility reasons.
+func ShardName(shard int) string {
+	if shard == 0 {
+		return ConfigMapName
 	}
 
-	return nil, err
+	return fmt.Sprintf("%s-%d", ConfigMapName, shard)
 }
 
 func newCache(data *corev1.ConfigMap, create bool) *Cache {
 	return &Cache{
-		obj:    data,
-		create: create,
+		obj:           data,
+		create:        create,
+		pruneInterval: DefaultPruneInterval,
+	}
+}
+
+func (cache *Cache) shardCount() int {
+	return len(cache.shards) + 1
+}
+
+func (cache *Cache) shardOf(node string) int {
+	if cache.shardCount() == 1 {
+		return 0
+	}
+
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(node))
+
+	return int(hash.Sum32() % uint32(cache.shardCount())) //nolint:gosec
+}
+
+func (cache *Cache) markDirty(shard int) {
+	if cache.dirty == nil {
+		cache.dirty = map[int]bool{}
 	}
+
+	cache.dirty[shard] = true
+}
+
+// splitData distributes the in-memory entries to their shards.
+func (cache *Cache) splitData() []map[string]string {
+	out := make([]map[string]string, cache.shardCount())
+	for i := range out {
+		out[i] = map[string]string{}
+	}
+
+	for node, raw := range cache.obj.Data {
+		out[cache.shardOf(node)][node] = raw
+	}
+
+	return out
 }
 
 // GetEntry returns the information about node, or error if not found or failed to unmarshall the data.
@@ -127,7 +288,7 @@ func (cache *Cache) SetEntry(node string, entry Entry) error {
 	}
 
 	cache.obj.Data[node] = string(raw)
-	cache.update = true
+	cache.markDirty(cache.shardOf(node))
 
 	return nil
 }
@@ -136,7 +297,7 @@ func (cache *Cache) SetEntry(node string, entry Entry) error {
 func (cache *Cache) DeleteEntry(node string) {
 	if cache.obj.Data != nil {
 		delete(cache.obj.Data, node)
-		cache.update = true
+		cache.markDirty(cache.shardOf(node))
 	}
 }
 
@@ -154,27 +315,104 @@ func (cache *Cache) Keys() []string {
 	return out
 }
 
+// Entries returns all parsed entries of the cache, entries that fail to parse are skipped.
+func (cache *Cache) Entries() []Entry {
+	out := make([]Entry, 0, len(cache.obj.Data))
+
+	for _, node := range cache.Keys() {
+		entry, err := cache.GetEntry(node)
+		if err != nil {
+			continue
+		}
+
+		out = append(out, entry)
+	}
+
+	return out
+}
+
 // Changed returns true if changes have been made to the cache instance.
 func (cache *Cache) Changed() bool {
-	return cache.create || cache.update
+	return cache.create || len(cache.dirty) > 0 || len(cache.surplus) > 0
 }
 
+// Store persists the shards that have changed since the last Store and deletes the surplus shards.
+// Shards that could not be written stay dirty, so calling Store again retries them.
 func (cache *Cache) Store(ctx context.Context, client client.Client) error {
 	if !cache.Changed() {
 		return nil
 	}
 
-	if cache.create {
-		return client.Create(ctx, cache.obj)
+	shardData := cache.splitData()
+
+	for i, shard := range cache.shards {
+		if !cache.dirty[i+1] {
+			continue
+		}
+
+		shard.Data = shardData[i+1]
+
+		if err := storeConfigMap(ctx, client, shard); err != nil {
+			return err
+		}
+
+		delete(cache.dirty, i+1)
+	}
+
+	if cache.create || cache.dirty[0] {
+		primary := cache.obj
+
+		if cache.shardCount() > 1 {
+			primary = cache.obj.DeepCopy()
+			primary.Data = shardData[0]
+		}
+
+		err := storeConfigMap(ctx, client, primary)
+		cache.obj.ResourceVersion = primary.ResourceVersion
+
+		if err != nil {
+			return err
+		}
+
+		cache.create = false
+		delete(cache.dirty, 0)
+	}
+
+	for len(cache.surplus) > 0 {
+		if err := client.Delete(ctx, cache.surplus[0]); err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+
+		cache.surplus = cache.surplus[1:]
+	}
+
+	return nil
+}
+
+// storeConfigMap creates or updates the ConfigMap. In case of a conflict the current resource version is taken over,
+// so the next attempt overwrites the ConfigMap with the in-memory state.
+func storeConfigMap(ctx context.Context, clt client.Client, cm *corev1.ConfigMap) error {
+	var err error
+	if cm.ResourceVersion == "" {
+		err = clt.Create(ctx, cm)
+	} else {
+		err = clt.Update(ctx, cm)
+	}
+
+	if k8serrors.IsConflict(err) || k8serrors.IsAlreadyExists(err) {
+		var current corev1.ConfigMap
+		if getErr := clt.Get(ctx, client.ObjectKeyFromObject(cm), &current); getErr == nil {
+			cm.ResourceVersion = current.ResourceVersion
+		}
 	}
 
-	return client.Update(ctx, cache.obj)
+	return err
 }
 
 func (cache *Cache) IsOutdated(now time.Time) bool {
 	if lastUpdated, ok := cache.obj.Annotations[lastUpdatedAnnotation]; ok {
 		if lastUpdatedTime, err := time.Parse(time.RFC3339, lastUpdated); err == nil {
-			return now.Sub(lastUpdatedTime.UTC()) > pruneInterval
+			return now.Sub(lastUpdatedTime.UTC()) > cache.pruneInterval
 		} else {
 			return false
 		}
@@ -189,7 +427,7 @@ func (cache *Cache) UpdateTimestamp(now time.Time) {
 	}
 
 	cache.obj.Annotations[lastUpdatedAnnotation] = now.Format(time.RFC3339)
-	cache.update = true
+	cache.markDirty(0)
 }
 
 // Prune will collect the nodeNames from the Cache that do not have a corresponding k8s Node in cluster.
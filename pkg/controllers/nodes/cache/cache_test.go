@@ -1,15 +1,24 @@
 package cache
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
+const testNamespace = "dynatrace"
+
 func TestCache(t *testing.T) {
 	t.Run("get non-existing key", func(t *testing.T) {
 		cm := corev1.ConfigMap{}
@@ -55,3 +64,154 @@ func TestCache(t *testing.T) {
 		assert.False(t, nodesCache.IsOutdated(time.Now()))
 	})
 }
+
+func TestShardedCache(t *testing.T) {
+	nodes := make([]string, 0, 20)
+	for i := range 20 {
+		nodes = append(nodes, fmt.Sprintf("node%d", i))
+	}
+
+	t.Run("entries are spread over shards and loaded again", func(t *testing.T) {
+		ctx := t.Context()
+		clt := fake.NewClient()
+
+		nodesCache, err := New(ctx, clt, testNamespace, nil, WithShards(3))
+		require.NoError(t, err)
+
+		for _, node := range nodes {
+			require.NoError(t, nodesCache.SetEntry(node, Entry{IPAddress: node}))
+		}
+
+		require.NoError(t, nodesCache.Store(ctx, clt))
+		assert.False(t, nodesCache.Changed())
+
+		stored := 0
+
+		for i := range 3 {
+			var cm corev1.ConfigMap
+			require.NoError(t, clt.Get(ctx, client.ObjectKey{Name: ShardName(i), Namespace: testNamespace}, &cm))
+
+			for node := range cm.Data {
+				assert.Equal(t, i, nodesCache.shardOf(node))
+			}
+
+			stored += len(cm.Data)
+		}
+
+		assert.Equal(t, len(nodes), stored)
+
+		reloaded, err := New(ctx, clt, testNamespace, nil, WithShards(3))
+		require.NoError(t, err)
+		assert.ElementsMatch(t, nodes, reloaded.Keys())
+		assert.False(t, reloaded.Changed())
+	})
+
+	t.Run("only changed shards are written", func(t *testing.T) {
+		ctx := t.Context()
+		clt := fake.NewClient()
+
+		nodesCache, err := New(ctx, clt, testNamespace, nil, WithShards(3))
+		require.NoError(t, err)
+
+		for _, node := range nodes {
+			require.NoError(t, nodesCache.SetEntry(node, Entry{IPAddress: node}))
+		}
+
+		require.NoError(t, nodesCache.Store(ctx, clt))
+
+		nodesCache.DeleteEntry("node1")
+		assert.Len(t, nodesCache.dirty, 1)
+		assert.True(t, nodesCache.dirty[nodesCache.shardOf("node1")])
+	})
+
+	t.Run("entries are moved when the number of shards changes", func(t *testing.T) {
+		ctx := t.Context()
+		clt := fake.NewClient()
+
+		nodesCache, err := New(ctx, clt, testNamespace, nil)
+		require.NoError(t, err)
+
+		for _, node := range nodes {
+			require.NoError(t, nodesCache.SetEntry(node, Entry{IPAddress: node}))
+		}
+
+		require.NoError(t, nodesCache.Store(ctx, clt))
+
+		resharded, err := New(ctx, clt, testNamespace, nil, WithShards(2))
+		require.NoError(t, err)
+		assert.True(t, resharded.Changed())
+		require.NoError(t, resharded.Store(ctx, clt))
+
+		var primary corev1.ConfigMap
+		require.NoError(t, clt.Get(ctx, client.ObjectKey{Name: ShardName(0), Namespace: testNamespace}, &primary))
+
+		var secondary corev1.ConfigMap
+		require.NoError(t, clt.Get(ctx, client.ObjectKey{Name: ShardName(1), Namespace: testNamespace}, &secondary))
+
+		assert.Len(t, nodes, len(primary.Data)+len(secondary.Data))
+		assert.NotEmpty(t, secondary.Data)
+	})
+
+	t.Run("surplus shards are merged and deleted when the number of shards is reduced", func(t *testing.T) {
+		ctx := t.Context()
+		clt := fake.NewClient()
+
+		nodesCache, err := New(ctx, clt, testNamespace, nil, WithShards(3))
+		require.NoError(t, err)
+
+		for _, node := range nodes {
+			require.NoError(t, nodesCache.SetEntry(node, Entry{IPAddress: node}))
+		}
+
+		require.NoError(t, nodesCache.Store(ctx, clt))
+
+		resharded, err := New(ctx, clt, testNamespace, nil)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, nodes, resharded.Keys())
+		require.NoError(t, resharded.Store(ctx, clt))
+		assert.False(t, resharded.Changed())
+
+		var primary corev1.ConfigMap
+		require.NoError(t, clt.Get(ctx, client.ObjectKey{Name: ShardName(0), Namespace: testNamespace}, &primary))
+		assert.Len(t, primary.Data, len(nodes))
+
+		for i := 1; i < 3; i++ {
+			var shard corev1.ConfigMap
+			err := clt.Get(ctx, client.ObjectKey{Name: ShardName(i), Namespace: testNamespace}, &shard)
+			assert.True(t, k8serrors.IsNotFound(err))
+		}
+	})
+
+	t.Run("changes are kept when storing fails", func(t *testing.T) {
+		ctx := t.Context()
+		fail := true
+		clt := fake.NewClientWithInterceptors(interceptor.Funcs{
+			Create: func(ctx context.Context, clt client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				if fail {
+					return errors.New("boom")
+				}
+
+				return clt.Create(ctx, obj, opts...)
+			},
+		})
+
+		nodesCache, err := New(ctx, clt, testNamespace, nil, WithShards(2))
+		require.NoError(t, err)
+
+		for _, node := range nodes {
+			require.NoError(t, nodesCache.SetEntry(node, Entry{IPAddress: node}))
+		}
+
+		require.Error(t, nodesCache.Store(ctx, clt))
+		assert.True(t, nodesCache.Changed())
+
+		fail = false
+
+		require.NoError(t, nodesCache.Store(ctx, clt))
+		assert.False(t, nodesCache.Changed())
+
+		reloaded, err := New(ctx, clt, testNamespace, nil, WithShards(2))
+		require.NoError(t, err)
+		assert.ElementsMatch(t, nodes, reloaded.Keys())
+	})
+}
@@ -0,0 +1,80 @@
+package nodes
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/nodes/cache"
+)
+
+// cacheEntryView is the read-only representation of a cache.Entry, used for debugging which node maps to which DynaKube/IP.
+type cacheEntryView struct {
+	LastSeen                 time.Time `json:"lastSeen"`
+	LastMarkedForTermination time.Time `json:"lastMarkedForTermination,omitzero"`
+	MaintenanceStarted       time.Time `json:"maintenanceStarted,omitzero"`
+	NodeName                 string    `json:"nodeName"`
+	DynaKubeName             string    `json:"dynakube"`
+	IPAddress                string    `json:"ipAddress"`
+}
+
+// cacheHandler exposes the content of the node cache as JSON.
+type cacheHandler struct {
+	controller *Controller
+}
+
+func (handler *cacheHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	entries, err := handler.getEntries(r)
+	if err != nil {
+		log.Info("failed to read node cache", "err", err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+
+		return
+	}
+
+	view := make([]cacheEntryView, 0, len(entries))
+	for _, entry := range entries {
+		view = append(view, cacheEntryView{
+			NodeName:                 entry.NodeName,
+			DynaKubeName:             entry.DynaKubeName,
+			IPAddress:                entry.IPAddress,
+			LastSeen:                 entry.LastSeen,
+			LastMarkedForTermination: entry.LastMarkedForTermination,
+			MaintenanceStarted:       entry.MaintenanceStarted,
+		})
+	}
+
+	slices.SortFunc(view, func(a, b cacheEntryView) int {
+		return strings.Compare(a.NodeName, b.NodeName)
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(view); err != nil {
+		log.Info("failed to write node cache response", "err", err.Error())
+	}
+}
+
+func (handler *cacheHandler) getEntries(r *http.Request) ([]cache.Entry, error) {
+	handler.controller.cacheMutex.Lock()
+	defer handler.controller.cacheMutex.Unlock()
+
+	if handler.controller.nodeCache != nil {
+		return handler.controller.nodeCache.Entries(), nil
+	}
+
+	nodeCache, err := handler.controller.getCache(r.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	return nodeCache.Entries(), nil
+}
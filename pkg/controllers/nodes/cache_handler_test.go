@@ -0,0 +1,43 @@
+package nodes
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	dtclientmock "github.com/Dynatrace/dynatrace-operator/test/mocks/pkg/clients/dynatrace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheHandler(t *testing.T) {
+	t.Run("returns cached nodes", func(t *testing.T) {
+		fakeClient := createDefaultFakeClient()
+		ctrl := createDefaultReconciler(fakeClient, dtclientmock.NewClient(t))
+		reconcileAllNodes(t, ctrl, fakeClient)
+
+		recorder := httptest.NewRecorder()
+		(&cacheHandler{controller: ctrl}).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, cacheEndpointPath, nil))
+
+		require.Equal(t, http.StatusOK, recorder.Code)
+
+		var view []cacheEntryView
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &view))
+		require.Len(t, view, 2)
+		assert.Equal(t, "node1", view[0].NodeName)
+		assert.Equal(t, "oneagent1", view[0].DynaKubeName)
+		assert.Equal(t, "1.2.3.4", view[0].IPAddress)
+		assert.Equal(t, "node2", view[1].NodeName)
+		assert.Equal(t, "oneagent2", view[1].DynaKubeName)
+	})
+
+	t.Run("rejects non-get requests", func(t *testing.T) {
+		ctrl := createDefaultReconciler(createDefaultFakeClient(), dtclientmock.NewClient(t))
+
+		recorder := httptest.NewRecorder()
+		(&cacheHandler{controller: ctrl}).ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, cacheEndpointPath, nil))
+
+		assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+	})
+}
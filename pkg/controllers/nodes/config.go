@@ -1,10 +1,52 @@
 package nodes
 
 import (
+	"time"
+
 	"github.com/Dynatrace/dynatrace-operator/pkg/logd"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	eventSource = "Dynatrace Operator"
+
+	cacheEndpointPath    = "/node-cache"
+	shutdownFlushTimeout = 10 * time.Second
+
+	defaultFlushIntervalSeconds = 30
+
+	// maintenanceWindowDuration is the expected length of a node maintenance, used for the window announced when a node gets cordoned.
+	// The actual window is reported when the node becomes schedulable again.
+	maintenanceWindowDuration = time.Hour
+
+	reasonNodeCordoned         = "Kubernetes node cordoned"
+	reasonNodeDeleting         = "Kubernetes node is being deleted"
+	reasonNodeRemoved          = "Kubernetes node was removed from the cluster"
+	reasonClusterAutoscaler    = "Kubernetes node is scaled down by the cluster-autoscaler"
+	reasonKarpenterDisruption  = "Kubernetes node is disrupted by Karpenter"
+	reasonSpotInterruption     = "Kubernetes node received a spot instance interruption notice"
+	reasonRebalance            = "Kubernetes node received a rebalance recommendation"
+	reasonASGTermination       = "Kubernetes node is terminated by its auto scaling group"
+	reasonScheduledMaintenance = "Kubernetes node has a scheduled maintenance or termination event"
 )
 
 var (
-	log                 = logd.Get().WithName("nodes")
-	unschedulableTaints = []string{"ToBeDeletedByClusterAutoscaler"}
+	log = logd.Get().WithName("nodes")
+
+	// terminationTaints maps taints set by node lifecycle tools (autoscalers, termination handlers) to the reason reported in the termination event.
+	terminationTaints = map[string]string{
+		"ToBeDeletedByClusterAutoscaler":                         reasonClusterAutoscaler,
+		"karpenter.sh/disrupted":                                 reasonKarpenterDisruption,
+		"karpenter.sh/disruption":                                reasonKarpenterDisruption,
+		"aws-node-termination-handler/spot-itn":                  reasonSpotInterruption,
+		"aws-node-termination-handler/asg-lifecycle-termination": reasonASGTermination,
+		"aws-node-termination-handler/scheduled-maintenance":     reasonScheduledMaintenance,
+		"aws-node-termination-handler/rebalance-recommendation":  reasonRebalance,
+		"cloud.google.com/impending-node-termination":            reasonSpotInterruption,
+	}
+
+	// terminationConditions maps node conditions set by node-problem-detectors to the reason reported in the termination event.
+	terminationConditions = map[corev1.NodeConditionType]string{
+		"VMEventScheduled": reasonScheduledMaintenance,
+	}
 )
@@ -3,14 +3,16 @@ package nodes
 import (
 	"context"
 	"os"
-	"slices"
+	"sync"
 	"time"
 
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
 	dtclient "github.com/Dynatrace/dynatrace-operator/pkg/clients/dynatrace"
+	"github.com/Dynatrace/dynatrace-operator/pkg/consts"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/dynatraceclient"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/dynakube/token"
 	"github.com/Dynatrace/dynatrace-operator/pkg/controllers/nodes/cache"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/envvars"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8senv"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8sdeployment"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/system"
@@ -29,8 +31,15 @@ type Controller struct {
 	apiReader              client.Reader
 	dynatraceClientBuilder dynatraceclient.Builder
 	timeProvider           *timeprovider.Provider
-	podNamespace           string
-	runLocal               bool
+
+	// nodeCache is kept in memory between reconciles, it is written to the cluster either on every reconcile or every flushInterval.
+	nodeCache    *cache.Cache
+	cacheOptions []cache.Option
+	podNamespace string
+
+	flushInterval time.Duration
+	cacheMutex    sync.Mutex
+	runLocal      bool
 }
 
 func Add(mgr manager.Manager, _ string) error {
@@ -38,6 +47,16 @@ func Add(mgr manager.Manager, _ string) error {
 }
 
 func (controller *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	if controller.flushInterval > 0 {
+		if err := mgr.Add(manager.RunnableFunc(controller.runCacheFlush)); err != nil {
+			return err
+		}
+	}
+
+	if err := mgr.AddMetricsServerExtraHandler(cacheEndpointPath, &cacheHandler{controller: controller}); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&corev1.Node{}).
 		Named("nodes-controller").
@@ -52,6 +71,8 @@ func NewController(mgr manager.Manager) *Controller {
 		runLocal:               system.IsRunLocally(),
 		podNamespace:           os.Getenv(k8senv.PodNamespace),
 		timeProvider:           timeprovider.New(),
+		cacheOptions:           getCacheOptions(),
+		flushInterval:          time.Duration(envvars.GetInt(consts.NodeCacheFlushIntervalEnvVar, defaultFlushIntervalSeconds)) * time.Second,
 	}
 }
 
@@ -63,6 +84,14 @@ func NewControllerFromClient(clt client.Client) *Controller {
 		runLocal:               system.IsRunLocally(),
 		podNamespace:           os.Getenv(k8senv.PodNamespace),
 		timeProvider:           timeprovider.New(),
+		cacheOptions:           getCacheOptions(),
+	}
+}
+
+func getCacheOptions() []cache.Option {
+	return []cache.Option{
+		cache.WithShards(envvars.GetInt(consts.NodeCacheShardsEnvVar, 1)),
+		cache.WithPruneInterval(time.Duration(envvars.GetInt(consts.NodeCachePruneIntervalEnvVar, 0)) * time.Second),
 	}
 }
 
@@ -76,7 +105,10 @@ func (controller *Controller) Reconcile(ctx context.Context, request reconcile.R
 		return reconcile.Result{}, err
 	}
 
-	nodeCache, err := controller.getCache(ctx)
+	controller.cacheMutex.Lock()
+	defer controller.cacheMutex.Unlock()
+
+	nodeCache, err := controller.loadCache(ctx)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
@@ -94,7 +126,7 @@ func (controller *Controller) Reconcile(ctx context.Context, request reconcile.R
 			return reconcile.Result{}, err
 		}
 
-		return reconcile.Result{}, nodeCache.Store(ctx, controller.client)
+		return reconcile.Result{}, controller.storeCache(ctx)
 	} else if dk != nil { // Node is found in the cluster, add or update to cache
 		err := controller.reconcileNodeUpdate(ctx, dk, nodeCache, &node)
 		if err != nil {
@@ -111,7 +143,7 @@ func (controller *Controller) Reconcile(ctx context.Context, request reconcile.R
 		nodeCache.UpdateTimestamp(controller.timeProvider.Now().UTC())
 	}
 
-	return reconcile.Result{}, nodeCache.Store(ctx, controller.client)
+	return reconcile.Result{}, controller.storeCache(ctx)
 }
 
 func (controller *Controller) reconcileNodeUpdate(ctx context.Context, dk *dynakube.DynaKube, nodeCache *cache.Cache, node *corev1.Node) error {
@@ -126,13 +158,20 @@ func (controller *Controller) reconcileNodeUpdate(ctx context.Context, dk *dynak
 
 	if cached, err := nodeCache.GetEntry(nodeName); err == nil {
 		cacheEntry.SetLastMarkedForTerminationTimestamp(cached.LastMarkedForTermination)
+		cacheEntry.SetMaintenanceStartedTimestamp(cached.MaintenanceStarted)
 	}
 
 	// Handle unschedulable Nodes, if they have a OneAgent instance
-	if isUnschedulable(node) {
-		if err := controller.markForTermination(ctx, dk, &cacheEntry); err != nil {
+	if reason := getTerminationReason(node); reason != "" {
+		if err := controller.markForTermination(ctx, dk, &cacheEntry, reason); err != nil {
+			return err
+		}
+
+		if err := controller.startMaintenance(ctx, dk, &cacheEntry); err != nil {
 			return err
 		}
+	} else if err := controller.endMaintenance(ctx, dk, &cacheEntry); err != nil {
+		return err
 	}
 
 	if err := nodeCache.SetEntry(nodeName, cacheEntry); err != nil {
@@ -159,7 +198,7 @@ func (controller *Controller) reconcileNodeDeletion(ctx context.Context, nodeCac
 	}
 
 	if dynakube != nil {
-		if err := controller.markForTermination(ctx, dynakube, &cacheEntry); err != nil {
+		if err := controller.markForTermination(ctx, dynakube, &cacheEntry, reasonNodeRemoved); err != nil {
 			return err
 		}
 	}
@@ -169,7 +208,41 @@ func (controller *Controller) reconcileNodeDeletion(ctx context.Context, nodeCac
 	return nil
 }
 
-func (controller *Controller) sendMarkedForTermination(ctx context.Context, dk *dynakube.DynaKube, cachedNode *cache.Entry) error {
+func (controller *Controller) sendMarkedForTermination(ctx context.Context, dk *dynakube.DynaKube, cachedNode *cache.Entry, reason string) error {
+	ts := uint64(cachedNode.LastSeen.Add(-10*time.Minute).UnixNano()) / uint64(time.Millisecond) //nolint:gosec
+
+	return controller.sendHostEvent(ctx, dk, cachedNode, dtclient.EventData{
+		EventType:     dtclient.MarkedForTerminationEvent,
+		Source:        eventSource,
+		Description:   reason + ". Node might be drained or terminated.",
+		StartInMillis: ts,
+		EndInMillis:   ts,
+	})
+}
+
+func (controller *Controller) sendMaintenanceStarted(ctx context.Context, dk *dynakube.DynaKube, cachedNode *cache.Entry) error {
+	return controller.sendHostEvent(ctx, dk, cachedNode, dtclient.EventData{
+		EventType:     dtclient.CustomInfoEvent,
+		Title:         "Kubernetes node maintenance started",
+		Source:        eventSource,
+		Description:   "Kubernetes node cordoned for maintenance. Host unavailability is expected until the node becomes schedulable again.",
+		StartInMillis: toMillis(cachedNode.MaintenanceStarted),
+		EndInMillis:   toMillis(cachedNode.MaintenanceStarted.Add(maintenanceWindowDuration)),
+	})
+}
+
+func (controller *Controller) sendMaintenanceEnded(ctx context.Context, dk *dynakube.DynaKube, cachedNode *cache.Entry) error {
+	return controller.sendHostEvent(ctx, dk, cachedNode, dtclient.EventData{
+		EventType:     dtclient.CustomInfoEvent,
+		Title:         "Kubernetes node maintenance ended",
+		Source:        eventSource,
+		Description:   "Kubernetes node is schedulable again.",
+		StartInMillis: toMillis(cachedNode.MaintenanceStarted),
+		EndInMillis:   toMillis(controller.timeProvider.Now().UTC()),
+	})
+}
+
+func (controller *Controller) sendHostEvent(ctx context.Context, dk *dynakube.DynaKube, cachedNode *cache.Entry, event dtclient.EventData) error {
 	tokenReader := token.NewReader(controller.apiReader, dk)
 
 	tokens, err := tokenReader.ReadTokens(ctx)
@@ -188,37 +261,30 @@ func (controller *Controller) sendMarkedForTermination(ctx context.Context, dk *
 	entityID, err := dynatraceClient.GetHostEntityIDForIP(ctx, cachedNode.IPAddress)
 	if err != nil {
 		if errors.As(err, &dtclient.HostEntityNotFoundErr{}) {
-			log.Info("skipping to send mark for termination event", "dynakube", dk.Name, "nodeIP", cachedNode.IPAddress, "reason", err.Error())
+			log.Info("skipping to send host event", "eventType", event.EventType, "dynakube", dk.Name, "nodeIP", cachedNode.IPAddress, "reason", err.Error())
 
 			return nil
 		}
 
 		if errors.As(err, &dtclient.V1HostEntityAPINotAvailableErr{}) {
-			log.Info("skipping to send mark for termination event", "dynakube", dk.Name, "nodeIP", cachedNode.IPAddress, "reason", err.Error())
+			log.Info("skipping to send host event", "eventType", event.EventType, "dynakube", dk.Name, "nodeIP", cachedNode.IPAddress, "reason", err.Error())
 
 			return nil
 		}
 
-		log.Info("failed to send mark for termination event",
+		log.Info("failed to send host event", "eventType", event.EventType,
 			"reason", "failed to determine entity id", "dynakube", dk.Name, "nodeIP", cachedNode.IPAddress, "cause", err)
 
 		return err
 	}
 
-	ts := uint64(cachedNode.LastSeen.Add(-10*time.Minute).UnixNano()) / uint64(time.Millisecond) //nolint:gosec
+	event.AttachRules = dtclient.EventDataAttachRules{
+		EntityIDs: []string{entityID},
+	}
 
-	err = dynatraceClient.SendEvent(ctx, &dtclient.EventData{
-		EventType:     dtclient.MarkedForTerminationEvent,
-		Source:        "Dynatrace Operator",
-		Description:   "Kubernetes node cordoned. Node might be drained or terminated.",
-		StartInMillis: ts,
-		EndInMillis:   ts,
-		AttachRules: dtclient.EventDataAttachRules{
-			EntityIDs: []string{entityID},
-		},
-	})
+	err = dynatraceClient.SendEvent(ctx, &event)
 	if errors.As(err, &dtclient.V1EventsAPINotAvailableErr{}) {
-		log.Info("skipping to send mark for termination event", "dynakube", dk.Name, "nodeIP", cachedNode.IPAddress, "reason", err.Error())
+		log.Info("skipping to send host event", "eventType", event.EventType, "dynakube", dk.Name, "nodeIP", cachedNode.IPAddress, "reason", err.Error())
 
 		return nil
 	}
@@ -226,7 +292,7 @@ func (controller *Controller) sendMarkedForTermination(ctx context.Context, dk *
 	return err
 }
 
-func (controller *Controller) markForTermination(ctx context.Context, dk *dynakube.DynaKube, cacheEntry *cache.Entry) error {
+func (controller *Controller) markForTermination(ctx context.Context, dk *dynakube.DynaKube, cacheEntry *cache.Entry, reason string) error {
 	if !cacheEntry.IsMarkableForTermination(controller.timeProvider.Now().UTC()) {
 		return nil
 	}
@@ -234,23 +300,76 @@ func (controller *Controller) markForTermination(ctx context.Context, dk *dynaku
 	cacheEntry.SetLastMarkedForTerminationTimestamp(controller.timeProvider.Now().UTC())
 
 	log.Info("sending mark for termination event to dynatrace server", "dk", dk.Name, "ip", cacheEntry.IPAddress,
+		"node", cacheEntry.NodeName, "reason", reason)
+
+	return controller.sendMarkedForTermination(ctx, dk, cacheEntry, reason)
+}
+
+// startMaintenance sends a maintenance window event for the host of a cordoned node, if it wasn't already sent.
+// The OneAgent pods don't have to be protected during the drain, as DaemonSet pods tolerate the unschedulable taint and are never evicted by kubectl drain,
+// so they keep monitoring the node until it's shut down.
+func (controller *Controller) startMaintenance(ctx context.Context, dk *dynakube.DynaKube, cacheEntry *cache.Entry) error {
+	if !dk.FF().IsOneAgentNodeMaintenanceEvents() || cacheEntry.IsInMaintenance() {
+		return nil
+	}
+
+	cacheEntry.SetMaintenanceStartedTimestamp(controller.timeProvider.Now().UTC())
+
+	log.Info("sending maintenance start event to dynatrace server", "dk", dk.Name, "ip", cacheEntry.IPAddress,
+		"node", cacheEntry.NodeName)
+
+	return controller.sendMaintenanceStarted(ctx, dk, cacheEntry)
+}
+
+// endMaintenance sends the closed maintenance window for the host of a node that became schedulable again.
+func (controller *Controller) endMaintenance(ctx context.Context, dk *dynakube.DynaKube, cacheEntry *cache.Entry) error {
+	if !cacheEntry.IsInMaintenance() {
+		return nil
+	}
+
+	log.Info("sending maintenance end event to dynatrace server", "dk", dk.Name, "ip", cacheEntry.IPAddress,
 		"node", cacheEntry.NodeName)
 
-	return controller.sendMarkedForTermination(ctx, dk, cacheEntry)
+	if err := controller.sendMaintenanceEnded(ctx, dk, cacheEntry); err != nil {
+		return err
+	}
+
+	cacheEntry.ClearMaintenanceStartedTimestamp()
+
+	return nil
 }
 
-func isUnschedulable(node *corev1.Node) bool {
-	return node.Spec.Unschedulable || hasUnschedulableTaint(node)
+func toMillis(t time.Time) uint64 {
+	return uint64(t.UnixNano()) / uint64(time.Millisecond) //nolint:gosec
 }
 
-func hasUnschedulableTaint(node *corev1.Node) bool {
+// getTerminationReason returns a human-readable reason if the node is about to be drained or terminated, otherwise an empty string.
+func getTerminationReason(node *corev1.Node) string {
+	if node.DeletionTimestamp != nil {
+		return reasonNodeDeleting
+	}
+
+	if node.Spec.Unschedulable {
+		return reasonNodeCordoned
+	}
+
 	for _, taint := range node.Spec.Taints {
-		if slices.Contains(unschedulableTaints, taint.Key) {
-			return true
+		if reason, ok := terminationTaints[taint.Key]; ok {
+			return reason
+		}
+	}
+
+	for _, condition := range node.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+
+		if reason, ok := terminationConditions[condition.Type]; ok {
+			return reason
 		}
 	}
 
-	return false
+	return ""
 }
 
 func (controller *Controller) getCache(ctx context.Context) (*cache.Cache, error) {
@@ -265,7 +384,69 @@ func (controller *Controller) getCache(ctx context.Context) (*cache.Cache, error
 		owner = deploy
 	}
 
-	return cache.New(ctx, controller.apiReader, controller.podNamespace, owner)
+	return cache.New(ctx, controller.apiReader, controller.podNamespace, owner, controller.cacheOptions...)
+}
+
+// loadCache returns the in-memory cache, or loads it from the cluster if there is none yet. The cacheMutex has to be held by the caller.
+func (controller *Controller) loadCache(ctx context.Context) (*cache.Cache, error) {
+	if controller.nodeCache != nil {
+		return controller.nodeCache, nil
+	}
+
+	nodeCache, err := controller.getCache(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	controller.nodeCache = nodeCache
+
+	return nodeCache, nil
+}
+
+// storeCache writes the cache to the cluster, unless the writes are batched by the flush interval. The cacheMutex has to be held by the caller.
+func (controller *Controller) storeCache(ctx context.Context) error {
+	if controller.flushInterval > 0 {
+		return nil
+	}
+
+	return controller.flushCache(ctx)
+}
+
+// flushCache writes the in-memory cache to the cluster. The cacheMutex has to be held by the caller.
+// On failure the cache keeps its unsaved changes, so they are written again on the next flush.
+func (controller *Controller) flushCache(ctx context.Context) error {
+	if controller.nodeCache == nil {
+		return nil
+	}
+
+	return controller.nodeCache.Store(ctx, controller.client)
+}
+
+// runCacheFlush periodically writes the changes of the in-memory cache to the cluster.
+func (controller *Controller) runCacheFlush(ctx context.Context) error {
+	ticker := time.NewTicker(controller.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.Background(), shutdownFlushTimeout)
+			defer cancel()
+
+			controller.cacheMutex.Lock()
+			defer controller.cacheMutex.Unlock()
+
+			return controller.flushCache(flushCtx)
+		case <-ticker.C:
+			controller.cacheMutex.Lock()
+
+			if err := controller.flushCache(ctx); err != nil {
+				log.Error(err, "failed to store node cache")
+			}
+
+			controller.cacheMutex.Unlock()
+		}
+	}
 }
 
 func (controller *Controller) pruneCache(ctx context.Context, nodeCache *cache.Cache) error {
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/exp"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/oneagent"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
@@ -18,6 +19,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -234,6 +236,98 @@ func TestReconcile(t *testing.T) {
 		assert.True(t, node.LastMarkedForTermination.Add(time.Minute).After(now))
 	})
 
+	t.Run("Node maintenance start and end events", func(t *testing.T) {
+		ctx := t.Context()
+		node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1"}}
+		fakeClient := fake.NewClient(
+			node,
+			&dynakube.DynaKube{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "oneagent1",
+					Namespace:   testNamespace,
+					Annotations: map[string]string{exp.OANodeMaintenanceKey: "true"},
+				},
+				Status: dynakube.DynaKubeStatus{
+					OneAgent: oneagent.Status{
+						Instances: map[string]oneagent.Instance{node.Name: {IPAddress: "1.2.3.4"}},
+					},
+				},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "oneagent1",
+					Namespace: testNamespace,
+				},
+				Data: map[string][]byte{
+					dtclient.APIToken: []byte(testAPIToken),
+				},
+			},
+		)
+
+		dtClient := createDTMockClient(t, "1.2.3.4", "HOST-42")
+		dtClient.On("SendEvent", mock.AnythingOfType("*context.cancelCtx"), mock.MatchedBy(func(e *dtclient.EventData) bool {
+			return e.EventType == dtclient.CustomInfoEvent && e.Title == "Kubernetes node maintenance started" &&
+				e.EndInMillis-e.StartInMillis == uint64(maintenanceWindowDuration.Milliseconds())
+		})).Return(nil).Once()
+		dtClient.On("SendEvent", mock.AnythingOfType("*context.cancelCtx"), mock.MatchedBy(func(e *dtclient.EventData) bool {
+			return e.EventType == dtclient.CustomInfoEvent && e.Title == "Kubernetes node maintenance ended"
+		})).Return(nil).Once()
+
+		ctrl := createDefaultReconciler(fakeClient, dtClient)
+
+		node.Spec.Unschedulable = true
+		require.NoError(t, fakeClient.Update(ctx, node))
+
+		_, err := ctrl.Reconcile(ctx, createReconcileRequest("node1"))
+		require.NoError(t, err)
+
+		// second reconcile must not send a second start event
+		_, err = ctrl.Reconcile(ctx, createReconcileRequest("node1"))
+		require.NoError(t, err)
+
+		c, err := ctrl.getCache(ctx)
+		require.NoError(t, err)
+
+		entry, err := c.GetEntry("node1")
+		require.NoError(t, err)
+		assert.True(t, entry.IsInMaintenance())
+
+		node.Spec.Unschedulable = false
+		require.NoError(t, fakeClient.Update(ctx, node))
+
+		_, err = ctrl.Reconcile(ctx, createReconcileRequest("node1"))
+		require.NoError(t, err)
+
+		c, err = ctrl.getCache(ctx)
+		require.NoError(t, err)
+
+		entry, err = c.GetEntry("node1")
+		require.NoError(t, err)
+		assert.False(t, entry.IsInMaintenance())
+		dtClient.AssertNumberOfCalls(t, "SendEvent", 3)
+	})
+
+	t.Run("Cache writes are batched when flush interval is set", func(t *testing.T) {
+		ctx := t.Context()
+		fakeClient := createDefaultFakeClient()
+		dtClient := dtclientmock.NewClient(t)
+
+		ctrl := createDefaultReconciler(fakeClient, dtClient)
+		ctrl.flushInterval = time.Minute
+
+		reconcileAllNodes(t, ctrl, fakeClient)
+
+		var cm corev1.ConfigMap
+		err := fakeClient.Get(ctx, client.ObjectKey{Name: cache.ConfigMapName, Namespace: testNamespace}, &cm)
+		require.True(t, k8serrors.IsNotFound(err))
+
+		require.NoError(t, ctrl.flushCache(ctx))
+
+		nodesCache, err := cache.New(ctx, fakeClient, testNamespace, nil)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"node1", "node2"}, nodesCache.Keys())
+	})
+
 	t.Run("Server error when removing node", func(t *testing.T) {
 		ctx := t.Context()
 		fakeClient := createDefaultFakeClient()
@@ -299,6 +393,77 @@ func TestReconcile(t *testing.T) {
 	})
 }
 
+func TestGetTerminationReason(t *testing.T) {
+	now := metav1.Now()
+
+	testCases := []struct {
+		name     string
+		node     corev1.Node
+		expected string
+	}{
+		{
+			name:     "schedulable node",
+			node:     corev1.Node{},
+			expected: "",
+		},
+		{
+			name:     "cordoned node",
+			node:     corev1.Node{Spec: corev1.NodeSpec{Unschedulable: true}},
+			expected: reasonNodeCordoned,
+		},
+		{
+			name:     "node with deletion timestamp",
+			node:     corev1.Node{ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &now}},
+			expected: reasonNodeDeleting,
+		},
+		{
+			name:     "cluster-autoscaler taint",
+			node:     corev1.Node{Spec: corev1.NodeSpec{Taints: []corev1.Taint{{Key: "ToBeDeletedByClusterAutoscaler"}}}},
+			expected: reasonClusterAutoscaler,
+		},
+		{
+			name:     "karpenter disruption taint",
+			node:     corev1.Node{Spec: corev1.NodeSpec{Taints: []corev1.Taint{{Key: "karpenter.sh/disrupted", Effect: corev1.TaintEffectNoSchedule}}}},
+			expected: reasonKarpenterDisruption,
+		},
+		{
+			name:     "spot interruption taint",
+			node:     corev1.Node{Spec: corev1.NodeSpec{Taints: []corev1.Taint{{Key: "aws-node-termination-handler/spot-itn"}}}},
+			expected: reasonSpotInterruption,
+		},
+		{
+			name:     "auto scaling group termination taint",
+			node:     corev1.Node{Spec: corev1.NodeSpec{Taints: []corev1.Taint{{Key: "aws-node-termination-handler/asg-lifecycle-termination"}}}},
+			expected: reasonASGTermination,
+		},
+		{
+			name:     "unrelated taint",
+			node:     corev1.Node{Spec: corev1.NodeSpec{Taints: []corev1.Taint{{Key: "dedicated"}}}},
+			expected: "",
+		},
+		{
+			name: "scheduled vm event condition",
+			node: corev1.Node{Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+				{Type: "VMEventScheduled", Status: corev1.ConditionTrue},
+			}}},
+			expected: reasonScheduledMaintenance,
+		},
+		{
+			name: "inactive scheduled vm event condition",
+			node: corev1.Node{Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+				{Type: "VMEventScheduled", Status: corev1.ConditionFalse},
+			}}},
+			expected: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, getTerminationReason(&tc.node))
+		})
+	}
+}
+
 func createReconcileRequest(nodeName string) reconcile.Request {
 	return reconcile.Request{
 		NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: nodeName},
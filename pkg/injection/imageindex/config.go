@@ -0,0 +1,37 @@
+package imageindex
+
+import (
+	"time"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/logd"
+)
+
+var (
+	log = logd.Get().WithName("image-index")
+)
+
+const (
+	configMapSuffix = "-image-index"
+	// IndexDataKey is the key of the index in the ConfigMap, the value is a JSON object mapping the images to their detected technologies.
+	IndexDataKey = "index.json"
+
+	// maxInspectionsPerRun limits the number of images inspected before the results are added to the index, as pulling the image configs can be slow.
+	maxInspectionsPerRun = 10
+
+	// images that failed to be inspected are retried after initialInspectionBackoff, which doubles with every failure up to maxInspectionBackoff.
+	initialInspectionBackoff = time.Minute
+	maxInspectionBackoff     = time.Hour
+
+	// podListPageSize limits the number of pods returned by a single list request, as namespaces can contain a lot of pods.
+	podListPageSize = 500
+
+	TechnologyJava   = "java"
+	TechnologyNodeJS = "nodejs"
+	TechnologyDotNet = "dotnet"
+	TechnologyGo     = "go"
+	TechnologyPython = "python"
+)
+
+func GetConfigMapName(dkName string) string {
+	return dkName + configMapSuffix
+}
@@ -0,0 +1,57 @@
+package imageindex
+
+import (
+	"maps"
+	"path"
+	"slices"
+	"strings"
+
+	containerv1 "github.com/google/go-containerregistry/pkg/v1"
+)
+
+// envHints are env vars set by the official runtime images.
+var envHints = map[string]string{
+	"JAVA_HOME":                   TechnologyJava,
+	"JAVA_VERSION":                TechnologyJava,
+	"JDK_VERSION":                 TechnologyJava,
+	"NODE_VERSION":                TechnologyNodeJS,
+	"DOTNET_VERSION":              TechnologyDotNet,
+	"ASPNET_VERSION":              TechnologyDotNet,
+	"DOTNET_RUNNING_IN_CONTAINER": TechnologyDotNet,
+	"GOLANG_VERSION":              TechnologyGo,
+	"PYTHON_VERSION":              TechnologyPython,
+}
+
+// binaryHints are the runtime binaries, that are started by the entrypoint or command of the image.
+var binaryHints = map[string]string{
+	"java":    TechnologyJava,
+	"node":    TechnologyNodeJS,
+	"dotnet":  TechnologyDotNet,
+	"python":  TechnologyPython,
+	"python3": TechnologyPython,
+}
+
+// Detect returns the technologies of the runtimes found in the config of an image, in a stable order.
+// An empty result means no runtime could be detected, in which case all technologies have to be used.
+func Detect(config containerv1.Config) []string {
+	technologies := map[string]bool{}
+
+	for _, env := range config.Env {
+		key, _, _ := strings.Cut(env, "=")
+		if technology, ok := envHints[key]; ok {
+			technologies[technology] = true
+		}
+	}
+
+	for _, command := range [][]string{config.Entrypoint, config.Cmd} {
+		if len(command) == 0 {
+			continue
+		}
+
+		if technology, ok := binaryHints[path.Base(command[0])]; ok {
+			technologies[technology] = true
+		}
+	}
+
+	return slices.Sorted(maps.Keys(technologies))
+}
@@ -0,0 +1,45 @@
+package imageindex
+
+import (
+	"testing"
+
+	containerv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetect(t *testing.T) {
+	t.Run("detect by env", func(t *testing.T) {
+		config := containerv1.Config{
+			Env: []string{"PATH=/usr/local/bin", "JAVA_HOME=/opt/java/openjdk", "NODE_VERSION=22.1.0"},
+		}
+
+		assert.Equal(t, []string{TechnologyJava, TechnologyNodeJS}, Detect(config))
+	})
+
+	t.Run("detect by entrypoint and cmd", func(t *testing.T) {
+		config := containerv1.Config{
+			Entrypoint: []string{"/usr/bin/dotnet", "app.dll"},
+			Cmd:        []string{"python3", "main.py"},
+		}
+
+		assert.Equal(t, []string{TechnologyDotNet, TechnologyPython}, Detect(config))
+	})
+
+	t.Run("technologies are unique", func(t *testing.T) {
+		config := containerv1.Config{
+			Env:        []string{"JAVA_HOME=/opt/java/openjdk", "JAVA_VERSION=21"},
+			Entrypoint: []string{"java", "-jar", "app.jar"},
+		}
+
+		assert.Equal(t, []string{TechnologyJava}, Detect(config))
+	})
+
+	t.Run("nothing detected", func(t *testing.T) {
+		config := containerv1.Config{
+			Env:        []string{"PATH=/usr/local/bin"},
+			Entrypoint: []string{"/app/server"},
+		}
+
+		assert.Empty(t, Detect(config))
+	})
+}
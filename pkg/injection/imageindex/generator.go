@@ -0,0 +1,121 @@
+package imageindex
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8sconfigmap"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Generator maintains the image index of a DynaKube, which the webhook uses to only download the code modules of the technologies actually used by a pod.
+type Generator struct {
+	apiReader  client.Reader
+	configMaps k8sconfigmap.QueryObject
+	inspector  *Inspector
+}
+
+func NewGenerator(client client.Client, apiReader client.Reader, inspector *Inspector) *Generator {
+	return &Generator{
+		apiReader:  apiReader,
+		configMaps: k8sconfigmap.Query(client, apiReader, log),
+		inspector:  inspector,
+	}
+}
+
+// GenerateForDynakube removes the images from the index that are no longer used in the namespaces of the DynaKube,
+// and queues the images that are not part of the index yet for the Inspector, which adds them in the background.
+func (g *Generator) GenerateForDynakube(ctx context.Context, dk *dynakube.DynaKube, namespaces []corev1.Namespace) error {
+	index, err := getIndex(ctx, g.apiReader, dk.Name, dk.Namespace)
+	if err != nil {
+		log.Info("failed to read the image index, recreating it", "error", err.Error())
+
+		index = Index{}
+	}
+
+	images, err := g.collectImages(ctx, namespaces)
+	if err != nil {
+		return err
+	}
+
+	newIndex := Index{}
+
+	var uninspected []string
+
+	for _, image := range images {
+		if technologies, ok := index[image]; ok {
+			newIndex[image] = technologies
+		} else {
+			uninspected = append(uninspected, image)
+		}
+	}
+
+	if err := storeIndex(ctx, g.configMaps, dk, newIndex); err != nil {
+		return err
+	}
+
+	g.inspector.Enqueue(dk, uninspected)
+
+	return nil
+}
+
+// Cleanup removes the image index, when technology detection is disabled.
+func (g *Generator) Cleanup(ctx context.Context, dk *dynakube.DynaKube) error {
+	g.inspector.Enqueue(dk, nil)
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: GetConfigMapName(dk.Name), Namespace: dk.Namespace}}
+
+	return g.configMaps.Delete(ctx, configMap)
+}
+
+func (g *Generator) collectImages(ctx context.Context, namespaces []corev1.Namespace) ([]string, error) {
+	var images []string
+
+	for _, namespace := range namespaces {
+		namespaceImages, err := g.listImages(ctx, namespace.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		images = append(images, namespaceImages...)
+	}
+
+	slices.Sort(images)
+
+	return slices.Compact(images), nil
+}
+
+// listImages lists the images of the containers of the pods in the namespace, in pages.
+func (g *Generator) listImages(ctx context.Context, namespace string) ([]string, error) {
+	var images []string
+
+	continueToken := ""
+
+	for {
+		var pods corev1.PodList
+
+		err := g.apiReader.List(ctx, &pods,
+			client.InNamespace(namespace),
+			client.Limit(podListPageSize),
+			client.Continue(continueToken),
+		)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "failed to list pods in namespace %s", namespace)
+		}
+
+		for _, pod := range pods.Items {
+			for _, container := range pod.Spec.Containers {
+				images = append(images, container.Image)
+			}
+		}
+
+		continueToken = pods.Continue
+		if continueToken == "" {
+			return images, nil
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package imageindex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/oci/registry"
+	registrymock "github.com/Dynatrace/dynatrace-operator/test/mocks/pkg/util/oci/registry"
+	containerv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/flowcontrol"
+	testingclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const testAppNamespace = "app-namespace"
+
+func createDynakube() *dynakube.DynaKube {
+	return &dynakube.DynaKube{
+		ObjectMeta: metav1.ObjectMeta{Name: testDynakubeName, Namespace: testNamespace},
+	}
+}
+
+func createPod(name string, images ...string) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testAppNamespace},
+	}
+
+	for _, image := range images {
+		pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{Name: image, Image: image})
+	}
+
+	return pod
+}
+
+func createImage(t *testing.T, config containerv1.Config) *containerv1.Image {
+	image, err := mutate.ConfigFile(empty.Image, &containerv1.ConfigFile{Config: config})
+	require.NoError(t, err)
+
+	return &image
+}
+
+func createInspector(clt client.Client, imageGetter registry.ImageGetter) *Inspector {
+	return NewInspector(clt, clt, func(...func(*registry.Client)) (registry.ImageGetter, error) {
+		return imageGetter, nil
+	})
+}
+
+func createGenerator(clt client.Client, inspector *Inspector) *Generator {
+	return NewGenerator(clt, clt, inspector)
+}
+
+func readIndex(t *testing.T, clt client.Client) Index {
+	var configMap corev1.ConfigMap
+	require.NoError(t, clt.Get(context.Background(), client.ObjectKey{Name: GetConfigMapName(testDynakubeName), Namespace: testNamespace}, &configMap))
+
+	var index Index
+	require.NoError(t, json.Unmarshal([]byte(configMap.Data[IndexDataKey]), &index))
+
+	return index
+}
+
+func TestGenerateForDynakube(t *testing.T) {
+	ctx := context.Background()
+	namespaces := []corev1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: testAppNamespace}}}
+
+	t.Run("new images are queued for inspection", func(t *testing.T) {
+		dk := createDynakube()
+		clt := fake.NewClient(dk, createPod("java", "java-app:1.0"), createPod("multi", "java-app:1.0", "broken:1.0"))
+		inspector := createInspector(clt, registrymock.NewImageGetter(t))
+
+		require.NoError(t, createGenerator(clt, inspector).GenerateForDynakube(ctx, dk, namespaces))
+
+		assert.Equal(t, Index{}, readIndex(t, clt))
+		assert.Equal(t, []string{"broken:1.0", "java-app:1.0"}, inspector.pending[client.ObjectKeyFromObject(dk)])
+	})
+
+	t.Run("known images are not inspected again, unused images are removed", func(t *testing.T) {
+		dk := createDynakube()
+		clt := fake.NewClient(dk, createPod("java", "java-app:1.0"), createIndexConfigMap(`{"java-app:1.0":["java"],"old-app:1.0":["nodejs"]}`))
+		inspector := createInspector(clt, registrymock.NewImageGetter(t))
+
+		require.NoError(t, createGenerator(clt, inspector).GenerateForDynakube(ctx, dk, namespaces))
+
+		assert.Equal(t, Index{"java-app:1.0": {TechnologyJava}}, readIndex(t, clt))
+		assert.Empty(t, inspector.pending)
+	})
+}
+
+func TestInspector(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("inspect queued images", func(t *testing.T) {
+		dk := createDynakube()
+		clt := fake.NewClient(dk, createIndexConfigMap(`{"known-app:1.0":["nodejs"]}`))
+
+		imageGetter := registrymock.NewImageGetter(t)
+		imageGetter.EXPECT().PullImageInfo(mock.Anything, "java-app:1.0").Return(createImage(t, containerv1.Config{Env: []string{"JAVA_HOME=/opt/java"}}), nil).Once()
+		imageGetter.EXPECT().PullImageInfo(mock.Anything, "broken:1.0").Return(nil, errors.New("unauthorized")).Once()
+
+		inspector := createInspector(clt, imageGetter)
+		inspector.Enqueue(dk, []string{"java-app:1.0", "broken:1.0"})
+		inspector.inspectPending(ctx)
+
+		assert.Equal(t, Index{"known-app:1.0": {TechnologyNodeJS}, "java-app:1.0": {TechnologyJava}}, readIndex(t, clt))
+		assert.Empty(t, inspector.pending)
+	})
+
+	t.Run("failed images are retried after the backoff", func(t *testing.T) {
+		dk := createDynakube()
+		clt := fake.NewClient(dk)
+
+		imageGetter := registrymock.NewImageGetter(t)
+		imageGetter.EXPECT().PullImageInfo(mock.Anything, "java-app:1.0").Return(nil, errors.New("unauthorized")).Once()
+		imageGetter.EXPECT().PullImageInfo(mock.Anything, "java-app:1.0").Return(createImage(t, containerv1.Config{Env: []string{"JAVA_HOME=/opt/java"}}), nil).Once()
+
+		fakeClock := testingclock.NewFakeClock(time.Now())
+		inspector := createInspector(clt, imageGetter)
+		inspector.backoff = flowcontrol.NewFakeBackOff(initialInspectionBackoff, maxInspectionBackoff, fakeClock)
+
+		inspector.Enqueue(dk, []string{"java-app:1.0"})
+		inspector.inspectPending(ctx)
+
+		err := clt.Get(ctx, client.ObjectKey{Name: GetConfigMapName(testDynakubeName), Namespace: testNamespace}, &corev1.ConfigMap{})
+		assert.True(t, k8serrors.IsNotFound(err))
+
+		inspector.Enqueue(dk, []string{"java-app:1.0"})
+		assert.Empty(t, inspector.pending)
+
+		fakeClock.Step(initialInspectionBackoff)
+
+		inspector.Enqueue(dk, []string{"java-app:1.0"})
+		inspector.inspectPending(ctx)
+		assert.Equal(t, Index{"java-app:1.0": {TechnologyJava}}, readIndex(t, clt))
+	})
+
+	t.Run("images are inspected in batches", func(t *testing.T) {
+		dk := createDynakube()
+		clt := fake.NewClient(dk)
+
+		var images []string
+		for i := range maxInspectionsPerRun + 5 {
+			images = append(images, "image-"+string(rune('a'+i)))
+		}
+
+		imageGetter := registrymock.NewImageGetter(t)
+		imageGetter.EXPECT().PullImageInfo(mock.Anything, mock.Anything).Return(createImage(t, containerv1.Config{}), nil).Times(maxInspectionsPerRun)
+
+		inspector := createInspector(clt, imageGetter)
+		inspector.Enqueue(dk, images)
+
+		key, batch, ok := inspector.next()
+		require.True(t, ok)
+		require.NoError(t, inspector.inspectForDynakube(ctx, key, batch))
+
+		assert.Len(t, readIndex(t, clt), maxInspectionsPerRun)
+		assert.Len(t, inspector.pending[key], 5)
+	})
+
+	t.Run("queue of removed dynakube is dropped", func(t *testing.T) {
+		dk := createDynakube()
+		clt := fake.NewClient()
+
+		inspector := createInspector(clt, registrymock.NewImageGetter(t))
+		inspector.Enqueue(dk, []string{"java-app:1.0"})
+		inspector.inspectPending(ctx)
+
+		assert.Empty(t, inspector.pending)
+	})
+}
+
+func TestCleanup(t *testing.T) {
+	ctx := context.Background()
+	dk := createDynakube()
+	clt := fake.NewClient(dk, createIndexConfigMap("{}"))
+
+	require.NoError(t, createGenerator(clt, createInspector(clt, nil)).Cleanup(ctx, dk))
+
+	err := clt.Get(ctx, client.ObjectKey{Name: GetConfigMapName(testDynakubeName), Namespace: testNamespace}, &corev1.ConfigMap{})
+	assert.True(t, k8serrors.IsNotFound(err))
+
+	require.NoError(t, createGenerator(clt, createInspector(clt, nil)).Cleanup(ctx, dk))
+}
@@ -0,0 +1,74 @@
+package imageindex
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Index maps the images to the technologies detected for them.
+// Images where no runtime was detected have no technologies, images that could not be inspected are not part of the index.
+type Index map[string][]string
+
+func parseIndex(configMap *corev1.ConfigMap) (Index, error) {
+	index := Index{}
+
+	data, ok := configMap.Data[IndexDataKey]
+	if !ok {
+		return index, nil
+	}
+
+	if err := json.Unmarshal([]byte(data), &index); err != nil {
+		return nil, errors.WithMessage(err, "failed to parse image index")
+	}
+
+	return index, nil
+}
+
+func getIndex(ctx context.Context, apiReader client.Reader, dkName, dkNamespace string) (Index, error) {
+	var configMap corev1.ConfigMap
+
+	err := apiReader.Get(ctx, client.ObjectKey{Name: GetConfigMapName(dkName), Namespace: dkNamespace}, &configMap)
+	if k8serrors.IsNotFound(err) {
+		return Index{}, nil
+	} else if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return parseIndex(&configMap)
+}
+
+// GetTechnologies returns the technologies detected for the images, as expected by the technologies annotation.
+// It only returns a result if a technology was detected for every image, otherwise all technologies are needed.
+// It's called for every admission, so the reader should be backed by an informer cache.
+func GetTechnologies(ctx context.Context, reader client.Reader, dkName, dkNamespace string, images []string) (string, bool, error) {
+	index, err := getIndex(ctx, reader, dkName, dkNamespace)
+	if err != nil {
+		return "", false, err
+	}
+
+	var technologies []string
+
+	for _, image := range images {
+		detected := index[image]
+		if len(detected) == 0 {
+			return "", false, nil
+		}
+
+		technologies = append(technologies, detected...)
+	}
+
+	if len(technologies) == 0 {
+		return "", false, nil
+	}
+
+	slices.Sort(technologies)
+
+	return strings.Join(slices.Compact(technologies), ","), true, nil
+}
@@ -0,0 +1,64 @@
+package imageindex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	testDynakubeName = "test-dk"
+	testNamespace    = "dynatrace"
+)
+
+func createIndexConfigMap(data string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: GetConfigMapName(testDynakubeName), Namespace: testNamespace},
+		Data:       map[string]string{IndexDataKey: data},
+	}
+}
+
+func TestGetTechnologies(t *testing.T) {
+	ctx := context.Background()
+	configMap := createIndexConfigMap(`{"java-app:1.0":["java"],"node-app:1.0":["nodejs"],"sidecar:1.0":["go","java"],"unknown:1.0":null}`)
+
+	t.Run("union of all images", func(t *testing.T) {
+		technologies, ok, err := GetTechnologies(ctx, fake.NewClient(configMap), testDynakubeName, testNamespace, []string{"java-app:1.0", "sidecar:1.0", "node-app:1.0"})
+
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, "go,java,nodejs", technologies)
+	})
+
+	t.Run("image without technologies => not ok", func(t *testing.T) {
+		_, ok, err := GetTechnologies(ctx, fake.NewClient(configMap), testDynakubeName, testNamespace, []string{"java-app:1.0", "unknown:1.0"})
+
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("image not in index => not ok", func(t *testing.T) {
+		_, ok, err := GetTechnologies(ctx, fake.NewClient(configMap), testDynakubeName, testNamespace, []string{"java-app:1.0", "new-app:1.0"})
+
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("no index => not ok", func(t *testing.T) {
+		_, ok, err := GetTechnologies(ctx, fake.NewClient(), testDynakubeName, testNamespace, []string{"java-app:1.0"})
+
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("corrupt index => error", func(t *testing.T) {
+		_, _, err := GetTechnologies(ctx, fake.NewClient(createIndexConfigMap("{")), testDynakubeName, testNamespace, []string{"java-app:1.0"})
+
+		require.Error(t, err)
+	})
+}
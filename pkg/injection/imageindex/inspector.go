@@ -0,0 +1,221 @@
+package imageindex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"sync"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8sconfigmap"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/oci/registry"
+	"github.com/pkg/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/flowcontrol"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// Inspector inspects the images queued by the Generator in the background, so pulling the image configs doesn't block the reconcile of the DynaKube.
+// The results are added to the image index of the DynaKube, in batches of maxInspectionsPerRun images.
+// Images that failed to be inspected are not added to the index, they are queued again by the Generator once their backoff has passed.
+type Inspector struct {
+	apiReader             client.Reader
+	configMaps            k8sconfigmap.QueryObject
+	registryClientBuilder registry.ClientBuilder
+	backoff               *flowcontrol.Backoff
+
+	mutex   sync.Mutex
+	pending map[types.NamespacedName][]string
+	wakeup  chan struct{}
+}
+
+var _ manager.Runnable = &Inspector{}
+
+func NewInspector(client client.Client, apiReader client.Reader, registryClientBuilder registry.ClientBuilder) *Inspector {
+	return &Inspector{
+		apiReader:             apiReader,
+		configMaps:            k8sconfigmap.Query(client, apiReader, log),
+		registryClientBuilder: registryClientBuilder,
+		backoff:               flowcontrol.NewBackOff(initialInspectionBackoff, maxInspectionBackoff),
+		pending:               map[types.NamespacedName][]string{},
+		wakeup:                make(chan struct{}, 1),
+	}
+}
+
+// Enqueue replaces the images that wait to be inspected for the DynaKube, images that recently failed to be inspected are left out.
+func (i *Inspector) Enqueue(dk *dynakube.DynaKube, images []string) {
+	key := types.NamespacedName{Name: dk.Name, Namespace: dk.Namespace}
+
+	i.backoff.GC()
+
+	now := i.backoff.Clock.Now()
+	images = slices.DeleteFunc(slices.Clone(images), func(image string) bool {
+		return i.backoff.IsInBackOffSinceUpdate(getBackoffID(key, image), now)
+	})
+
+	i.mutex.Lock()
+
+	if len(images) == 0 {
+		delete(i.pending, key)
+	} else {
+		i.pending[key] = images
+	}
+
+	i.mutex.Unlock()
+
+	select {
+	case i.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// Start inspects the queued images until the context is cancelled.
+func (i *Inspector) Start(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-i.wakeup:
+			i.inspectPending(ctx)
+		}
+	}
+}
+
+func (i *Inspector) inspectPending(ctx context.Context) {
+	for ctx.Err() == nil {
+		key, images, ok := i.next()
+		if !ok {
+			return
+		}
+
+		if err := i.inspectForDynakube(ctx, key, images); err != nil {
+			log.Info("failed to update the image index", "dynakube", key.Name, "error", err.Error())
+		}
+	}
+}
+
+// next takes the next batch of images to inspect from the queue.
+func (i *Inspector) next() (types.NamespacedName, []string, bool) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	for key, images := range i.pending {
+		batch := images[:min(len(images), maxInspectionsPerRun)]
+		if len(batch) == len(images) {
+			delete(i.pending, key)
+		} else {
+			i.pending[key] = images[len(batch):]
+		}
+
+		return key, batch, true
+	}
+
+	return types.NamespacedName{}, nil, false
+}
+
+func (i *Inspector) inspectForDynakube(ctx context.Context, key types.NamespacedName, images []string) error {
+	var dk dynakube.DynaKube
+	if err := i.apiReader.Get(ctx, key, &dk); k8serrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return errors.WithStack(err)
+	}
+
+	inspected := i.inspect(ctx, key, &dk, images)
+	if len(inspected) == 0 {
+		return nil
+	}
+
+	// the index is read again, as it might have been updated by the Generator in the meantime
+	index, err := getIndex(ctx, i.apiReader, dk.Name, dk.Namespace)
+	if err != nil {
+		return err
+	}
+
+	for image, technologies := range inspected {
+		index[image] = technologies
+	}
+
+	return storeIndex(ctx, i.configMaps, &dk, index)
+}
+
+func (i *Inspector) inspect(ctx context.Context, key types.NamespacedName, dk *dynakube.DynaKube, images []string) Index {
+	transport, err := registry.PrepareTransportForDynaKube(ctx, i.apiReader, http.DefaultTransport.(*http.Transport).Clone(), dk)
+	if err != nil {
+		log.Info("failed to prepare transport, skipping image inspection", "error", err.Error())
+
+		return nil
+	}
+
+	registryClient, err := i.registryClientBuilder(
+		registry.WithContext(ctx),
+		registry.WithAPIReader(i.apiReader),
+		registry.WithTransport(transport),
+	)
+	if err != nil {
+		log.Info("failed to create registry client, skipping image inspection", "error", err.Error())
+
+		return nil
+	}
+
+	index := Index{}
+
+	for _, image := range images {
+		backoffID := getBackoffID(key, image)
+
+		technologies, err := inspectImage(ctx, registryClient, image)
+		if err != nil {
+			i.backoff.Next(backoffID, i.backoff.Clock.Now())
+			log.Info("failed to inspect image, falling back to all technologies until it is retried", "image", image, "retryIn", i.backoff.Get(backoffID), "error", err.Error())
+
+			continue
+		}
+
+		i.backoff.Reset(backoffID)
+
+		index[image] = technologies
+	}
+
+	return index
+}
+
+func inspectImage(ctx context.Context, registryClient registry.ImageGetter, image string) ([]string, error) {
+	imageInfo, err := registryClient.PullImageInfo(ctx, image)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to pull image")
+	}
+
+	configFile, err := (*imageInfo).ConfigFile()
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to read image config")
+	}
+
+	technologies := Detect(configFile.Config)
+	log.Info("inspected image", "image", image, "technologies", technologies)
+
+	return technologies, nil
+}
+
+// getBackoffID keeps the backoff separate per DynaKube, as the pull secrets used for the inspection can differ.
+func getBackoffID(key types.NamespacedName, image string) string {
+	return key.String() + "/" + image
+}
+
+func storeIndex(ctx context.Context, configMaps k8sconfigmap.QueryObject, dk *dynakube.DynaKube, index Index) error {
+	data, err := json.Marshal(index)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	configMap, err := k8sconfigmap.Build(dk, GetConfigMapName(dk.Name), map[string]string{IndexDataKey: string(data)})
+	if err != nil {
+		return err
+	}
+
+	_, err = configMaps.CreateOrUpdate(ctx, configMap)
+
+	return err
+}
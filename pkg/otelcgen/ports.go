@@ -0,0 +1,32 @@
+package otelcgen
+
+// ReceiverPort is a port the collector listens on for a protocol.
+type ReceiverPort struct {
+	Port int32
+	UDP  bool
+}
+
+// ReceiverPorts returns the ports the receivers of the given protocols listen on, unknown protocols are ignored.
+func ReceiverPorts(protocols Protocols) []ReceiverPort {
+	var ports []ReceiverPort
+
+	for _, protocol := range protocols {
+		switch protocol {
+		case OtlpProtocol:
+			ports = append(ports, ReceiverPort{Port: OtlpGrpcPort}, ReceiverPort{Port: OtlpHTTPPort})
+		case JaegerProtocol:
+			ports = append(ports,
+				ReceiverPort{Port: JaegerGrpcPort},
+				ReceiverPort{Port: JaegerThriftBinaryPort, UDP: true},
+				ReceiverPort{Port: JaegerThriftCompactPort, UDP: true},
+				ReceiverPort{Port: JaegerThriftHTTPPort},
+			)
+		case ZipkinProtocol:
+			ports = append(ports, ReceiverPort{Port: ZipkinPort})
+		case StatsdProtocol:
+			ports = append(ports, ReceiverPort{Port: StatsdPort, UDP: true})
+		}
+	}
+
+	return ports
+}
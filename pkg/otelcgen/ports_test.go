@@ -0,0 +1,23 @@
+package otelcgen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReceiverPorts(t *testing.T) {
+	t.Run("no protocols", func(t *testing.T) {
+		assert.Empty(t, ReceiverPorts(nil))
+	})
+	t.Run("otlp and statsd", func(t *testing.T) {
+		assert.Equal(t, []ReceiverPort{
+			{Port: OtlpGrpcPort},
+			{Port: OtlpHTTPPort},
+			{Port: StatsdPort, UDP: true},
+		}, ReceiverPorts(Protocols{OtlpProtocol, StatsdProtocol}))
+	})
+	t.Run("all registered protocols", func(t *testing.T) {
+		assert.Len(t, ReceiverPorts(RegisteredProtocols), 8)
+	})
+}
@@ -0,0 +1,31 @@
+package certificates
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var daysToExpiryMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "dynatrace",
+	Subsystem: "operator",
+	Name:      "certificate_days_to_expiry",
+	Help:      "Number of days until a self-signed certificate created by the Operator expires",
+}, []string{"namespace", "owner", "component"})
+
+func init() {
+	metrics.Registry.MustRegister(daysToExpiryMetric)
+}
+
+// RecordExpiry exposes the days left until the certificate of the given component expires.
+func RecordExpiry(owner metav1.Object, component string, notAfter time.Time, now time.Time) {
+	days := notAfter.Sub(now).Hours() / hoursPerDay
+	daysToExpiryMetric.WithLabelValues(owner.GetNamespace(), owner.GetName(), component).Set(days)
+}
+
+// ClearExpiry removes the metric of a component that no longer uses a self-signed certificate.
+func ClearExpiry(owner metav1.Object, component string) {
+	daysToExpiryMetric.DeleteLabelValues(owner.GetNamespace(), owner.GetName(), component)
+}
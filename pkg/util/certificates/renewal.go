@@ -0,0 +1,124 @@
+package certificates
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"net"
+	"slices"
+	"time"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/status"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/hasher"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/timeprovider"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	hoursPerDay = 24
+	percent     = 100
+)
+
+// Renewal takes care of the lifecycle of self-signed certificates:
+// it creates them with the configured validity and decides when they have to be renewed.
+type Renewal struct {
+	timeProvider *timeprovider.Provider
+	validity     time.Duration
+	threshold    int
+}
+
+// NewRenewal creates certificates that are valid for validityDays and renews them once renewalThreshold percent of their validity has passed.
+func NewRenewal(timeProvider *timeprovider.Provider, validityDays int, renewalThreshold int) Renewal {
+	return Renewal{
+		timeProvider: timeProvider,
+		validity:     time.Duration(validityDays) * hoursPerDay * time.Hour,
+		threshold:    renewalThreshold,
+	}
+}
+
+// New creates a certificate that still needs to be signed, valid from now on for the configured validity.
+func (r Renewal) New() (*Certificate, error) {
+	cert, err := New(r.timeProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	cert.Cert.NotAfter = cert.Cert.NotBefore.Add(r.validity)
+
+	return cert, nil
+}
+
+// IsDue returns true if the given PEM encoded certificate can't be parsed or has passed the renewal threshold of its own validity.
+// Certificates with a longer validity (e.g. created by an older Operator version) are kept until they are due,
+// as not every component that uses them is restarted when they change.
+func (r Renewal) IsDue(pemCert []byte) bool {
+	cert, err := parsePEM(pemCert)
+	if err != nil {
+		return true
+	}
+
+	lifetime := cert.NotAfter.Sub(cert.NotBefore)
+	renewAt := cert.NotBefore.Add(lifetime / percent * time.Duration(r.threshold))
+
+	return !r.timeProvider.Now().Time.Before(renewAt)
+}
+
+// CoversDNSNames returns true if the given PEM encoded certificate can be parsed and contains all the given DNS names.
+func CoversDNSNames(pemCert []byte, dnsNames []string) bool {
+	cert, err := parsePEM(pemCert)
+	if err != nil {
+		return false
+	}
+
+	for _, dnsName := range dnsNames {
+		if !slices.Contains(cert.DNSNames, dnsName) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CoversIPAddresses returns true if the given PEM encoded certificate can be parsed and contains all the given IP addresses.
+func CoversIPAddresses(pemCert []byte, ipAddresses []string) bool {
+	cert, err := parsePEM(pemCert)
+	if err != nil {
+		return false
+	}
+
+	for _, ipAddress := range ipAddresses {
+		ip := net.ParseIP(ipAddress)
+		if !slices.ContainsFunc(cert.IPAddresses, ip.Equal) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// GetStatus provides the expiry and the hash of the given PEM encoded certificate.
+func GetStatus(pemCert []byte) (*status.CertificateStatus, error) {
+	cert, err := parsePEM(pemCert)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := hasher.GenerateHash(cert.Raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return &status.CertificateStatus{
+		NotAfter: metav1.NewTime(cert.NotAfter),
+		Hash:     hash,
+	}, nil
+}
+
+func parsePEM(pemCert []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(pemCert)
+	if block == nil {
+		return nil, errors.New("can't decode PEM file")
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
@@ -0,0 +1,100 @@
+package certificates
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/timeprovider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenewal(t *testing.T) {
+	timeProvider := timeprovider.New().Freeze()
+	issuedAt := timeProvider.Now().Time
+	renewal := NewRenewal(timeProvider, 10, 80)
+
+	cert, err := renewal.New()
+	require.NoError(t, err)
+	require.NoError(t, cert.SelfSign())
+
+	pemCert, _, err := cert.ToPEM()
+	require.NoError(t, err)
+
+	t.Run("validity is applied", func(t *testing.T) {
+		assert.Equal(t, issuedAt.Add(10*24*time.Hour), cert.Cert.NotAfter)
+	})
+	t.Run("not due before threshold", func(t *testing.T) {
+		timeProvider.Set(issuedAt.Add(7 * 24 * time.Hour))
+		assert.False(t, renewal.IsDue(pemCert))
+	})
+	t.Run("due after threshold", func(t *testing.T) {
+		timeProvider.Set(issuedAt.Add(8 * 24 * time.Hour))
+		assert.True(t, renewal.IsDue(pemCert))
+	})
+	t.Run("due if certificate can't be parsed", func(t *testing.T) {
+		assert.True(t, renewal.IsDue(randomTestData))
+	})
+	t.Run("certificate valid for longer than configured is renewed by its own validity", func(t *testing.T) {
+		shortRenewal := NewRenewal(timeProvider, 5, 80)
+
+		timeProvider.Set(issuedAt.Add(7 * 24 * time.Hour))
+		assert.False(t, shortRenewal.IsDue(pemCert))
+
+		timeProvider.Set(issuedAt.Add(8 * 24 * time.Hour))
+		assert.True(t, shortRenewal.IsDue(pemCert))
+	})
+}
+
+func TestCoversDNSNames(t *testing.T) {
+	cert, err := New(timeprovider.New())
+	require.NoError(t, err)
+
+	cert.Cert.DNSNames = []string{"ag.dynatrace", "ag.example.com"}
+	require.NoError(t, cert.SelfSign())
+
+	pemCert, _, err := cert.ToPEM()
+	require.NoError(t, err)
+
+	assert.True(t, CoversDNSNames(pemCert, nil))
+	assert.True(t, CoversDNSNames(pemCert, []string{"ag.example.com"}))
+	assert.False(t, CoversDNSNames(pemCert, []string{"ag.example.com", "other.example.com"}))
+	assert.False(t, CoversDNSNames(randomTestData, nil))
+}
+
+func TestCoversIPAddresses(t *testing.T) {
+	cert, err := New(timeprovider.New())
+	require.NoError(t, err)
+
+	cert.Cert.IPAddresses = []net.IP{net.ParseIP("10.0.0.1"), net.ParseIP("fd00::1")}
+	require.NoError(t, cert.SelfSign())
+
+	pemCert, _, err := cert.ToPEM()
+	require.NoError(t, err)
+
+	assert.True(t, CoversIPAddresses(pemCert, nil))
+	assert.True(t, CoversIPAddresses(pemCert, []string{"10.0.0.1", "fd00:0::1"}))
+	assert.False(t, CoversIPAddresses(pemCert, []string{"10.0.0.1", "10.0.0.2"}))
+	assert.False(t, CoversIPAddresses(randomTestData, nil))
+}
+
+func TestGetStatus(t *testing.T) {
+	t.Run("provides expiry and hash", func(t *testing.T) {
+		cert, err := New(timeprovider.New())
+		require.NoError(t, err)
+		require.NoError(t, cert.SelfSign())
+
+		pemCert, _, err := cert.ToPEM()
+		require.NoError(t, err)
+
+		certStatus, err := GetStatus(pemCert)
+		require.NoError(t, err)
+		assert.Equal(t, cert.Cert.NotAfter.Unix(), certStatus.NotAfter.Unix())
+		assert.NotEmpty(t, certStatus.Hash)
+	})
+	t.Run("error for invalid certificate", func(t *testing.T) {
+		_, err := GetStatus(randomTestData)
+		require.Error(t, err)
+	})
+}
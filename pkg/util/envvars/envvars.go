@@ -18,3 +18,17 @@ func GetBool(varName string, defaultValue bool) bool {
 
 	return defaultValue
 }
+
+func GetInt(varName string, defaultValue int) int {
+	envValue := os.Getenv(varName)
+	if envValue != "" {
+		parsedValue, err := strconv.Atoi(envValue)
+		if err != nil {
+			return defaultValue
+		}
+
+		return parsedValue
+	}
+
+	return defaultValue
+}
@@ -31,3 +31,21 @@ func TestGetBool(t *testing.T) {
 		assert.False(t, GetBool(consts.HostAvailabilityDetectionEnvVar, false))
 	})
 }
+
+func TestGetInt(t *testing.T) {
+	t.Run("env var is set to a number and properly parsed", func(t *testing.T) {
+		t.Setenv(consts.NodeCacheShardsEnvVar, "4")
+
+		assert.Equal(t, 4, GetInt(consts.NodeCacheShardsEnvVar, 1))
+	})
+
+	t.Run("env var is set to dummy and properly parsed to default value", func(t *testing.T) {
+		t.Setenv(consts.NodeCacheShardsEnvVar, "dummy")
+
+		assert.Equal(t, 1, GetInt(consts.NodeCacheShardsEnvVar, 1))
+	})
+
+	t.Run("env var is NOT set and fallback to default", func(t *testing.T) {
+		assert.Equal(t, 1, GetInt(consts.NodeCacheShardsEnvVar, 1))
+	})
+}
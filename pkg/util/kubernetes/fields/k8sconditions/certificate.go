@@ -0,0 +1,20 @@
+package k8sconditions
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	CertificateNotReadyReason = "CertificateNotReady"
+)
+
+func SetCertificateNotReady(conditions *[]metav1.Condition, conditionType, name string) {
+	condition := metav1.Condition{
+		Type:    conditionType,
+		Status:  metav1.ConditionFalse,
+		Reason:  CertificateNotReadyReason,
+		Message: "Waiting for cert-manager to issue certificate " + name,
+	}
+	_ = meta.SetStatusCondition(conditions, condition)
+}
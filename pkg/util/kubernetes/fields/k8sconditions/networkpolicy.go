@@ -0,0 +1,22 @@
+package k8sconditions
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	NetworkPoliciesAppliedReason = "NetworkPoliciesApplied"
+)
+
+func SetNetworkPoliciesApplied(conditions *[]metav1.Condition, conditionType string, names []string) {
+	condition := metav1.Condition{
+		Type:    conditionType,
+		Status:  metav1.ConditionTrue,
+		Reason:  NetworkPoliciesAppliedReason,
+		Message: appendCreatedOrUpdatedSuffix(strings.Join(names, ", ")),
+	}
+	_ = meta.SetStatusCondition(conditions, condition)
+}
@@ -0,0 +1,55 @@
+package k8spodtemplate
+
+import (
+	"encoding/json"
+	"maps"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/podtemplate"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+)
+
+// ApplyOverride applies the override as a strategic merge patch on the pod template built by the operator.
+// The labels and annotations set by the operator take precedence, as the selectors and config hashes depend on them.
+func ApplyOverride(template *corev1.PodTemplateSpec, override *podtemplate.Override) error {
+	if override.IsEmpty() {
+		return nil
+	}
+
+	original, err := json.Marshal(template)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	patched, err := strategicpatch.StrategicMergePatch(original, override.Raw, corev1.PodTemplateSpec{})
+	if err != nil {
+		return errors.WithMessage(err, "failed to apply pod template override")
+	}
+
+	var result corev1.PodTemplateSpec
+	if err := json.Unmarshal(patched, &result); err != nil {
+		return errors.WithStack(err)
+	}
+
+	result.Labels = merge(result.Labels, template.Labels)
+	result.Annotations = merge(result.Annotations, template.Annotations)
+
+	*template = result
+
+	return nil
+}
+
+func merge(overridden, operator map[string]string) map[string]string {
+	if len(operator) == 0 {
+		return overridden
+	}
+
+	if overridden == nil {
+		overridden = make(map[string]string, len(operator))
+	}
+
+	maps.Copy(overridden, operator)
+
+	return overridden
+}
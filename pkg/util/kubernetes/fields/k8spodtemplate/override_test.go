@@ -0,0 +1,80 @@
+package k8spodtemplate
+
+import (
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/podtemplate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func createTemplate() *corev1.PodTemplateSpec {
+	return &corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels:      map[string]string{"app": "operator"},
+			Annotations: map[string]string{"hash": "123"},
+		},
+		Spec: corev1.PodSpec{
+			ServiceAccountName: "operator-sa",
+			Containers: []corev1.Container{
+				{
+					Name:  "main",
+					Image: "image:1",
+					Env:   []corev1.EnvVar{{Name: "A", Value: "a"}},
+				},
+				{
+					Name:  "sidecar",
+					Image: "image:2",
+				},
+			},
+		},
+	}
+}
+
+func createOverride(raw string) *podtemplate.Override {
+	return &podtemplate.Override{RawExtension: runtime.RawExtension{Raw: []byte(raw)}}
+}
+
+func TestApplyOverride(t *testing.T) {
+	t.Run("no override", func(t *testing.T) {
+		template := createTemplate()
+
+		require.NoError(t, ApplyOverride(template, nil))
+		require.NoError(t, ApplyOverride(template, &podtemplate.Override{}))
+		assert.Equal(t, createTemplate(), template)
+	})
+
+	t.Run("containers are merged by name", func(t *testing.T) {
+		template := createTemplate()
+		override := createOverride(`{"spec": {"priorityClassName": "high", "containers": [{"name": "main", "env": [{"name": "B", "value": "b"}]}]}}`)
+
+		require.NoError(t, ApplyOverride(template, override))
+
+		assert.Equal(t, "high", template.Spec.PriorityClassName)
+		assert.Equal(t, "operator-sa", template.Spec.ServiceAccountName)
+		require.Len(t, template.Spec.Containers, 2)
+		assert.Equal(t, "image:1", template.Spec.Containers[0].Image)
+		assert.ElementsMatch(t, []corev1.EnvVar{{Name: "A", Value: "a"}, {Name: "B", Value: "b"}}, template.Spec.Containers[0].Env)
+		assert.Equal(t, "image:2", template.Spec.Containers[1].Image)
+	})
+
+	t.Run("labels and annotations of the operator take precedence", func(t *testing.T) {
+		template := createTemplate()
+		override := createOverride(`{"metadata": {"labels": {"app": "custom", "team": "a"}, "annotations": {"hash": "0", "note": "b"}}}`)
+
+		require.NoError(t, ApplyOverride(template, override))
+
+		assert.Equal(t, map[string]string{"app": "operator", "team": "a"}, template.Labels)
+		assert.Equal(t, map[string]string{"hash": "123", "note": "b"}, template.Annotations)
+	})
+
+	t.Run("invalid override", func(t *testing.T) {
+		template := createTemplate()
+
+		require.Error(t, ApplyOverride(template, createOverride(`[]`)))
+		assert.Equal(t, createTemplate(), template)
+	})
+}
@@ -1,6 +1,8 @@
 package builder
 
 import (
+	"maps"
+
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -44,3 +46,10 @@ func SetLabels[T client.Object](labels map[string]string) func(T) {
 		o.SetResourceVersion("")
 	}
 }
+
+func SetAnnotations[T client.Object](annotations map[string]string) func(T) {
+	return func(o T) {
+		o.SetAnnotations(maps.Clone(annotations))
+		o.SetResourceVersion("")
+	}
+}
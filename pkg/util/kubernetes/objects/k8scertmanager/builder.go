@@ -0,0 +1,135 @@
+package k8scertmanager
+
+import (
+	"slices"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/certmanager"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/internal/builder"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// cert-manager is not a dependency of the operator, so its Certificates are handled as unstructured objects.
+var (
+	CertificateGVK     = schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"}
+	CertificateListGVK = CertificateGVK.GroupVersion().WithKind("CertificateList")
+)
+
+const (
+	// Keys of the secret issued by cert-manager, in addition to tls.crt and tls.key.
+	CADataName             = "ca.crt"
+	KeystorePKCS12DataName = "keystore.p12"
+
+	UsageServerAuth       = "server auth"
+	UsageDigitalSignature = "digital signature"
+	UsageKeyEncipherment  = "key encipherment"
+)
+
+type Option = builder.Option[*unstructured.Unstructured]
+
+var (
+	// Mandatory fields, provided in constructor as named params
+	setName      = builder.SetName[*unstructured.Unstructured]
+	setNamespace = builder.SetNamespace[*unstructured.Unstructured]
+
+	// Optional fields, provided in constructor as list of options
+	SetLabels = builder.SetLabels[*unstructured.Unstructured]
+)
+
+// Build creates a Certificate, which makes cert-manager issue a certificate signed by the referenced issuer into the secret with the given name.
+func Build(owner metav1.Object, name, secretName string, issuerRef certmanager.IssuerRef, options ...Option) (*unstructured.Unstructured, error) {
+	neededOpts := slices.Concat([]Option{
+		setName(name),
+		setNamespace(owner.GetNamespace()),
+		setSecretName(secretName),
+		setIssuerRef(issuerRef),
+	}, options)
+
+	return builder.Build(owner, newCertificate(), neededOpts...)
+}
+
+func newCertificate() *unstructured.Unstructured {
+	certificate := &unstructured.Unstructured{}
+	certificate.SetGroupVersionKind(CertificateGVK)
+
+	return certificate
+}
+
+func setSecretName(secretName string) Option {
+	return func(c *unstructured.Unstructured) {
+		setSpecField(c, secretName, "secretName")
+	}
+}
+
+func setIssuerRef(issuerRef certmanager.IssuerRef) Option {
+	return func(c *unstructured.Unstructured) {
+		setSpecField(c, map[string]any{
+			"name":  issuerRef.Name,
+			"kind":  issuerRef.GetKind(),
+			"group": issuerRef.GetGroup(),
+		}, "issuerRef")
+	}
+}
+
+func SetDNSNames(dnsNames []string) Option {
+	return func(c *unstructured.Unstructured) {
+		setSpecField(c, toSlice(dnsNames), "dnsNames")
+	}
+}
+
+func SetIPAddresses(ipAddresses []string) Option {
+	return func(c *unstructured.Unstructured) {
+		if len(ipAddresses) == 0 {
+			return
+		}
+
+		setSpecField(c, toSlice(ipAddresses), "ipAddresses")
+	}
+}
+
+func SetUsages(usages ...string) Option {
+	return func(c *unstructured.Unstructured) {
+		setSpecField(c, toSlice(usages), "usages")
+	}
+}
+
+// SetSecretLabels sets the labels cert-manager adds to the issued secret.
+func SetSecretLabels(labels map[string]string) Option {
+	return func(c *unstructured.Unstructured) {
+		secretLabels := make(map[string]any, len(labels))
+		for key, value := range labels {
+			secretLabels[key] = value
+		}
+
+		setSpecField(c, secretLabels, "secretTemplate", "labels")
+	}
+}
+
+// SetPKCS12Keystore makes cert-manager additionally store the certificate and key as PKCS12 keystore under keystore.p12,
+// encrypted with the password found in the given secret.
+func SetPKCS12Keystore(passwordSecretName, passwordKey string) Option {
+	return func(c *unstructured.Unstructured) {
+		setSpecField(c, map[string]any{
+			"create": true,
+			"passwordSecretRef": map[string]any{
+				"name": passwordSecretName,
+				"key":  passwordKey,
+			},
+		}, "keystores", "pkcs12")
+	}
+}
+
+// The fields are always set on a freshly built object, so setting them can't run into a non-map field.
+func setSpecField(c *unstructured.Unstructured, value any, fields ...string) {
+	_ = unstructured.SetNestedField(c.Object, value, append([]string{"spec"}, fields...)...)
+}
+
+func toSlice(values []string) []any {
+	out := make([]any, len(values))
+	for i, value := range values {
+		out[i] = value
+	}
+
+	return out
+}
@@ -0,0 +1,74 @@
+package k8scertmanager
+
+import (
+	"context"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/logd"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/hasher"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/internal/query"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const errorCertManagerNotInstalled = "cert-manager is not installed, the Certificate CRD is missing"
+
+type QueryObject = query.Generic[*unstructured.Unstructured, *unstructured.UnstructuredList]
+
+func Query(kubeClient client.Client, kubeReader client.Reader, log logd.Logger) QueryObject {
+	listTarget := &unstructured.UnstructuredList{}
+	listTarget.SetGroupVersionKind(CertificateListGVK)
+
+	return query.Generic[*unstructured.Unstructured, *unstructured.UnstructuredList]{
+		Target:     newCertificate(),
+		ListTarget: listTarget,
+		ToList: func(list *unstructured.UnstructuredList) []*unstructured.Unstructured {
+			out := make([]*unstructured.Unstructured, len(list.Items))
+			for i, item := range list.Items {
+				out[i] = &item
+			}
+
+			return out
+		},
+		IsEqual:      isEqual,
+		MustRecreate: mustRecreate,
+
+		KubeClient: kubeClient,
+		KubeReader: kubeReader,
+		Log:        log,
+	}
+}
+
+// CreateOrUpdate creates or updates the desired Certificate, a missing cert-manager installation is reported with a readable error.
+func CreateOrUpdate(ctx context.Context, query QueryObject, desired *unstructured.Unstructured) error {
+	_, err := query.CreateOrUpdate(ctx, desired)
+	if meta.IsNoMatchError(err) {
+		return errors.WithMessage(err, errorCertManagerNotInstalled)
+	}
+
+	return err
+}
+
+// Delete removes the Certificate with the given name, it's a no-op if it or cert-manager itself is not present.
+func Delete(ctx context.Context, query QueryObject, name, namespace string) error {
+	certificate := newCertificate()
+	certificate.SetName(name)
+	certificate.SetNamespace(namespace)
+
+	err := query.Delete(ctx, certificate)
+	if meta.IsNoMatchError(err) {
+		return nil
+	}
+
+	return err
+}
+
+func isEqual(current, desired *unstructured.Unstructured) bool {
+	return !hasher.IsAnnotationDifferent(current, desired)
+}
+
+// The spec of a Certificate is mutable, cert-manager reissues the certificate on changes.
+func mustRecreate(_, _ *unstructured.Unstructured) bool {
+	return false
+}
@@ -0,0 +1,147 @@
+package k8scertmanager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/certmanager"
+	"github.com/Dynatrace/dynatrace-operator/pkg/logd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+const (
+	testOwnerName       = "owner-of-certificate"
+	testCertificateName = "test-certificate"
+	testSecretName      = "test-certificate-tls"
+	testNamespace       = "test-namespace"
+)
+
+var certificateLog = logd.Get().WithName("test-certificate")
+
+func createOwner() *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testOwnerName,
+			Namespace: testNamespace,
+		},
+	}
+}
+
+func TestBuild(t *testing.T) {
+	t.Run("minimal certificate", func(t *testing.T) {
+		certificate, err := Build(createOwner(), testCertificateName, testSecretName, certmanager.IssuerRef{Name: "issuer"})
+		require.NoError(t, err)
+		require.Len(t, certificate.GetOwnerReferences(), 1)
+		assert.Equal(t, testOwnerName, certificate.GetOwnerReferences()[0].Name)
+		assert.Equal(t, CertificateGVK, certificate.GroupVersionKind())
+		assert.Equal(t, testNamespace, certificate.GetNamespace())
+
+		secretName, _, _ := unstructured.NestedString(certificate.Object, "spec", "secretName")
+		assert.Equal(t, testSecretName, secretName)
+
+		issuerRef, _, _ := unstructured.NestedStringMap(certificate.Object, "spec", "issuerRef")
+		assert.Equal(t, map[string]string{"name": "issuer", "kind": certmanager.DefaultIssuerKind, "group": certmanager.DefaultIssuerGroup}, issuerRef)
+
+		_, found, _ := unstructured.NestedFieldNoCopy(certificate.Object, "spec", "ipAddresses")
+		assert.False(t, found)
+	})
+	t.Run("all options", func(t *testing.T) {
+		certificate, err := Build(createOwner(), testCertificateName, testSecretName, certmanager.IssuerRef{Name: "issuer", Kind: "ClusterIssuer"},
+			SetDNSNames([]string{"a.test", "b.test"}),
+			SetIPAddresses([]string{"10.0.0.1"}),
+			SetUsages(UsageServerAuth),
+			SetSecretLabels(map[string]string{"app": "test"}),
+			SetPKCS12Keystore("password-secret", "password"),
+		)
+		require.NoError(t, err)
+
+		dnsNames, _, _ := unstructured.NestedStringSlice(certificate.Object, "spec", "dnsNames")
+		assert.Equal(t, []string{"a.test", "b.test"}, dnsNames)
+
+		ipAddresses, _, _ := unstructured.NestedStringSlice(certificate.Object, "spec", "ipAddresses")
+		assert.Equal(t, []string{"10.0.0.1"}, ipAddresses)
+
+		usages, _, _ := unstructured.NestedStringSlice(certificate.Object, "spec", "usages")
+		assert.Equal(t, []string{UsageServerAuth}, usages)
+
+		secretLabels, _, _ := unstructured.NestedStringMap(certificate.Object, "spec", "secretTemplate", "labels")
+		assert.Equal(t, map[string]string{"app": "test"}, secretLabels)
+
+		create, _, _ := unstructured.NestedBool(certificate.Object, "spec", "keystores", "pkcs12", "create")
+		assert.True(t, create)
+
+		passwordRef, _, _ := unstructured.NestedStringMap(certificate.Object, "spec", "keystores", "pkcs12", "passwordSecretRef")
+		assert.Equal(t, map[string]string{"name": "password-secret", "key": "password"}, passwordRef)
+	})
+}
+
+func TestCreateOrUpdate(t *testing.T) {
+	t.Run("create and update", func(t *testing.T) {
+		clt := fake.NewClient()
+
+		desired, err := Build(createOwner(), testCertificateName, testSecretName, certmanager.IssuerRef{Name: "issuer"})
+		require.NoError(t, err)
+		require.NoError(t, CreateOrUpdate(t.Context(), Query(clt, clt, certificateLog), desired))
+
+		desired, err = Build(createOwner(), testCertificateName, testSecretName, certmanager.IssuerRef{Name: "other-issuer"})
+		require.NoError(t, err)
+		require.NoError(t, CreateOrUpdate(t.Context(), Query(clt, clt, certificateLog), desired))
+
+		current := newCertificate()
+		require.NoError(t, clt.Get(t.Context(), client.ObjectKey{Name: testCertificateName, Namespace: testNamespace}, current))
+
+		issuerName, _, _ := unstructured.NestedString(current.Object, "spec", "issuerRef", "name")
+		assert.Equal(t, "other-issuer", issuerName)
+	})
+	t.Run("cert-manager not installed", func(t *testing.T) {
+		clt := fake.NewClientWithInterceptors(interceptor.Funcs{
+			Get: func(_ context.Context, _ client.WithWatch, _ client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
+				return &meta.NoKindMatchError{GroupKind: CertificateGVK.GroupKind()}
+			},
+		})
+
+		desired, err := Build(createOwner(), testCertificateName, testSecretName, certmanager.IssuerRef{Name: "issuer"})
+		require.NoError(t, err)
+
+		err = CreateOrUpdate(t.Context(), Query(clt, clt, certificateLog), desired)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errorCertManagerNotInstalled)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("delete existing", func(t *testing.T) {
+		clt := fake.NewClient()
+		desired, err := Build(createOwner(), testCertificateName, testSecretName, certmanager.IssuerRef{Name: "issuer"})
+		require.NoError(t, err)
+		require.NoError(t, CreateOrUpdate(t.Context(), Query(clt, clt, certificateLog), desired))
+
+		require.NoError(t, Delete(t.Context(), Query(clt, clt, certificateLog), testCertificateName, testNamespace))
+
+		err = clt.Get(t.Context(), client.ObjectKey{Name: testCertificateName, Namespace: testNamespace}, newCertificate())
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+	t.Run("nothing to delete", func(t *testing.T) {
+		clt := fake.NewClient()
+
+		require.NoError(t, Delete(t.Context(), Query(clt, clt, certificateLog), testCertificateName, testNamespace))
+	})
+	t.Run("cert-manager not installed", func(t *testing.T) {
+		clt := fake.NewClientWithInterceptors(interceptor.Funcs{
+			Delete: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.DeleteOption) error {
+				return &meta.NoKindMatchError{GroupKind: CertificateGVK.GroupKind()}
+			},
+		})
+
+		require.NoError(t, Delete(t.Context(), Query(clt, clt, certificateLog), testCertificateName, testNamespace))
+	})
+}
@@ -0,0 +1,181 @@
+package k8sciliumpolicy
+
+import (
+	"slices"
+	"strconv"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/internal/builder"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Cilium is not a dependency of the operator, so its CiliumNetworkPolicies are handled as unstructured objects.
+var (
+	CiliumNetworkPolicyGVK     = schema.GroupVersionKind{Group: "cilium.io", Version: "v2", Kind: "CiliumNetworkPolicy"}
+	CiliumNetworkPolicyListGVK = CiliumNetworkPolicyGVK.GroupVersion().WithKind("CiliumNetworkPolicyList")
+)
+
+const (
+	ProtocolTCP = "TCP"
+	ProtocolUDP = "UDP"
+	ProtocolAny = "ANY"
+
+	EntityKubeAPIServer = "kube-apiserver"
+
+	dnsPort = 53
+)
+
+type Option = builder.Option[*unstructured.Unstructured]
+
+var (
+	// Mandatory fields, provided in constructor as named params
+	setName      = builder.SetName[*unstructured.Unstructured]
+	setNamespace = builder.SetNamespace[*unstructured.Unstructured]
+
+	// Optional fields, provided in constructor as list of options
+	SetLabels = builder.SetLabels[*unstructured.Unstructured]
+)
+
+type Port struct {
+	Protocol string
+	Port     uint32
+}
+
+// EgressRule allows the egress to the listed peers on the given ports, all ports are allowed if none are given.
+type EgressRule struct {
+	ToFQDNs     []string
+	ToCIDRs     []string
+	ToEntities  []string
+	ToEndpoints []map[string]string
+	Ports       []Port
+
+	// DNSInspection makes Cilium proxy the DNS requests, which is needed to resolve the ToFQDNs of the policy.
+	DNSInspection bool
+}
+
+// NewDNSProxyRule allows the DNS requests to kube-dns and makes Cilium inspect them, so that the ToFQDNs rules can be enforced.
+func NewDNSProxyRule() EgressRule {
+	return EgressRule{
+		ToEndpoints: []map[string]string{{
+			"k8s:io.kubernetes.pod.namespace": "kube-system",
+			"k8s:k8s-app":                     "kube-dns",
+		}},
+		Ports:         []Port{{Protocol: ProtocolAny, Port: dnsPort}},
+		DNSInspection: true,
+	}
+}
+
+// Build creates a CiliumNetworkPolicy for the pods matching the labels.
+func Build(owner metav1.Object, name string, matchLabels map[string]string, options ...Option) (*unstructured.Unstructured, error) {
+	neededOpts := slices.Concat([]Option{
+		setName(name),
+		setNamespace(owner.GetNamespace()),
+		setEndpointSelector(matchLabels),
+	}, options)
+
+	return builder.Build(owner, newCiliumNetworkPolicy(), neededOpts...)
+}
+
+func newCiliumNetworkPolicy() *unstructured.Unstructured {
+	policy := &unstructured.Unstructured{}
+	policy.SetGroupVersionKind(CiliumNetworkPolicyGVK)
+
+	return policy
+}
+
+func setEndpointSelector(matchLabels map[string]string) Option {
+	return func(p *unstructured.Unstructured) {
+		setSpecField(p, map[string]any{"matchLabels": toStringMap(matchLabels)}, "endpointSelector")
+	}
+}
+
+func SetEgress(rules ...EgressRule) Option {
+	return func(p *unstructured.Unstructured) {
+		egress := make([]any, len(rules))
+		for i, rule := range rules {
+			egress[i] = rule.toMap()
+		}
+
+		setSpecField(p, egress, "egress")
+	}
+}
+
+func (rule EgressRule) toMap() map[string]any {
+	out := map[string]any{}
+
+	if len(rule.ToFQDNs) > 0 {
+		fqdns := make([]any, len(rule.ToFQDNs))
+		for i, fqdn := range rule.ToFQDNs {
+			fqdns[i] = map[string]any{"matchName": fqdn}
+		}
+
+		out["toFQDNs"] = fqdns
+	}
+
+	if len(rule.ToCIDRs) > 0 {
+		out["toCIDR"] = toSlice(rule.ToCIDRs)
+	}
+
+	if len(rule.ToEntities) > 0 {
+		out["toEntities"] = toSlice(rule.ToEntities)
+	}
+
+	if len(rule.ToEndpoints) > 0 {
+		endpoints := make([]any, len(rule.ToEndpoints))
+		for i, matchLabels := range rule.ToEndpoints {
+			endpoints[i] = map[string]any{"matchLabels": toStringMap(matchLabels)}
+		}
+
+		out["toEndpoints"] = endpoints
+	}
+
+	if len(rule.Ports) > 0 {
+		out["toPorts"] = []any{rule.toPortRule()}
+	}
+
+	return out
+}
+
+func (rule EgressRule) toPortRule() map[string]any {
+	ports := make([]any, len(rule.Ports))
+	for i, port := range rule.Ports {
+		ports[i] = map[string]any{
+			"port":     strconv.FormatUint(uint64(port.Port), 10),
+			"protocol": port.Protocol,
+		}
+	}
+
+	portRule := map[string]any{"ports": ports}
+
+	if rule.DNSInspection {
+		portRule["rules"] = map[string]any{
+			"dns": []any{map[string]any{"matchPattern": "*"}},
+		}
+	}
+
+	return portRule
+}
+
+// The fields are always set on a freshly built object, so setting them can't run into a non-map field.
+func setSpecField(p *unstructured.Unstructured, value any, fields ...string) {
+	_ = unstructured.SetNestedField(p.Object, value, append([]string{"spec"}, fields...)...)
+}
+
+func toSlice(values []string) []any {
+	out := make([]any, len(values))
+	for i, value := range values {
+		out[i] = value
+	}
+
+	return out
+}
+
+func toStringMap(values map[string]string) map[string]any {
+	out := make(map[string]any, len(values))
+	for key, value := range values {
+		out[key] = value
+	}
+
+	return out
+}
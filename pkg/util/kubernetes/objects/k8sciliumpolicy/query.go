@@ -0,0 +1,79 @@
+package k8sciliumpolicy
+
+import (
+	"context"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/logd"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/hasher"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/internal/query"
+	"github.com/pkg/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const errorCiliumNotInstalled = "cilium is not installed, the CiliumNetworkPolicy CRD is missing"
+
+type QueryObject = query.Generic[*unstructured.Unstructured, *unstructured.UnstructuredList]
+
+func Query(kubeClient client.Client, kubeReader client.Reader, log logd.Logger) QueryObject {
+	listTarget := &unstructured.UnstructuredList{}
+	listTarget.SetGroupVersionKind(CiliumNetworkPolicyListGVK)
+
+	return query.Generic[*unstructured.Unstructured, *unstructured.UnstructuredList]{
+		Target:     newCiliumNetworkPolicy(),
+		ListTarget: listTarget,
+		ToList: func(list *unstructured.UnstructuredList) []*unstructured.Unstructured {
+			out := make([]*unstructured.Unstructured, len(list.Items))
+			for i, item := range list.Items {
+				out[i] = &item
+			}
+
+			return out
+		},
+		IsEqual:      isEqual,
+		MustRecreate: mustRecreate,
+
+		KubeClient: kubeClient,
+		KubeReader: kubeReader,
+		Log:        log,
+	}
+}
+
+// CreateOrUpdate creates or updates the desired CiliumNetworkPolicy, a missing Cilium installation is reported with a readable error.
+func CreateOrUpdate(ctx context.Context, query QueryObject, desired *unstructured.Unstructured) error {
+	_, err := query.CreateOrUpdate(ctx, desired)
+	if meta.IsNoMatchError(err) {
+		return errors.WithMessage(err, errorCiliumNotInstalled)
+	}
+
+	return err
+}
+
+// Delete removes the CiliumNetworkPolicy with the given name, it's a no-op if it or Cilium itself is not present.
+func Delete(ctx context.Context, query QueryObject, name, namespace string) error {
+	policy, err := query.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace})
+	if k8serrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	err = query.Delete(ctx, policy)
+	if meta.IsNoMatchError(err) {
+		return nil
+	}
+
+	return err
+}
+
+func isEqual(current, desired *unstructured.Unstructured) bool {
+	return !hasher.IsAnnotationDifferent(current, desired)
+}
+
+// The spec of a CiliumNetworkPolicy is mutable, so it never needs to be recreated.
+func mustRecreate(_, _ *unstructured.Unstructured) bool {
+	return false
+}
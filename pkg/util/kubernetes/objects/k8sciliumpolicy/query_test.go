@@ -0,0 +1,137 @@
+package k8sciliumpolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
+	"github.com/Dynatrace/dynatrace-operator/pkg/logd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+const (
+	testOwnerName  = "owner-of-cilium-policy"
+	testPolicyName = "test-cilium-policy"
+	testNamespace  = "test-namespace"
+)
+
+var ciliumPolicyLog = logd.Get().WithName("test-cilium-policy")
+
+func createOwner() *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testOwnerName,
+			Namespace: testNamespace,
+		},
+	}
+}
+
+func TestBuild(t *testing.T) {
+	matchLabels := map[string]string{"app": "test"}
+
+	t.Run("minimal policy", func(t *testing.T) {
+		policy, err := Build(createOwner(), testPolicyName, matchLabels)
+		require.NoError(t, err)
+		require.Len(t, policy.GetOwnerReferences(), 1)
+		assert.Equal(t, testOwnerName, policy.GetOwnerReferences()[0].Name)
+		assert.Equal(t, CiliumNetworkPolicyGVK, policy.GroupVersionKind())
+		assert.Equal(t, testNamespace, policy.GetNamespace())
+
+		selector, _, _ := unstructured.NestedStringMap(policy.Object, "spec", "endpointSelector", "matchLabels")
+		assert.Equal(t, matchLabels, selector)
+
+		_, found, _ := unstructured.NestedFieldNoCopy(policy.Object, "spec", "egress")
+		assert.False(t, found)
+	})
+	t.Run("egress rules", func(t *testing.T) {
+		policy, err := Build(createOwner(), testPolicyName, matchLabels, SetEgress(
+			NewDNSProxyRule(),
+			EgressRule{ToFQDNs: []string{"tenant.test"}, Ports: []Port{{Protocol: ProtocolTCP, Port: 443}}},
+			EgressRule{ToEntities: []string{EntityKubeAPIServer}},
+		))
+		require.NoError(t, err)
+
+		egress, _, _ := unstructured.NestedSlice(policy.Object, "spec", "egress")
+		require.Len(t, egress, 3)
+
+		assert.Equal(t, map[string]any{
+			"toEndpoints": []any{map[string]any{"matchLabels": map[string]any{
+				"k8s:io.kubernetes.pod.namespace": "kube-system",
+				"k8s:k8s-app":                     "kube-dns",
+			}}},
+			"toPorts": []any{map[string]any{
+				"ports": []any{map[string]any{"port": "53", "protocol": ProtocolAny}},
+				"rules": map[string]any{"dns": []any{map[string]any{"matchPattern": "*"}}},
+			}},
+		}, egress[0])
+		assert.Equal(t, map[string]any{
+			"toFQDNs": []any{map[string]any{"matchName": "tenant.test"}},
+			"toPorts": []any{map[string]any{
+				"ports": []any{map[string]any{"port": "443", "protocol": ProtocolTCP}},
+			}},
+		}, egress[1])
+		assert.Equal(t, map[string]any{"toEntities": []any{EntityKubeAPIServer}}, egress[2])
+	})
+}
+
+func TestCreateOrUpdate(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		clt := fake.NewClient()
+
+		desired, err := Build(createOwner(), testPolicyName, map[string]string{"app": "test"})
+		require.NoError(t, err)
+		require.NoError(t, CreateOrUpdate(t.Context(), Query(clt, clt, ciliumPolicyLog), desired))
+
+		require.NoError(t, clt.Get(t.Context(), client.ObjectKey{Name: testPolicyName, Namespace: testNamespace}, newCiliumNetworkPolicy()))
+	})
+	t.Run("cilium not installed", func(t *testing.T) {
+		clt := fake.NewClientWithInterceptors(interceptor.Funcs{
+			Get: func(_ context.Context, _ client.WithWatch, _ client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
+				return &meta.NoKindMatchError{GroupKind: CiliumNetworkPolicyGVK.GroupKind()}
+			},
+		})
+
+		desired, err := Build(createOwner(), testPolicyName, map[string]string{"app": "test"})
+		require.NoError(t, err)
+
+		err = CreateOrUpdate(t.Context(), Query(clt, clt, ciliumPolicyLog), desired)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errorCiliumNotInstalled)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("delete existing", func(t *testing.T) {
+		clt := fake.NewClient()
+		desired, err := Build(createOwner(), testPolicyName, map[string]string{"app": "test"})
+		require.NoError(t, err)
+		require.NoError(t, CreateOrUpdate(t.Context(), Query(clt, clt, ciliumPolicyLog), desired))
+
+		require.NoError(t, Delete(t.Context(), Query(clt, clt, ciliumPolicyLog), testPolicyName, testNamespace))
+
+		err = clt.Get(t.Context(), client.ObjectKey{Name: testPolicyName, Namespace: testNamespace}, newCiliumNetworkPolicy())
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+	t.Run("nothing to delete", func(t *testing.T) {
+		clt := fake.NewClient()
+
+		require.NoError(t, Delete(t.Context(), Query(clt, clt, ciliumPolicyLog), testPolicyName, testNamespace))
+	})
+	t.Run("cilium not installed", func(t *testing.T) {
+		clt := fake.NewClientWithInterceptors(interceptor.Funcs{
+			Get: func(_ context.Context, _ client.WithWatch, _ client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
+				return &meta.NoKindMatchError{GroupKind: CiliumNetworkPolicyGVK.GroupKind()}
+			},
+		})
+
+		require.NoError(t, Delete(t.Context(), Query(clt, clt, ciliumPolicyLog), testPolicyName, testNamespace))
+	})
+}
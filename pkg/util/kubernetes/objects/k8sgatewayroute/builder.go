@@ -0,0 +1,110 @@
+package k8sgatewayroute
+
+import (
+	"slices"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/internal/builder"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// The Gateway API is not a dependency of the operator, so its routes are handled as unstructured objects.
+// TLSRoutes are only part of the experimental channel, which serves them as v1alpha2.
+var (
+	HTTPRouteGVK = schema.GroupVersionKind{Group: "gateway.networking.k8s.io", Version: "v1", Kind: "HTTPRoute"}
+	TLSRouteGVK  = schema.GroupVersionKind{Group: "gateway.networking.k8s.io", Version: "v1alpha2", Kind: "TLSRoute"}
+)
+
+// ParentRef references a Gateway, or one of its listeners, the route attaches to.
+type ParentRef struct {
+	Name        string
+	Namespace   string
+	SectionName string
+}
+
+type Option = builder.Option[*unstructured.Unstructured]
+
+var (
+	// Mandatory fields, provided in constructor as named params
+	setName      = builder.SetName[*unstructured.Unstructured]
+	setNamespace = builder.SetNamespace[*unstructured.Unstructured]
+
+	// Optional fields, provided in constructor as list of options
+	SetLabels      = builder.SetLabels[*unstructured.Unstructured]
+	SetAnnotations = builder.SetAnnotations[*unstructured.Unstructured]
+)
+
+// Build creates a route of the given kind, which forwards all traffic from the referenced Gateways to the given Service port.
+func Build(owner metav1.Object, gvk schema.GroupVersionKind, name string, parentRefs []ParentRef, options ...Option) (*unstructured.Unstructured, error) {
+	neededOpts := slices.Concat([]Option{
+		setName(name),
+		setNamespace(owner.GetNamespace()),
+		setParentRefs(parentRefs),
+	}, options)
+
+	return builder.Build(owner, newRoute(gvk), neededOpts...)
+}
+
+func newRoute(gvk schema.GroupVersionKind) *unstructured.Unstructured {
+	route := &unstructured.Unstructured{}
+	route.SetGroupVersionKind(gvk)
+
+	return route
+}
+
+func setParentRefs(parentRefs []ParentRef) Option {
+	return func(r *unstructured.Unstructured) {
+		refs := make([]any, len(parentRefs))
+		for i, parentRef := range parentRefs {
+			ref := map[string]any{"name": parentRef.Name}
+			if parentRef.Namespace != "" {
+				ref["namespace"] = parentRef.Namespace
+			}
+
+			if parentRef.SectionName != "" {
+				ref["sectionName"] = parentRef.SectionName
+			}
+
+			refs[i] = ref
+		}
+
+		setSpecField(r, refs, "parentRefs")
+	}
+}
+
+func SetHostnames(hostnames []string) Option {
+	return func(r *unstructured.Unstructured) {
+		if len(hostnames) == 0 {
+			return
+		}
+
+		hosts := make([]any, len(hostnames))
+		for i, hostname := range hostnames {
+			hosts[i] = hostname
+		}
+
+		setSpecField(r, hosts, "hostnames")
+	}
+}
+
+// SetBackend adds a single rule to the route, which forwards all traffic to the given Service port.
+func SetBackend(serviceName string, port int32) Option {
+	return func(r *unstructured.Unstructured) {
+		setSpecField(r, []any{
+			map[string]any{
+				"backendRefs": []any{
+					map[string]any{
+						"name": serviceName,
+						"port": int64(port),
+					},
+				},
+			},
+		}, "rules")
+	}
+}
+
+// The fields are always set on a freshly built object, so setting them can't run into a non-map field.
+func setSpecField(r *unstructured.Unstructured, value any, fields ...string) {
+	_ = unstructured.SetNestedField(r.Object, value, append([]string{"spec"}, fields...)...)
+}
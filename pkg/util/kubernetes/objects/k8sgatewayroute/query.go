@@ -0,0 +1,76 @@
+package k8sgatewayroute
+
+import (
+	"context"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/logd"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/hasher"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/internal/query"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const errorGatewayAPINotInstalled = "the Gateway API is not installed, the %s CRD is missing"
+
+type QueryObject = query.Generic[*unstructured.Unstructured, *unstructured.UnstructuredList]
+
+// Query provides a query for the routes of the given kind.
+func Query(gvk schema.GroupVersionKind, kubeClient client.Client, kubeReader client.Reader, log logd.Logger) QueryObject {
+	listTarget := &unstructured.UnstructuredList{}
+	listTarget.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+
+	return query.Generic[*unstructured.Unstructured, *unstructured.UnstructuredList]{
+		Target:     newRoute(gvk),
+		ListTarget: listTarget,
+		ToList: func(list *unstructured.UnstructuredList) []*unstructured.Unstructured {
+			out := make([]*unstructured.Unstructured, len(list.Items))
+			for i, item := range list.Items {
+				out[i] = &item
+			}
+
+			return out
+		},
+		IsEqual:      isEqual,
+		MustRecreate: mustRecreate,
+
+		KubeClient: kubeClient,
+		KubeReader: kubeReader,
+		Log:        log,
+	}
+}
+
+// CreateOrUpdate creates or updates the desired route, a missing Gateway API installation is reported with a readable error.
+func CreateOrUpdate(ctx context.Context, query QueryObject, desired *unstructured.Unstructured) error {
+	_, err := query.CreateOrUpdate(ctx, desired)
+	if meta.IsNoMatchError(err) {
+		return errors.WithMessagef(err, errorGatewayAPINotInstalled, desired.GetKind())
+	}
+
+	return err
+}
+
+// Delete removes the route with the given name, it's a no-op if it or the Gateway API itself is not present.
+func Delete(ctx context.Context, query QueryObject, name, namespace string) error {
+	route := newRoute(query.Target.GroupVersionKind())
+	route.SetName(name)
+	route.SetNamespace(namespace)
+
+	err := query.Delete(ctx, route)
+	if meta.IsNoMatchError(err) {
+		return nil
+	}
+
+	return err
+}
+
+func isEqual(current, desired *unstructured.Unstructured) bool {
+	return !hasher.IsAnnotationDifferent(current, desired)
+}
+
+// The spec of a route is mutable, so it never needs to be recreated.
+func mustRecreate(_, _ *unstructured.Unstructured) bool {
+	return false
+}
@@ -0,0 +1,128 @@
+package k8sgatewayroute
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
+	"github.com/Dynatrace/dynatrace-operator/pkg/logd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+const (
+	testOwnerName = "owner-of-route"
+	testRouteName = "test-route"
+	testNamespace = "test-namespace"
+)
+
+var routeLog = logd.Get().WithName("test-route")
+
+func createOwner() *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testOwnerName,
+			Namespace: testNamespace,
+		},
+	}
+}
+
+func TestBuild(t *testing.T) {
+	t.Run("minimal route", func(t *testing.T) {
+		route, err := Build(createOwner(), HTTPRouteGVK, testRouteName, []ParentRef{{Name: "gateway"}})
+		require.NoError(t, err)
+		require.Len(t, route.GetOwnerReferences(), 1)
+		assert.Equal(t, testOwnerName, route.GetOwnerReferences()[0].Name)
+		assert.Equal(t, HTTPRouteGVK, route.GroupVersionKind())
+		assert.Equal(t, testNamespace, route.GetNamespace())
+
+		parentRefs, _, _ := unstructured.NestedSlice(route.Object, "spec", "parentRefs")
+		assert.Equal(t, []any{map[string]any{"name": "gateway"}}, parentRefs)
+
+		_, found, _ := unstructured.NestedFieldNoCopy(route.Object, "spec", "hostnames")
+		assert.False(t, found)
+	})
+	t.Run("all options", func(t *testing.T) {
+		route, err := Build(createOwner(), TLSRouteGVK, testRouteName, []ParentRef{{Name: "gateway", Namespace: "infra", SectionName: "tls"}},
+			SetHostnames([]string{"ag.example.com"}),
+			SetBackend("test-service", 443),
+			SetAnnotations(map[string]string{"a": "b"}),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, TLSRouteGVK, route.GroupVersionKind())
+		assert.Equal(t, map[string]string{"a": "b"}, route.GetAnnotations())
+
+		parentRefs, _, _ := unstructured.NestedSlice(route.Object, "spec", "parentRefs")
+		assert.Equal(t, []any{map[string]any{"name": "gateway", "namespace": "infra", "sectionName": "tls"}}, parentRefs)
+
+		hostnames, _, _ := unstructured.NestedStringSlice(route.Object, "spec", "hostnames")
+		assert.Equal(t, []string{"ag.example.com"}, hostnames)
+
+		rules, _, _ := unstructured.NestedSlice(route.Object, "spec", "rules")
+		assert.Equal(t, []any{map[string]any{"backendRefs": []any{map[string]any{"name": "test-service", "port": int64(443)}}}}, rules)
+	})
+}
+
+func TestCreateOrUpdate(t *testing.T) {
+	t.Run("create and update", func(t *testing.T) {
+		clt := fake.NewClient()
+
+		desired, err := Build(createOwner(), HTTPRouteGVK, testRouteName, []ParentRef{{Name: "gateway"}})
+		require.NoError(t, err)
+		require.NoError(t, CreateOrUpdate(t.Context(), Query(HTTPRouteGVK, clt, clt, routeLog), desired))
+
+		desired, err = Build(createOwner(), HTTPRouteGVK, testRouteName, []ParentRef{{Name: "other-gateway"}})
+		require.NoError(t, err)
+		require.NoError(t, CreateOrUpdate(t.Context(), Query(HTTPRouteGVK, clt, clt, routeLog), desired))
+
+		current := newRoute(HTTPRouteGVK)
+		require.NoError(t, clt.Get(t.Context(), client.ObjectKey{Name: testRouteName, Namespace: testNamespace}, current))
+
+		parentRefs, _, _ := unstructured.NestedSlice(current.Object, "spec", "parentRefs")
+		assert.Equal(t, []any{map[string]any{"name": "other-gateway"}}, parentRefs)
+	})
+	t.Run("Gateway API not installed", func(t *testing.T) {
+		clt := fake.NewClientWithInterceptors(interceptor.Funcs{
+			Get: func(_ context.Context, _ client.WithWatch, _ client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
+				return &meta.NoKindMatchError{GroupKind: TLSRouteGVK.GroupKind()}
+			},
+		})
+
+		desired, err := Build(createOwner(), TLSRouteGVK, testRouteName, []ParentRef{{Name: "gateway"}})
+		require.NoError(t, err)
+
+		err = CreateOrUpdate(t.Context(), Query(TLSRouteGVK, clt, clt, routeLog), desired)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "the TLSRoute CRD is missing")
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("delete existing", func(t *testing.T) {
+		clt := fake.NewClient()
+		desired, err := Build(createOwner(), TLSRouteGVK, testRouteName, []ParentRef{{Name: "gateway"}})
+		require.NoError(t, err)
+		require.NoError(t, CreateOrUpdate(t.Context(), Query(TLSRouteGVK, clt, clt, routeLog), desired))
+
+		require.NoError(t, Delete(t.Context(), Query(TLSRouteGVK, clt, clt, routeLog), testRouteName, testNamespace))
+
+		err = clt.Get(t.Context(), client.ObjectKey{Name: testRouteName, Namespace: testNamespace}, newRoute(TLSRouteGVK))
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+	t.Run("Gateway API not installed", func(t *testing.T) {
+		clt := fake.NewClientWithInterceptors(interceptor.Funcs{
+			Delete: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.DeleteOption) error {
+				return &meta.NoKindMatchError{GroupKind: HTTPRouteGVK.GroupKind()}
+			},
+		})
+
+		require.NoError(t, Delete(t.Context(), Query(HTTPRouteGVK, clt, clt, routeLog), testRouteName, testNamespace))
+	})
+}
@@ -0,0 +1,59 @@
+package k8shpa
+
+import (
+	"slices"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/internal/builder"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	// Mandatory fields, provided in constructor as named params
+	setName      = builder.SetName[*autoscalingv2.HorizontalPodAutoscaler]
+	setNamespace = builder.SetNamespace[*autoscalingv2.HorizontalPodAutoscaler]
+
+	// Optional fields, provided in constructor as list of options
+	SetLabels = builder.SetLabels[*autoscalingv2.HorizontalPodAutoscaler]
+)
+
+func Build(owner metav1.Object, name string, target autoscalingv2.CrossVersionObjectReference, maxReplicas int32, options ...builder.Option[*autoscalingv2.HorizontalPodAutoscaler]) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	neededOpts := slices.Concat([]builder.Option[*autoscalingv2.HorizontalPodAutoscaler]{
+		setName(name),
+		setScaleTarget(target),
+		setMaxReplicas(maxReplicas),
+		setNamespace(owner.GetNamespace()),
+	}, options)
+
+	return builder.Build(owner, &autoscalingv2.HorizontalPodAutoscaler{}, neededOpts...)
+}
+
+func setScaleTarget(target autoscalingv2.CrossVersionObjectReference) builder.Option[*autoscalingv2.HorizontalPodAutoscaler] {
+	return func(h *autoscalingv2.HorizontalPodAutoscaler) {
+		h.Spec.ScaleTargetRef = target
+	}
+}
+
+func setMaxReplicas(maxReplicas int32) builder.Option[*autoscalingv2.HorizontalPodAutoscaler] {
+	return func(h *autoscalingv2.HorizontalPodAutoscaler) {
+		h.Spec.MaxReplicas = maxReplicas
+	}
+}
+
+func SetMinReplicas(minReplicas *int32) builder.Option[*autoscalingv2.HorizontalPodAutoscaler] {
+	return func(h *autoscalingv2.HorizontalPodAutoscaler) {
+		h.Spec.MinReplicas = minReplicas
+	}
+}
+
+func SetMetrics(metrics []autoscalingv2.MetricSpec) builder.Option[*autoscalingv2.HorizontalPodAutoscaler] {
+	return func(h *autoscalingv2.HorizontalPodAutoscaler) {
+		h.Spec.Metrics = metrics
+	}
+}
+
+func SetBehavior(behavior *autoscalingv2.HorizontalPodAutoscalerBehavior) builder.Option[*autoscalingv2.HorizontalPodAutoscaler] {
+	return func(h *autoscalingv2.HorizontalPodAutoscaler) {
+		h.Spec.Behavior = behavior
+	}
+}
@@ -0,0 +1,78 @@
+package k8shpa
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	testStatefulSetName = "statefulset-as-owner-of-hpa"
+	testHPAName         = "test-hpa-name"
+	testNamespace       = "test-namespace"
+)
+
+func createStatefulSet() *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testStatefulSetName,
+			Namespace: testNamespace,
+		},
+	}
+}
+
+func TestHPABuilder(t *testing.T) {
+	target := autoscalingv2.CrossVersionObjectReference{
+		APIVersion: "apps/v1",
+		Kind:       "StatefulSet",
+		Name:       testStatefulSetName,
+	}
+
+	t.Run("create hpa", func(t *testing.T) {
+		hpa, err := Build(createStatefulSet(), testHPAName, target, 5)
+		require.NoError(t, err)
+		require.Len(t, hpa.OwnerReferences, 1)
+		assert.Equal(t, testStatefulSetName, hpa.OwnerReferences[0].Name)
+		assert.Equal(t, testHPAName, hpa.Name)
+		assert.Equal(t, testNamespace, hpa.Namespace)
+		assert.Equal(t, target, hpa.Spec.ScaleTargetRef)
+		assert.Equal(t, int32(5), hpa.Spec.MaxReplicas)
+		assert.Nil(t, hpa.Spec.MinReplicas)
+		assert.Empty(t, hpa.Labels)
+	})
+	t.Run("create hpa with options", func(t *testing.T) {
+		labels := map[string]string{"name": "value"}
+		metrics := []autoscalingv2.MetricSpec{
+			{
+				Type: autoscalingv2.ResourceMetricSourceType,
+				Resource: &autoscalingv2.ResourceMetricSource{
+					Name: "cpu",
+					Target: autoscalingv2.MetricTarget{
+						Type:               autoscalingv2.UtilizationMetricType,
+						AverageUtilization: ptr.To(int32(50)),
+					},
+				},
+			},
+		}
+		behavior := &autoscalingv2.HorizontalPodAutoscalerBehavior{
+			ScaleDown: &autoscalingv2.HPAScalingRules{StabilizationWindowSeconds: ptr.To(int32(600))},
+		}
+
+		hpa, err := Build(createStatefulSet(), testHPAName, target, 5,
+			SetLabels(labels),
+			SetMinReplicas(ptr.To(int32(2))),
+			SetMetrics(metrics),
+			SetBehavior(behavior),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, labels, hpa.Labels)
+		assert.Equal(t, int32(2), *hpa.Spec.MinReplicas)
+		assert.Equal(t, metrics, hpa.Spec.Metrics)
+		assert.Equal(t, behavior, hpa.Spec.Behavior)
+	})
+}
@@ -0,0 +1,79 @@
+package k8singress
+
+import (
+	"slices"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/internal/builder"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+type Option = builder.Option[*networkingv1.Ingress]
+
+var (
+	// Mandatory fields, provided in constructor as named params
+	setName      = builder.SetName[*networkingv1.Ingress]
+	setNamespace = builder.SetNamespace[*networkingv1.Ingress]
+
+	// Optional fields, provided in constructor as list of options
+	SetLabels      = builder.SetLabels[*networkingv1.Ingress]
+	SetAnnotations = builder.SetAnnotations[*networkingv1.Ingress]
+)
+
+// Build creates an Ingress, which routes all requests for the given host to the named port of the given Service.
+func Build(owner metav1.Object, name, host string, backend networkingv1.IngressServiceBackend, options ...Option) (*networkingv1.Ingress, error) {
+	neededOpts := slices.Concat([]Option{
+		setName(name),
+		setNamespace(owner.GetNamespace()),
+		setRule(host, backend),
+	}, options)
+
+	return builder.Build(owner, &networkingv1.Ingress{}, neededOpts...)
+}
+
+func setRule(host string, backend networkingv1.IngressServiceBackend) Option {
+	return func(i *networkingv1.Ingress) {
+		i.Spec.Rules = []networkingv1.IngressRule{
+			{
+				Host: host,
+				IngressRuleValue: networkingv1.IngressRuleValue{
+					HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{
+							{
+								Path:     "/",
+								PathType: ptr.To(networkingv1.PathTypePrefix),
+								Backend: networkingv1.IngressBackend{
+									Service: &backend,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+}
+
+func SetIngressClassName(ingressClassName *string) Option {
+	return func(i *networkingv1.Ingress) {
+		i.Spec.IngressClassName = ingressClassName
+	}
+}
+
+// SetTLS makes the Ingress controller terminate TLS for the given hosts with the certificate from the given secret.
+// Nothing is set for an empty secret name, the default certificate of the Ingress controller is used in that case.
+func SetTLS(secretName string, hosts ...string) Option {
+	return func(i *networkingv1.Ingress) {
+		if secretName == "" {
+			return
+		}
+
+		i.Spec.TLS = []networkingv1.IngressTLS{
+			{
+				Hosts:      hosts,
+				SecretName: secretName,
+			},
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package k8singress
+
+import (
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
+	"github.com/Dynatrace/dynatrace-operator/pkg/logd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	testOwnerName   = "owner-of-ingress"
+	testIngressName = "test-ingress"
+	testNamespace   = "test-namespace"
+	testHost        = "ag.example.com"
+)
+
+var ingressLog = logd.Get().WithName("test-ingress")
+
+func createOwner() *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testOwnerName,
+			Namespace: testNamespace,
+		},
+	}
+}
+
+func testBackend() networkingv1.IngressServiceBackend {
+	return networkingv1.IngressServiceBackend{
+		Name: "test-service",
+		Port: networkingv1.ServiceBackendPort{Name: "http"},
+	}
+}
+
+func TestBuild(t *testing.T) {
+	t.Run("minimal ingress", func(t *testing.T) {
+		ingress, err := Build(createOwner(), testIngressName, testHost, testBackend())
+		require.NoError(t, err)
+		require.Len(t, ingress.OwnerReferences, 1)
+		assert.Equal(t, testOwnerName, ingress.OwnerReferences[0].Name)
+		assert.Equal(t, testNamespace, ingress.Namespace)
+
+		require.Len(t, ingress.Spec.Rules, 1)
+		assert.Equal(t, testHost, ingress.Spec.Rules[0].Host)
+		require.Len(t, ingress.Spec.Rules[0].HTTP.Paths, 1)
+		assert.Equal(t, testBackend(), *ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service)
+		assert.Nil(t, ingress.Spec.IngressClassName)
+		assert.Empty(t, ingress.Spec.TLS)
+	})
+	t.Run("all options", func(t *testing.T) {
+		annotations := map[string]string{"a": "b"}
+
+		ingress, err := Build(createOwner(), testIngressName, testHost, testBackend(),
+			SetIngressClassName(ptr.To("nginx")),
+			SetTLS("tls-secret", testHost),
+			SetAnnotations(annotations),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "nginx", *ingress.Spec.IngressClassName)
+		assert.Equal(t, []networkingv1.IngressTLS{{Hosts: []string{testHost}, SecretName: "tls-secret"}}, ingress.Spec.TLS)
+		assert.Equal(t, annotations, ingress.Annotations)
+
+		ingress.Annotations["c"] = "d"
+		assert.Len(t, annotations, 1)
+	})
+}
+
+func TestCreateOrUpdate(t *testing.T) {
+	clt := fake.NewClient()
+
+	desired, err := Build(createOwner(), testIngressName, testHost, testBackend())
+	require.NoError(t, err)
+
+	created, err := Query(clt, clt, ingressLog).CreateOrUpdate(t.Context(), desired)
+	require.NoError(t, err)
+	assert.True(t, created)
+
+	desired, err = Build(createOwner(), testIngressName, testHost, testBackend())
+	require.NoError(t, err)
+
+	updated, err := Query(clt, clt, ingressLog).CreateOrUpdate(t.Context(), desired)
+	require.NoError(t, err)
+	assert.False(t, updated)
+
+	desired, err = Build(createOwner(), testIngressName, "other.example.com", testBackend())
+	require.NoError(t, err)
+
+	updated, err = Query(clt, clt, ingressLog).CreateOrUpdate(t.Context(), desired)
+	require.NoError(t, err)
+	assert.True(t, updated)
+
+	current := &networkingv1.Ingress{}
+	require.NoError(t, clt.Get(t.Context(), client.ObjectKey{Name: testIngressName, Namespace: testNamespace}, current))
+	assert.Equal(t, "other.example.com", current.Spec.Rules[0].Host)
+}
@@ -0,0 +1,41 @@
+package k8singress
+
+import (
+	"github.com/Dynatrace/dynatrace-operator/pkg/logd"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/hasher"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/internal/query"
+	networkingv1 "k8s.io/api/networking/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type QueryObject = query.Generic[*networkingv1.Ingress, *networkingv1.IngressList]
+
+func Query(kubeClient client.Client, kubeReader client.Reader, log logd.Logger) QueryObject {
+	return query.Generic[*networkingv1.Ingress, *networkingv1.IngressList]{
+		Target:     &networkingv1.Ingress{},
+		ListTarget: &networkingv1.IngressList{},
+		ToList: func(list *networkingv1.IngressList) []*networkingv1.Ingress {
+			out := make([]*networkingv1.Ingress, len(list.Items))
+			for i, item := range list.Items {
+				out[i] = &item
+			}
+
+			return out
+		},
+		IsEqual:      isEqual,
+		MustRecreate: mustRecreate,
+
+		KubeClient: kubeClient,
+		KubeReader: kubeReader,
+		Log:        log,
+	}
+}
+
+func isEqual(current, desired *networkingv1.Ingress) bool {
+	return !hasher.IsAnnotationDifferent(current, desired)
+}
+
+// The spec of an Ingress is mutable, so it never needs to be recreated.
+func mustRecreate(_, _ *networkingv1.Ingress) bool {
+	return false
+}
@@ -0,0 +1,57 @@
+package k8snetworkpolicy
+
+import (
+	"slices"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/internal/builder"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type Option = builder.Option[*networkingv1.NetworkPolicy]
+
+var (
+	// Mandatory fields, provided in constructor as named params
+	setName      = builder.SetName[*networkingv1.NetworkPolicy]
+	setNamespace = builder.SetNamespace[*networkingv1.NetworkPolicy]
+
+	// Optional fields, provided in constructor as list of options
+	SetLabels = builder.SetLabels[*networkingv1.NetworkPolicy]
+)
+
+// Build creates a NetworkPolicy for the selected pods, which restricts both their ingress and egress.
+// Without ingress or egress rules all traffic in the respective direction is denied.
+func Build(owner metav1.Object, name string, podSelector metav1.LabelSelector, options ...Option) (*networkingv1.NetworkPolicy, error) {
+	neededOpts := slices.Concat([]Option{
+		setName(name),
+		setNamespace(owner.GetNamespace()),
+		setPodSelector(podSelector),
+		setPolicyTypes(),
+	}, options)
+
+	return builder.Build(owner, &networkingv1.NetworkPolicy{}, neededOpts...)
+}
+
+func setPodSelector(podSelector metav1.LabelSelector) Option {
+	return func(p *networkingv1.NetworkPolicy) {
+		p.Spec.PodSelector = podSelector
+	}
+}
+
+func setPolicyTypes() Option {
+	return func(p *networkingv1.NetworkPolicy) {
+		p.Spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress}
+	}
+}
+
+func SetIngress(rules ...networkingv1.NetworkPolicyIngressRule) Option {
+	return func(p *networkingv1.NetworkPolicy) {
+		p.Spec.Ingress = rules
+	}
+}
+
+func SetEgress(rules ...networkingv1.NetworkPolicyEgressRule) Option {
+	return func(p *networkingv1.NetworkPolicy) {
+		p.Spec.Egress = rules
+	}
+}
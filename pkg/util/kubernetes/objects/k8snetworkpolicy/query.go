@@ -0,0 +1,62 @@
+package k8snetworkpolicy
+
+import (
+	"context"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/logd"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/hasher"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/internal/query"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type QueryObject = query.Generic[*networkingv1.NetworkPolicy, *networkingv1.NetworkPolicyList]
+
+func Query(kubeClient client.Client, kubeReader client.Reader, log logd.Logger) QueryObject {
+	return query.Generic[*networkingv1.NetworkPolicy, *networkingv1.NetworkPolicyList]{
+		Target:     &networkingv1.NetworkPolicy{},
+		ListTarget: &networkingv1.NetworkPolicyList{},
+		ToList: func(list *networkingv1.NetworkPolicyList) []*networkingv1.NetworkPolicy {
+			out := make([]*networkingv1.NetworkPolicy, len(list.Items))
+			for i, item := range list.Items {
+				out[i] = &item
+			}
+
+			return out
+		},
+		IsEqual:      isEqual,
+		MustRecreate: mustRecreate,
+
+		KubeClient: kubeClient,
+		KubeReader: kubeReader,
+		Log:        log,
+	}
+}
+
+// CreateOrDelete creates or updates the desired NetworkPolicy if enabled, otherwise it removes a previously created one.
+func CreateOrDelete(ctx context.Context, query QueryObject, desired *networkingv1.NetworkPolicy, enabled bool) error {
+	if enabled {
+		_, err := query.CreateOrUpdate(ctx, desired)
+
+		return err
+	}
+
+	_, err := query.Get(ctx, client.ObjectKeyFromObject(desired))
+	if k8serrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	return query.Delete(ctx, desired)
+}
+
+func isEqual(current, desired *networkingv1.NetworkPolicy) bool {
+	return !hasher.IsAnnotationDifferent(current, desired)
+}
+
+// The spec of a NetworkPolicy is mutable, so it never needs to be recreated.
+func mustRecreate(_, _ *networkingv1.NetworkPolicy) bool {
+	return false
+}
@@ -0,0 +1,93 @@
+package k8snetworkpolicy
+
+import (
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
+	"github.com/Dynatrace/dynatrace-operator/pkg/logd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	testOwnerName  = "owner-of-network-policy"
+	testPolicyName = "test-network-policy"
+	testNamespace  = "test-namespace"
+)
+
+var networkPolicyLog = logd.Get().WithName("test-network-policy")
+
+func createOwner() *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testOwnerName,
+			Namespace: testNamespace,
+		},
+	}
+}
+
+func TestBuild(t *testing.T) {
+	podSelector := metav1.LabelSelector{MatchLabels: map[string]string{"app": "test"}}
+
+	t.Run("deny all", func(t *testing.T) {
+		policy, err := Build(createOwner(), testPolicyName, podSelector)
+		require.NoError(t, err)
+		require.Len(t, policy.OwnerReferences, 1)
+		assert.Equal(t, testOwnerName, policy.OwnerReferences[0].Name)
+		assert.Equal(t, testNamespace, policy.Namespace)
+		assert.Equal(t, podSelector, policy.Spec.PodSelector)
+		assert.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress}, policy.Spec.PolicyTypes)
+		assert.Empty(t, policy.Spec.Ingress)
+		assert.Empty(t, policy.Spec.Egress)
+	})
+	t.Run("with rules", func(t *testing.T) {
+		ingress := networkingv1.NetworkPolicyIngressRule{From: []networkingv1.NetworkPolicyPeer{{NamespaceSelector: &metav1.LabelSelector{}}}}
+		egress := networkingv1.NetworkPolicyEgressRule{}
+
+		policy, err := Build(createOwner(), testPolicyName, podSelector, SetIngress(ingress), SetEgress(egress))
+		require.NoError(t, err)
+		assert.Equal(t, []networkingv1.NetworkPolicyIngressRule{ingress}, policy.Spec.Ingress)
+		assert.Equal(t, []networkingv1.NetworkPolicyEgressRule{egress}, policy.Spec.Egress)
+	})
+}
+
+func TestCreateOrDelete(t *testing.T) {
+	podSelector := metav1.LabelSelector{MatchLabels: map[string]string{"app": "test"}}
+
+	getPolicy := func(t *testing.T, clt client.Client) error {
+		return clt.Get(t.Context(), client.ObjectKey{Name: testPolicyName, Namespace: testNamespace}, &networkingv1.NetworkPolicy{})
+	}
+
+	t.Run("create if enabled", func(t *testing.T) {
+		clt := fake.NewClient()
+		desired, err := Build(createOwner(), testPolicyName, podSelector)
+		require.NoError(t, err)
+
+		require.NoError(t, CreateOrDelete(t.Context(), Query(clt, clt, networkPolicyLog), desired, true))
+		require.NoError(t, getPolicy(t, clt))
+	})
+	t.Run("delete if disabled", func(t *testing.T) {
+		clt := fake.NewClient()
+		desired, err := Build(createOwner(), testPolicyName, podSelector)
+		require.NoError(t, err)
+		require.NoError(t, CreateOrDelete(t.Context(), Query(clt, clt, networkPolicyLog), desired, true))
+
+		desired, err = Build(createOwner(), testPolicyName, podSelector)
+		require.NoError(t, err)
+		require.NoError(t, CreateOrDelete(t.Context(), Query(clt, clt, networkPolicyLog), desired, false))
+		assert.True(t, k8serrors.IsNotFound(getPolicy(t, clt)))
+	})
+	t.Run("nothing to delete if disabled", func(t *testing.T) {
+		clt := fake.NewClient()
+		desired, err := Build(createOwner(), testPolicyName, podSelector)
+		require.NoError(t, err)
+
+		require.NoError(t, CreateOrDelete(t.Context(), Query(clt, clt, networkPolicyLog), desired, false))
+		assert.True(t, k8serrors.IsNotFound(getPolicy(t, clt)))
+	})
+}
@@ -0,0 +1,43 @@
+package k8spdb
+
+import (
+	"slices"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/pdb"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/internal/builder"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	// Mandatory fields, provided in constructor as named params
+	setName      = builder.SetName[*policyv1.PodDisruptionBudget]
+	setNamespace = builder.SetNamespace[*policyv1.PodDisruptionBudget]
+
+	// Optional fields, provided in constructor as list of options
+	SetLabels = builder.SetLabels[*policyv1.PodDisruptionBudget]
+)
+
+func Build(owner metav1.Object, name string, matchLabels map[string]string, options ...builder.Option[*policyv1.PodDisruptionBudget]) (*policyv1.PodDisruptionBudget, error) {
+	neededOpts := slices.Concat([]builder.Option[*policyv1.PodDisruptionBudget]{
+		setName(name),
+		setSelector(matchLabels),
+		setNamespace(owner.GetNamespace()),
+	}, options)
+
+	return builder.Build(owner, &policyv1.PodDisruptionBudget{}, neededOpts...)
+}
+
+func setSelector(matchLabels map[string]string) builder.Option[*policyv1.PodDisruptionBudget] {
+	return func(p *policyv1.PodDisruptionBudget) {
+		p.Spec.Selector = &metav1.LabelSelector{MatchLabels: matchLabels}
+	}
+}
+
+// SetBudget applies minAvailable/maxUnavailable from the given spec, falling back to the defaults of the spec if it's not set.
+func SetBudget(spec *pdb.Spec) builder.Option[*policyv1.PodDisruptionBudget] {
+	return func(p *policyv1.PodDisruptionBudget) {
+		p.Spec.MinAvailable = spec.GetMinAvailable()
+		p.Spec.MaxUnavailable = spec.GetMaxUnavailable()
+	}
+}
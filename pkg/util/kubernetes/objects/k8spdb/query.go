@@ -0,0 +1,62 @@
+package k8spdb
+
+import (
+	"context"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/logd"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/hasher"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/internal/query"
+	policyv1 "k8s.io/api/policy/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type QueryObject = query.Generic[*policyv1.PodDisruptionBudget, *policyv1.PodDisruptionBudgetList]
+
+func Query(kubeClient client.Client, kubeReader client.Reader, log logd.Logger) QueryObject {
+	return query.Generic[*policyv1.PodDisruptionBudget, *policyv1.PodDisruptionBudgetList]{
+		Target:     &policyv1.PodDisruptionBudget{},
+		ListTarget: &policyv1.PodDisruptionBudgetList{},
+		ToList: func(list *policyv1.PodDisruptionBudgetList) []*policyv1.PodDisruptionBudget {
+			out := make([]*policyv1.PodDisruptionBudget, len(list.Items))
+			for i, item := range list.Items {
+				out[i] = &item
+			}
+
+			return out
+		},
+		IsEqual:      isEqual,
+		MustRecreate: mustRecreate,
+
+		KubeClient: kubeClient,
+		KubeReader: kubeReader,
+		Log:        log,
+	}
+}
+
+// CreateOrDelete creates or updates the desired PodDisruptionBudget if enabled, otherwise it removes a previously created one.
+func CreateOrDelete(ctx context.Context, query QueryObject, desired *policyv1.PodDisruptionBudget, enabled bool) error {
+	if enabled {
+		_, err := query.CreateOrUpdate(ctx, desired)
+
+		return err
+	}
+
+	_, err := query.Get(ctx, client.ObjectKeyFromObject(desired))
+	if k8serrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	return query.Delete(ctx, desired)
+}
+
+func isEqual(current, desired *policyv1.PodDisruptionBudget) bool {
+	return !hasher.IsAnnotationDifferent(current, desired)
+}
+
+// The spec of a policy/v1 PodDisruptionBudget is mutable, so it never needs to be recreated.
+func mustRecreate(_, _ *policyv1.PodDisruptionBudget) bool {
+	return false
+}
@@ -0,0 +1,92 @@
+package k8spdb
+
+import (
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/shared/pdb"
+	"github.com/Dynatrace/dynatrace-operator/pkg/logd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	testOwnerName = "owner-of-pdb"
+	testPDBName   = "test-pdb"
+	testNamespace = "test-namespace"
+)
+
+var pdbLog = logd.Get().WithName("test-pdb")
+
+func createOwner() *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testOwnerName,
+			Namespace: testNamespace,
+		},
+	}
+}
+
+func TestBuild(t *testing.T) {
+	matchLabels := map[string]string{"app": "test"}
+
+	t.Run("default budget", func(t *testing.T) {
+		budget, err := Build(createOwner(), testPDBName, matchLabels, SetBudget(nil))
+		require.NoError(t, err)
+		require.Len(t, budget.OwnerReferences, 1)
+		assert.Equal(t, testOwnerName, budget.OwnerReferences[0].Name)
+		assert.Equal(t, testNamespace, budget.Namespace)
+		assert.Equal(t, matchLabels, budget.Spec.Selector.MatchLabels)
+		assert.Nil(t, budget.Spec.MinAvailable)
+		assert.Equal(t, intstr.FromInt32(1), *budget.Spec.MaxUnavailable)
+	})
+	t.Run("custom budget", func(t *testing.T) {
+		budget, err := Build(createOwner(), testPDBName, matchLabels, SetBudget(&pdb.Spec{MinAvailable: ptr.To(intstr.FromString("50%"))}))
+		require.NoError(t, err)
+		assert.Equal(t, intstr.FromString("50%"), *budget.Spec.MinAvailable)
+		assert.Nil(t, budget.Spec.MaxUnavailable)
+	})
+}
+
+func TestCreateOrDelete(t *testing.T) {
+	matchLabels := map[string]string{"app": "test"}
+
+	getPDB := func(t *testing.T, clt client.Client) error {
+		return clt.Get(t.Context(), client.ObjectKey{Name: testPDBName, Namespace: testNamespace}, &policyv1.PodDisruptionBudget{})
+	}
+
+	t.Run("create if enabled", func(t *testing.T) {
+		clt := fake.NewClient()
+		desired, err := Build(createOwner(), testPDBName, matchLabels, SetBudget(nil))
+		require.NoError(t, err)
+
+		require.NoError(t, CreateOrDelete(t.Context(), Query(clt, clt, pdbLog), desired, true))
+		require.NoError(t, getPDB(t, clt))
+	})
+	t.Run("delete if disabled", func(t *testing.T) {
+		clt := fake.NewClient()
+		desired, err := Build(createOwner(), testPDBName, matchLabels, SetBudget(nil))
+		require.NoError(t, err)
+		require.NoError(t, CreateOrDelete(t.Context(), Query(clt, clt, pdbLog), desired, true))
+
+		desired, err = Build(createOwner(), testPDBName, matchLabels, SetBudget(nil))
+		require.NoError(t, err)
+		require.NoError(t, CreateOrDelete(t.Context(), Query(clt, clt, pdbLog), desired, false))
+		assert.True(t, k8serrors.IsNotFound(getPDB(t, clt)))
+	})
+	t.Run("nothing to delete if disabled", func(t *testing.T) {
+		clt := fake.NewClient()
+		desired, err := Build(createOwner(), testPDBName, matchLabels, SetBudget(nil))
+		require.NoError(t, err)
+
+		require.NoError(t, CreateOrDelete(t.Context(), Query(clt, clt, pdbLog), desired, false))
+		assert.True(t, k8serrors.IsNotFound(getPDB(t, clt)))
+	})
+}
@@ -2,9 +2,12 @@ package k8spod
 
 import (
 	"context"
+	"strings"
 
 	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -29,3 +32,27 @@ func GetName(pod corev1.Pod) string {
 
 	return pod.GenerateName
 }
+
+// GetWorkloadKey returns the kind and name of the workload that controls the pod, in the format <kind>/<name>.
+// Pods of a Deployment are mapped to the Deployment instead of the ReplicaSet, an empty string is returned for pods that aren't controlled by a Deployment, StatefulSet or DaemonSet.
+func GetWorkloadKey(pod corev1.Pod) string {
+	owner := metav1.GetControllerOf(&pod)
+	if owner == nil {
+		return ""
+	}
+
+	switch owner.Kind {
+	case "ReplicaSet":
+		// the name of a ReplicaSet of a Deployment is the name of the Deployment with the hash of the pod template as suffix
+		suffix := "-" + pod.Labels[appsv1.DefaultDeploymentUniqueLabelKey]
+		if suffix == "-" || !strings.HasSuffix(owner.Name, suffix) {
+			return ""
+		}
+
+		return "Deployment/" + strings.TrimSuffix(owner.Name, suffix)
+	case "StatefulSet", "DaemonSet":
+		return owner.Kind + "/" + owner.Name
+	}
+
+	return ""
+}
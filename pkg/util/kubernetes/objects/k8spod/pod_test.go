@@ -7,8 +7,10 @@ import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 func TestGetPod(t *testing.T) {
@@ -60,3 +62,19 @@ func TestGetName(t *testing.T) {
 		assert.Equal(t, podGenerateName, got)
 	})
 }
+
+func TestGetWorkloadKey(t *testing.T) {
+	owned := func(kind, name string, labels map[string]string) corev1.Pod {
+		return corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Labels:          labels,
+			OwnerReferences: []metav1.OwnerReference{{Kind: kind, Name: name, Controller: ptr.To(true)}},
+		}}
+	}
+
+	assert.Equal(t, "Deployment/app", GetWorkloadKey(owned("ReplicaSet", "app-5d8f", map[string]string{appsv1.DefaultDeploymentUniqueLabelKey: "5d8f"})))
+	assert.Empty(t, GetWorkloadKey(owned("ReplicaSet", "standalone", nil)))
+	assert.Equal(t, "StatefulSet/db", GetWorkloadKey(owned("StatefulSet", "db", nil)))
+	assert.Equal(t, "DaemonSet/agent", GetWorkloadKey(owned("DaemonSet", "agent", nil)))
+	assert.Empty(t, GetWorkloadKey(owned("Job", "migration", nil)))
+	assert.Empty(t, GetWorkloadKey(corev1.Pod{}))
+}
@@ -14,7 +14,8 @@ var (
 	setNamespace = builder.SetNamespace[*corev1.Service]
 
 	// Optional fields, provided in constructor as list of options
-	SetLabels = builder.SetLabels[*corev1.Service]
+	SetLabels      = builder.SetLabels[*corev1.Service]
+	SetAnnotations = builder.SetAnnotations[*corev1.Service]
 )
 
 func Build(owner metav1.Object, name string, selectorLabels map[string]string, svcPort []corev1.ServicePort, options ...builder.Option[*corev1.Service]) (*corev1.Service, error) {
@@ -45,3 +46,21 @@ func SetType(serviceType corev1.ServiceType) builder.Option[*corev1.Service] {
 		s.Spec.Type = serviceType
 	}
 }
+
+func SetLoadBalancerClass(loadBalancerClass *string) builder.Option[*corev1.Service] {
+	return func(s *corev1.Service) {
+		s.Spec.LoadBalancerClass = loadBalancerClass
+	}
+}
+
+func SetLoadBalancerSourceRanges(sourceRanges []string) builder.Option[*corev1.Service] {
+	return func(s *corev1.Service) {
+		s.Spec.LoadBalancerSourceRanges = sourceRanges
+	}
+}
+
+func SetExternalTrafficPolicy(policy corev1.ServiceExternalTrafficPolicy) builder.Option[*corev1.Service] {
+	return func(s *corev1.Service) {
+		s.Spec.ExternalTrafficPolicy = policy
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 const (
@@ -57,4 +59,22 @@ func TestServiceBuilder(t *testing.T) {
 		require.Len(t, secret.Labels, 1)
 		assert.Equal(t, labelValue, secret.Labels[labelName])
 	})
+	t.Run("create load balancer service", func(t *testing.T) {
+		service, err := Build(createDeployment(),
+			testServiceName,
+			labels,
+			nil,
+			SetType(corev1.ServiceTypeLoadBalancer),
+			SetAnnotations(labels),
+			SetLoadBalancerClass(ptr.To("test-class")),
+			SetLoadBalancerSourceRanges([]string{"10.0.0.0/8"}),
+			SetExternalTrafficPolicy(corev1.ServiceExternalTrafficPolicyLocal),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, corev1.ServiceTypeLoadBalancer, service.Spec.Type)
+		assert.Equal(t, labels, service.Annotations)
+		assert.Equal(t, "test-class", *service.Spec.LoadBalancerClass)
+		assert.Equal(t, []string{"10.0.0.0/8"}, service.Spec.LoadBalancerSourceRanges)
+		assert.Equal(t, corev1.ServiceExternalTrafficPolicyLocal, service.Spec.ExternalTrafficPolicy)
+	})
 }
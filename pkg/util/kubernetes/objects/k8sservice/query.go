@@ -4,13 +4,26 @@ import (
 	"reflect"
 
 	"github.com/Dynatrace/dynatrace-operator/pkg/logd"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/hasher"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8slabel"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/internal/query"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func Query(kubeClient client.Client, kubeReader client.Reader, log logd.Logger) query.Generic[*corev1.Service, *corev1.ServiceList] {
+type QueryObject = query.Generic[*corev1.Service, *corev1.ServiceList]
+
+func Query(kubeClient client.Client, kubeReader client.Reader, log logd.Logger) QueryObject {
+	return newQuery(kubeClient, kubeReader, log, isEqual)
+}
+
+// QueryByHash compares the hash annotation of the Services instead of their spec.
+// It's meant for Services whose spec is partially filled in by the API server or cloud controllers, like the node ports of a LoadBalancer Service.
+func QueryByHash(kubeClient client.Client, kubeReader client.Reader, log logd.Logger) QueryObject {
+	return newQuery(kubeClient, kubeReader, log, isEqualByHash)
+}
+
+func newQuery(kubeClient client.Client, kubeReader client.Reader, log logd.Logger, isEqual func(current, other *corev1.Service) bool) QueryObject {
 	return query.Generic[*corev1.Service, *corev1.ServiceList]{
 		Target:     &corev1.Service{},
 		ListTarget: &corev1.ServiceList{},
@@ -35,6 +48,10 @@ func isEqual(current, other *corev1.Service) bool {
 	return reflect.DeepEqual(current.Spec.Ports, other.Spec.Ports) && reflect.DeepEqual(current.Labels, other.Labels) && reflect.DeepEqual(current.OwnerReferences, other.OwnerReferences) && reflect.DeepEqual(current.Spec.Selector, other.Spec.Selector)
 }
 
+func isEqualByHash(current, other *corev1.Service) bool {
+	return !hasher.IsAnnotationDifferent(current, other)
+}
+
 func mustRecreate(current, desired *corev1.Service) bool {
 	return k8slabel.NotEqual(current.Spec.Selector, desired.Spec.Selector)
 }
@@ -0,0 +1,48 @@
+package k8sservice
+
+import (
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/hasher"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createService(ports ...corev1.ServicePort) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: testServiceName, Namespace: testNamespace},
+		Spec:       corev1.ServiceSpec{Ports: ports},
+	}
+}
+
+func TestIsEqual(t *testing.T) {
+	t.Run("service without hash annotation is equal if the spec matches", func(t *testing.T) {
+		desired := createService(corev1.ServicePort{Name: "https", Port: 443})
+		require.NoError(t, hasher.AddAnnotation(desired))
+
+		assert.True(t, isEqual(createService(corev1.ServicePort{Name: "https", Port: 443}), desired))
+	})
+	t.Run("service with different ports is not equal", func(t *testing.T) {
+		assert.False(t, isEqual(createService(corev1.ServicePort{Name: "https", Port: 443}), createService(corev1.ServicePort{Name: "https", Port: 8443})))
+	})
+}
+
+func TestIsEqualByHash(t *testing.T) {
+	desired := createService(corev1.ServicePort{Name: "https", Port: 443})
+	require.NoError(t, hasher.AddAnnotation(desired))
+
+	t.Run("values filled in by the API server are ignored", func(t *testing.T) {
+		current := desired.DeepCopy()
+		current.Spec.Ports[0].NodePort = 30443
+
+		assert.True(t, isEqualByHash(current, desired))
+	})
+	t.Run("changed desired service is not equal", func(t *testing.T) {
+		changed := createService(corev1.ServicePort{Name: "https", Port: 8443})
+		require.NoError(t, hasher.AddAnnotation(changed))
+
+		assert.False(t, isEqualByHash(desired, changed))
+	})
+}
@@ -9,7 +9,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func Query(kubeClient client.Client, kubeReader client.Reader, log logd.Logger) query.Generic[*appsv1.StatefulSet, *appsv1.StatefulSetList] {
+type QueryObject = query.Generic[*appsv1.StatefulSet, *appsv1.StatefulSetList]
+
+func Query(kubeClient client.Client, kubeReader client.Reader, log logd.Logger) QueryObject {
 	return query.Generic[*appsv1.StatefulSet, *appsv1.StatefulSetList]{
 		Target:     &appsv1.StatefulSet{},
 		ListTarget: &appsv1.StatefulSetList{},
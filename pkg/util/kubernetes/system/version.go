@@ -0,0 +1,23 @@
+package system
+
+import (
+	"k8s.io/apimachinery/pkg/util/version"
+	k8sversion "k8s.io/apimachinery/pkg/version"
+)
+
+// nativeSidecarVersion is the first Kubernetes version where native sidecars (restartable init containers) are enabled by default.
+var nativeSidecarVersion = version.MajorMinor(1, 29)
+
+// SupportsNativeSidecars checks if the Kubernetes server version supports native sidecars, unknown versions are considered as not supported.
+func SupportsNativeSidecars(serverVersion *k8sversion.Info) bool {
+	if serverVersion == nil {
+		return false
+	}
+
+	parsed, err := version.ParseGeneric(serverVersion.GitVersion)
+	if err != nil {
+		return false
+	}
+
+	return parsed.AtLeast(nativeSidecarVersion)
+}
@@ -0,0 +1,25 @@
+package system
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	k8sversion "k8s.io/apimachinery/pkg/version"
+)
+
+func TestSupportsNativeSidecars(t *testing.T) {
+	t.Run("supported versions", func(t *testing.T) {
+		assert.True(t, SupportsNativeSidecars(&k8sversion.Info{GitVersion: "v1.29.0"}))
+		assert.True(t, SupportsNativeSidecars(&k8sversion.Info{GitVersion: "v1.31.2-gke.1000"}))
+	})
+
+	t.Run("unsupported versions", func(t *testing.T) {
+		assert.False(t, SupportsNativeSidecars(&k8sversion.Info{GitVersion: "v1.28.9+k3s1"}))
+		assert.False(t, SupportsNativeSidecars(&k8sversion.Info{GitVersion: "v1.27.0"}))
+	})
+
+	t.Run("unknown version", func(t *testing.T) {
+		assert.False(t, SupportsNativeSidecars(nil))
+		assert.False(t, SupportsNativeSidecars(&k8sversion.Info{GitVersion: "invalid"}))
+	})
+}
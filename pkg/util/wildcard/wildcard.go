@@ -0,0 +1,49 @@
+package wildcard
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// validPattern allows the characters of image references and workload names, besides the '*' wildcard.
+	validPattern = regexp.MustCompile(`^[a-zA-Z0-9._/:@*-]+$`)
+
+	// compiled caches the compiled patterns, so they are not compiled again on every match.
+	compiled sync.Map
+)
+
+// Validate checks that the pattern is not empty and only contains characters that can be part of image references or workload names.
+func Validate(pattern string) error {
+	if !validPattern.MatchString(pattern) {
+		return errors.Errorf("invalid pattern %q, only alphanumeric characters, '.', '_', '-', '/', ':', '@' and '*' are allowed", pattern)
+	}
+
+	return nil
+}
+
+// MatchesAny checks the value against the patterns, where '*' matches any sequence of characters.
+func MatchesAny(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if compile(pattern).MatchString(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func compile(pattern string) *regexp.Regexp {
+	if expr, ok := compiled.Load(pattern); ok {
+		return expr.(*regexp.Regexp)
+	}
+
+	// all characters besides '*' are quoted, so compiling can't fail
+	expr := regexp.MustCompile("^" + strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, ".*") + "$")
+	compiled.Store(pattern, expr)
+
+	return expr
+}
@@ -0,0 +1,25 @@
+package wildcard
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchesAny(t *testing.T) {
+	assert.True(t, MatchesAny([]string{"nginx"}, "nginx"))
+	assert.True(t, MatchesAny([]string{"other", "*/nginx:*"}, "docker.io/library/nginx:1.27"))
+	assert.True(t, MatchesAny([]string{"*/istio/proxyv2*"}, "docker.io/istio/proxyv2:1.24.0"))
+	assert.False(t, MatchesAny([]string{"nginx"}, "docker.io/library/nginx"))
+	assert.False(t, MatchesAny([]string{"ngin.x"}, "nginxx"))
+	assert.False(t, MatchesAny(nil, "nginx"))
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate("*/istio/proxyv2*"))
+	require.NoError(t, Validate("registry.example.com:5000/shop@sha256:abc"))
+	require.Error(t, Validate(""))
+	require.Error(t, Validate("nginx latest"))
+	require.Error(t, Validate("(nginx|envoy)"))
+}
@@ -0,0 +1,85 @@
+package pod
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator/oneagent"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const ephemeralContainersSubResource = "ephemeralcontainers"
+
+// handleEphemeralContainers injects the OneAgent into the ephemeral containers added to a pod, e.g. by kubectl debug.
+// Only the ephemeral containers of an update are kept by the Kubernetes API, so changes to the annotations of the pod would be dropped.
+// Therefore, the reason why an ephemeral container is not injected is returned as a warning of the admission and sent as an event.
+func (wh *webhook) handleEphemeralContainers(ctx context.Context, request admission.Request) admission.Response {
+	emptyPatch := admission.Patched("")
+
+	mutationRequest, err := wh.createMutationRequestBase(ctx, request)
+	if err != nil {
+		emptyPatch.Result.Message = fmt.Sprintf("unable to inject into ephemeral containers (err=%s)", err.Error())
+		log.Error(err, "building mutation request base for ephemeral containers encountered an error")
+
+		return emptyPatch
+	}
+
+	if mutationRequest == nil || !mutationRequest.DynaKube.OneAgent().IsAppInjectionNeeded() || !mutationRequest.DynaKube.FF().IsEphemeralContainerInjection() {
+		return emptyPatch
+	}
+
+	wh.recorder.Setup(mutationRequest)
+
+	existing := wh.getExistingEphemeralContainers(request)
+	mutated := false
+
+	var warnings []string
+
+	for i := range mutationRequest.Pod.Spec.EphemeralContainers {
+		ephemeralContainer := &mutationRequest.Pod.Spec.EphemeralContainers[i]
+		if existing[ephemeralContainer.Name] {
+			continue
+		}
+
+		injected, reason := oneagent.MutateEphemeralContainer(mutationRequest.BaseRequest, ephemeralContainer)
+		if reason != "" {
+			log.Info("ephemeral container not injected", "podName", mutationRequest.PodName(), "containerName", ephemeralContainer.Name, "reason", reason)
+			warnings = append(warnings, fmt.Sprintf("Dynatrace OneAgent not injected into ephemeral container %s: %s", ephemeralContainer.Name, reason))
+			wh.recorder.SendEphemeralContainerSkippedEvent(ephemeralContainer.Name, reason)
+		}
+
+		mutated = mutated || injected
+	}
+
+	response := emptyPatch
+	if mutated {
+		log.Info("injection finished for ephemeral containers", "podName", mutationRequest.PodName(), "namespace", request.Namespace)
+
+		response = createResponseForPod(mutationRequest.Pod, request)
+	}
+
+	return response.WithWarnings(warnings...)
+}
+
+// getExistingEphemeralContainers returns the ephemeral containers of the pod before the update, which can't be changed anymore.
+func (wh *webhook) getExistingEphemeralContainers(request admission.Request) map[string]bool {
+	existing := map[string]bool{}
+
+	if len(request.OldObject.Raw) == 0 {
+		return existing
+	}
+
+	var oldPod corev1.Pod
+	if err := wh.decoder.DecodeRaw(request.OldObject, &oldPod); err != nil {
+		log.Info("failed to decode the pod before the update", "error", err.Error())
+
+		return existing
+	}
+
+	for _, ephemeralContainer := range oldPod.Spec.EphemeralContainers {
+		existing[ephemeralContainer.Name] = true
+	}
+
+	return existing
+}
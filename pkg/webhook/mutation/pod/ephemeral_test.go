@@ -0,0 +1,105 @@
+package pod
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/exp"
+	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator/oneagent"
+	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/volumes"
+	handlermock "github.com/Dynatrace/dynatrace-operator/test/mocks/pkg/webhook/mutation/pod/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func getTestInjectedPod() *corev1.Pod {
+	pod := getTestPod()
+	pod.Annotations = map[string]string{oneagent.AnnotationInjected: "true"}
+	pod.Spec.Containers[0].VolumeMounts = []corev1.VolumeMount{
+		{Name: oneagent.BinVolumeName, MountPath: oneagent.DefaultInstallPath},
+		{Name: volumes.ConfigVolumeName, MountPath: volumes.ConfigMountPath, SubPath: pod.Spec.Containers[0].Name},
+	}
+
+	return pod
+}
+
+func createTestEphemeralContainersRequest(oldPod, pod *corev1.Pod) *admission.Request {
+	oldPodBytes, _ := json.Marshal(oldPod)
+	request := createTestAdmissionRequest(pod)
+	request.SubResource = ephemeralContainersSubResource
+	request.Operation = admissionv1.Update
+	request.OldObject = runtime.RawExtension{Raw: oldPodBytes}
+
+	return request
+}
+
+func addEphemeralContainer(pod *corev1.Pod, name string) *corev1.Pod {
+	pod = pod.DeepCopy()
+	pod.Spec.EphemeralContainers = append(pod.Spec.EphemeralContainers, corev1.EphemeralContainer{
+		EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: name, Image: "busybox"},
+	})
+
+	return pod
+}
+
+func TestHandleEphemeralContainers(t *testing.T) {
+	ctx := context.Background()
+
+	dk := getTestDynakube()
+	dk.Annotations = map[string]string{exp.InjectionEphemeralContainersKey: "true"}
+
+	t.Run("new ephemeral container of an injected pod is injected", func(t *testing.T) {
+		wh := createTestWebhook(t, handlermock.NewHandler(t), handlermock.NewHandler(t), getTestNamespace(), dk)
+		oldPod := getTestInjectedPod()
+
+		resp := wh.Handle(ctx, *createTestEphemeralContainersRequest(oldPod, addEphemeralContainer(oldPod, "debugger")))
+
+		require.True(t, resp.Allowed)
+		assert.NotEmpty(t, resp.Patches)
+		assert.Empty(t, resp.Warnings)
+
+		patches, err := json.Marshal(resp.Patches)
+		require.NoError(t, err)
+		assert.Contains(t, string(patches), oneagent.EphemeralConfigMountPath)
+		assert.NotContains(t, string(patches), "subPath", "ephemeral containers can't use subPath mounts")
+	})
+
+	t.Run("existing ephemeral containers are not changed", func(t *testing.T) {
+		wh := createTestWebhook(t, handlermock.NewHandler(t), handlermock.NewHandler(t), getTestNamespace(), dk)
+		oldPod := addEphemeralContainer(getTestPod(), "debugger")
+
+		resp := wh.Handle(ctx, *createTestEphemeralContainersRequest(oldPod, oldPod))
+
+		require.True(t, resp.Allowed)
+		assert.Empty(t, resp.Patches)
+		assert.Empty(t, resp.Warnings)
+	})
+
+	t.Run("pod not injected => warning with reason", func(t *testing.T) {
+		wh := createTestWebhook(t, handlermock.NewHandler(t), handlermock.NewHandler(t), getTestNamespace(), dk)
+		oldPod := getTestPod()
+
+		resp := wh.Handle(ctx, *createTestEphemeralContainersRequest(oldPod, addEphemeralContainer(oldPod, "debugger")))
+
+		require.True(t, resp.Allowed)
+		assert.Empty(t, resp.Patches)
+		require.Len(t, resp.Warnings, 1)
+		assert.Contains(t, resp.Warnings[0], oneagent.PodNotInjectedReason)
+	})
+
+	t.Run("feature-flag not set => nothing is done", func(t *testing.T) {
+		wh := createTestWebhook(t, handlermock.NewHandler(t), handlermock.NewHandler(t), getTestNamespace(), getTestDynakube())
+		oldPod := getTestInjectedPod()
+
+		resp := wh.Handle(ctx, *createTestEphemeralContainersRequest(oldPod, addEphemeralContainer(oldPod, "debugger")))
+
+		require.True(t, resp.Allowed)
+		assert.Empty(t, resp.Patches)
+		assert.Empty(t, resp.Warnings)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	dtwebhook "github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 )
 
@@ -13,6 +14,7 @@ const (
 	updatePodEvent       = "UpdatePod"
 	IncompatibleCRDEvent = "IncompatibleCRDPresent"
 	missingDynakubeEvent = "MissingDynakube"
+	skipEphemeralEvent   = "SkipEphemeralContainer"
 )
 
 type EventRecorder struct {
@@ -25,6 +27,20 @@ func NewRecorder(recorder record.EventRecorder) EventRecorder {
 	return EventRecorder{recorder: recorder}
 }
 
+// NewDiscardingRecorder creates a recorder that drops all events, for mutations that are only previewed.
+func NewDiscardingRecorder() EventRecorder {
+	return EventRecorder{recorder: discardingRecorder{}}
+}
+
+type discardingRecorder struct{}
+
+func (discardingRecorder) Event(runtime.Object, string, string, string) {}
+
+func (discardingRecorder) Eventf(runtime.Object, string, string, string, ...any) {}
+
+func (discardingRecorder) AnnotatedEventf(runtime.Object, map[string]string, string, string, string, ...any) {
+}
+
 func (er *EventRecorder) Setup(mutationRequest *dtwebhook.MutationRequest) {
 	er.dk = &mutationRequest.DynaKube
 	er.pod = mutationRequest.Pod
@@ -44,6 +60,13 @@ func (er *EventRecorder) SendPodUpdateEvent() {
 		"Updating pod %s in namespace %s with missing containers", er.pod.GenerateName, er.pod.Namespace)
 }
 
+func (er *EventRecorder) SendEphemeralContainerSkippedEvent(containerName, reason string) {
+	er.recorder.Eventf(er.dk,
+		corev1.EventTypeWarning,
+		skipEphemeralEvent,
+		"Ephemeral container %s of pod %s in namespace %s was not injected: %s", containerName, er.pod.Name, er.pod.Namespace, reason)
+}
+
 func (er *EventRecorder) SendMissingDynaKubeEvent(namespaceName, dynakubeName string) {
 	template := "Namespace '%s' is assigned to DynaKube instance '%s' but this instance doesn't exist"
 	er.recorder.Eventf(
@@ -15,7 +15,16 @@ const (
 
 	NoBootstrapperConfigReason = "NoBootstrapperConfig"
 	NoMutationNeededReason     = "NoMutationNeeded"
+	// ContainerImageExcludedReason is used if the images of all containers are excluded from the OneAgent injection by the allow and deny lists of the DynaKube.
+	ContainerImageExcludedReason = "ContainerImageExcluded"
 
 	RootUser  int64 = 0
 	RootGroup int64 = 0
+
+	// operatorBinary is the path of the operator binary in the webhook image, which is used by the install container.
+	operatorBinary = "/usr/local/bin/dynatrace-operator"
+
+	// the startup probe of the native sidecar waits up to 10 minutes for the bootstrap, e.g. for the download of the code modules.
+	sidecarProbePeriodSeconds    = 2
+	sidecarProbeFailureThreshold = 300
 )
@@ -9,6 +9,7 @@ import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/annotations"
 	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/events"
 	dtwebhook "github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator"
+	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator/oneagent"
 	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/secrets"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -22,8 +23,9 @@ type Handler struct {
 	kubeClient client.Client
 	apiReader  client.Reader
 
-	webhookPodImage string
-	isOpenShift     bool
+	webhookPodImage        string
+	isOpenShift            bool
+	supportsNativeSidecars bool
 }
 
 func New( //nolint:revive
@@ -31,18 +33,20 @@ func New( //nolint:revive
 	apiReader client.Reader,
 	recorder events.EventRecorder,
 	webhookPodImage string,
-	isOpenShift bool,
+	isOpenShift,
+	supportsNativeSidecars bool,
 	metaMutator,
 	oaMutator dtwebhook.Mutator,
 ) *Handler {
 	return &Handler{
-		kubeClient:      kubeClient,
-		apiReader:       apiReader,
-		recorder:        recorder,
-		webhookPodImage: webhookPodImage,
-		isOpenShift:     isOpenShift,
-		metaMutator:     metaMutator,
-		oaMutator:       oaMutator,
+		kubeClient:             kubeClient,
+		apiReader:              apiReader,
+		recorder:               recorder,
+		webhookPodImage:        webhookPodImage,
+		isOpenShift:            isOpenShift,
+		supportsNativeSidecars: supportsNativeSidecars,
+		metaMutator:            metaMutator,
+		oaMutator:              oaMutator,
 	}
 }
 
@@ -83,11 +87,16 @@ func (h *Handler) Handle(mutationRequest *dtwebhook.MutationRequest) error {
 		}
 
 		if !mutated {
+			reason := NoMutationNeededReason
+			if isExcludedByImage(mutationRequest) {
+				reason = ContainerImageExcludedReason
+			}
+
 			annotations.SetNotInjected(
 				mutationRequest,
 				dtwebhook.AnnotationDynatraceInjected,
 				dtwebhook.AnnotationDynatraceReason,
-				NoMutationNeededReason,
+				reason,
 			)
 
 			return nil
@@ -117,46 +126,106 @@ func (h *Handler) isInjected(mutationRequest *dtwebhook.MutationRequest) bool {
 }
 
 func (h *Handler) handlePodMutation(mutationRequest *dtwebhook.MutationRequest) (bool, error) {
+	h.setDetectedTechnologies(mutationRequest)
+
 	mutationRequest.InstallContainer = h.createInitContainerBase(mutationRequest.Pod, mutationRequest.DynaKube)
 
-	var mutated bool
+	var mutated []string
 
-	if h.oaMutator.IsEnabled(mutationRequest.BaseRequest) {
-		err := h.oaMutator.Mutate(mutationRequest)
-		if err != nil {
-			return false, err
-		}
+	oaMutated, err := h.mutateOneAgent(mutationRequest)
+	if err != nil {
+		return false, err
+	} else if oaMutated {
+		mutated = append(mutated, dtwebhook.OneAgentMutatorName)
+	}
 
-		mutated = true
+	metaMutated, err := mutate(mutationRequest, h.metaMutator, dtwebhook.MetadataMutatorName, mutationRequest.DynaKube.MetadataEnrichment().IsEnabled())
+	if err != nil {
+		return false, err
+	} else if metaMutated {
+		mutated = append(mutated, dtwebhook.MetadataMutatorName)
 	}
 
-	if h.metaMutator.IsEnabled(mutationRequest.BaseRequest) {
-		err := h.metaMutator.Mutate(mutationRequest)
-		if err != nil {
-			return false, err
+	if len(mutated) == 0 {
+		return false, nil
+	}
+
+	if err := h.addInitContainer(mutationRequest); err != nil {
+		for _, name := range mutated {
+			mutationRequest.RecordFailed(name, err)
 		}
 
-		mutated = true
+		return false, err
 	}
 
-	if mutated {
-		_, err := addContainerAttributes(mutationRequest)
-		if err != nil {
-			return false, err
-		}
+	for _, name := range mutated {
+		mutationRequest.RecordInjected(name)
+	}
 
-		err = addPodAttributes(mutationRequest)
-		if err != nil {
-			log.Info("failed to add pod attributes to init-container")
+	return true, nil
+}
+
+// mutateOneAgent runs the OneAgent mutator, unless the images of all containers are excluded from the injection.
+func (h *Handler) mutateOneAgent(mutationRequest *dtwebhook.MutationRequest) (bool, error) {
+	if isExcludedByImage(mutationRequest) {
+		log.Info("images of all containers are excluded, skipping OneAgent injection", "podName", mutationRequest.PodName(), "namespace", mutationRequest.Namespace.Name)
+
+		annotations.SetNotInjected(
+			mutationRequest,
+			oneagent.AnnotationInjected,
+			oneagent.AnnotationReason,
+			ContainerImageExcludedReason,
+		)
+		mutationRequest.RecordSkipped(dtwebhook.OneAgentMutatorName, ContainerImageExcludedReason)
+
+		return false, nil
+	}
+
+	return mutate(mutationRequest, h.oaMutator, dtwebhook.OneAgentMutatorName, mutationRequest.DynaKube.OneAgent().IsAppInjectionNeeded())
+}
 
-			return false, err
+// isExcludedByImage checks if the OneAgent would be injected into the pod, if the images of all containers weren't excluded.
+func isExcludedByImage(mutationRequest *dtwebhook.MutationRequest) bool {
+	return oneagent.IsEnabled(mutationRequest.BaseRequest) && oneagent.AreAllContainersExcludedByImage(mutationRequest.BaseRequest)
+}
+
+// mutate runs the mutator if it is enabled for the pod.
+// Mutators that are configured in the DynaKube, but not enabled for the pod, are recorded as skipped.
+func mutate(mutationRequest *dtwebhook.MutationRequest, mutator dtwebhook.Mutator, name string, configured bool) (bool, error) {
+	if !mutator.IsEnabled(mutationRequest.BaseRequest) {
+		if configured {
+			mutationRequest.RecordSkipped(name, dtwebhook.NotEnabledReason)
 		}
 
-		addInitContainerToPod(mutationRequest.Pod, mutationRequest.InstallContainer)
-		h.recorder.SendPodInjectEvent()
+		return false, nil
+	}
+
+	if err := mutator.Mutate(mutationRequest); err != nil {
+		mutationRequest.RecordFailed(name, err)
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (h *Handler) addInitContainer(mutationRequest *dtwebhook.MutationRequest) error {
+	_, err := addContainerAttributes(mutationRequest)
+	if err != nil {
+		return err
+	}
+
+	err = addPodAttributes(mutationRequest)
+	if err != nil {
+		log.Info("failed to add pod attributes to init-container")
+
+		return err
 	}
 
-	return mutated, nil
+	addInitContainerToPod(mutationRequest.Pod, mutationRequest.InstallContainer)
+	h.recorder.SendPodInjectEvent()
+
+	return nil
 }
 
 func (h *Handler) handlePodReinvocation(mutationRequest *dtwebhook.MutationRequest) bool {
@@ -180,6 +249,10 @@ func (h *Handler) handlePodReinvocation(mutationRequest *dtwebhook.MutationReque
 
 func (h *Handler) isInputSecretPresent(mutationRequest *dtwebhook.MutationRequest, sourceSecretName, targetSecretName string) bool {
 	err := secrets.EnsureReplicated(mutationRequest, h.kubeClient, h.apiReader, sourceSecretName, targetSecretName, log)
+	if err != nil {
+		h.recordSkipped(mutationRequest, NoBootstrapperConfigReason)
+	}
+
 	if k8serrors.IsNotFound(err) {
 		log.Info(fmt.Sprintf("unable to copy source of %s as it is not available, injection not possible", sourceSecretName), "pod", mutationRequest.PodName())
 
@@ -208,3 +281,14 @@ func (h *Handler) isInputSecretPresent(mutationRequest *dtwebhook.MutationReques
 
 	return true
 }
+
+// recordSkipped records all mutators configured in the DynaKube as skipped, for reasons that prevent the injection as a whole.
+func (h *Handler) recordSkipped(mutationRequest *dtwebhook.MutationRequest, reason string) {
+	if mutationRequest.DynaKube.OneAgent().IsAppInjectionNeeded() {
+		mutationRequest.RecordSkipped(dtwebhook.OneAgentMutatorName, reason)
+	}
+
+	if mutationRequest.DynaKube.MetadataEnrichment().IsEnabled() {
+		mutationRequest.RecordSkipped(dtwebhook.MetadataMutatorName, reason)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8scontainer"
 	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/annotations"
 	dtwebhook "github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator"
+	oacommon "github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator/oneagent"
 	webhookmock "github.com/Dynatrace/dynatrace-operator/test/mocks/pkg/webhook/mutation/pod/mutator"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -229,6 +230,48 @@ func TestHandleImpl(t *testing.T) {
 		require.Nil(t, installContainer)
 	})
 
+	t.Run("images of all containers excluded => no OneAgent injection + reason annotations", func(t *testing.T) {
+		metaMutator := webhookmock.NewMutator(t)
+		metaMutator.On("IsEnabled", mock.Anything).Return(false)
+
+		h := createTestHandler(webhookmock.NewMutator(t), metaMutator, &initSecret, &certsSecret)
+
+		dk := getTestDynakube()
+		dk.Spec.OneAgent.CloudNativeFullStack.ContainerImages = &oneagent.ContainerImagesSpec{Deny: []string{"alp*"}}
+
+		request := createTestMutationRequest(dk)
+
+		err := h.Handle(request)
+		require.NoError(t, err)
+
+		assert.Equal(t, "false", request.Pod.Annotations[dtwebhook.AnnotationDynatraceInjected])
+		assert.Equal(t, ContainerImageExcludedReason, request.Pod.Annotations[dtwebhook.AnnotationDynatraceReason])
+		assert.Equal(t, "false", request.Pod.Annotations[oacommon.AnnotationInjected])
+		assert.Equal(t, ContainerImageExcludedReason, request.Pod.Annotations[oacommon.AnnotationReason])
+		assert.Contains(t, request.Outcomes(), dtwebhook.Outcome{Mutator: dtwebhook.OneAgentMutatorName, Result: dtwebhook.SkippedResult, Reason: ContainerImageExcludedReason})
+		assert.Nil(t, k8scontainer.FindInitInPodSpec(&request.Pod.Spec, dtwebhook.InstallContainerName))
+	})
+
+	t.Run("images of all containers excluded, but metadata enrichment => only OneAgent reason annotation", func(t *testing.T) {
+		metaMutator := webhookmock.NewMutator(t)
+		metaMutator.On("IsEnabled", mock.Anything).Return(true)
+		metaMutator.On("Mutate", mock.Anything).Return(nil)
+
+		h := createTestHandler(webhookmock.NewMutator(t), metaMutator, &initSecret, &certsSecret)
+
+		dk := getTestDynakube()
+		dk.Spec.OneAgent.CloudNativeFullStack.ContainerImages = &oneagent.ContainerImagesSpec{Deny: []string{"alp*"}}
+
+		request := createTestMutationRequest(dk)
+
+		err := h.Handle(request)
+		require.NoError(t, err)
+
+		assert.Equal(t, "true", request.Pod.Annotations[dtwebhook.AnnotationDynatraceInjected])
+		assert.NotContains(t, request.Pod.Annotations, dtwebhook.AnnotationDynatraceReason)
+		assert.Equal(t, ContainerImageExcludedReason, request.Pod.Annotations[oacommon.AnnotationReason])
+	})
+
 	t.Run("happy path - reinvoke", func(t *testing.T) {
 		oaMutator := webhookmock.NewMutator(t)
 		oaMutator.On("IsEnabled", mock.Anything).Return(true)
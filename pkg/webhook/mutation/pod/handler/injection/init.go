@@ -5,6 +5,7 @@ import (
 	"github.com/Dynatrace/dynatrace-bootstrapper/cmd/k8sinit/configure"
 	"github.com/Dynatrace/dynatrace-operator/cmd/bootstrapper"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/sizing"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8sresource"
 	maputils "github.com/Dynatrace/dynatrace-operator/pkg/util/map"
 	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/arg"
@@ -31,20 +32,72 @@ func (h *Handler) createInitContainerBase(pod *corev1.Pod, dk dynakube.DynaKube)
 		args = append(args, arg.Arg{Name: k8sinit.SuppressErrorsFlag})
 	}
 
+	isNativeSidecar := h.isNativeSidecar(dk)
+	if isNativeSidecar {
+		args = append(args,
+			arg.Arg{Name: bootstrapper.SidecarFlag},
+			arg.Arg{Name: bootstrapper.StateFolderFlag, Value: volumes.InitSidecarStateMountPath},
+		)
+	}
+
 	initContainer := &corev1.Container{
 		Name:            dtwebhook.InstallContainerName,
 		Image:           h.webhookPodImage,
 		ImagePullPolicy: corev1.PullIfNotPresent,
 		SecurityContext: securityContextForInitContainer(pod, dk, h.isOpenShift),
-		Resources:       defaultInitContainerResources(),
+		Resources:       initContainerResources(dk),
 		Args:            []string{bootstrapper.Use},
 	}
 
 	initContainer.Args = append(initContainer.Args, arg.ConvertArgsToStrings(args)...)
 
+	if isNativeSidecar {
+		setNativeSidecar(initContainer)
+	}
+
 	return initContainer
 }
 
+// isNativeSidecar checks if the install container should be added as a native sidecar, which has to be enabled via feature-flag and supported by the cluster.
+func (h *Handler) isNativeSidecar(dk dynakube.DynaKube) bool {
+	if !dk.FF().IsNativeSidecarInjection() {
+		return false
+	}
+
+	if !h.supportsNativeSidecars {
+		log.Debug("native sidecars are not supported by the cluster, using an init container instead", "dynakube", dk.Name)
+
+		return false
+	}
+
+	return true
+}
+
+// setNativeSidecar turns the install container into a restartable init container, that keeps running next to the application.
+// The startup probe makes sure the application containers are only started once the bootstrap is done.
+func setNativeSidecar(initContainer *corev1.Container) {
+	initContainer.RestartPolicy = ptr.To(corev1.ContainerRestartPolicyAlways)
+	initContainer.StartupProbe = &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			Exec: &corev1.ExecAction{
+				Command: append(
+					[]string{operatorBinary, bootstrapper.Use},
+					arg.ConvertArgsToStrings([]arg.Arg{
+						{Name: bootstrapper.CheckReadyFlag},
+						{Name: bootstrapper.StateFolderFlag, Value: volumes.InitSidecarStateMountPath},
+					})...,
+				),
+			},
+		},
+		PeriodSeconds:    sidecarProbePeriodSeconds,
+		FailureThreshold: sidecarProbeFailureThreshold,
+	}
+}
+
+func runsAsNativeSidecar(initContainer *corev1.Container) bool {
+	return initContainer.RestartPolicy != nil && *initContainer.RestartPolicy == corev1.ContainerRestartPolicyAlways
+}
+
 func areErrorsSuppressed(pod *corev1.Pod, dk dynakube.DynaKube) bool {
 	return maputils.GetField(pod.Annotations, dtwebhook.AnnotationFailurePolicy, dk.FF().GetInjectionFailurePolicy()) != "fail" // safer than == silent
 }
@@ -54,14 +107,28 @@ func addInitContainerToPod(pod *corev1.Pod, initContainer *corev1.Container) {
 	volumes.AddInitInputVolumeMount(initContainer)
 	volumes.AddInputVolume(pod)
 	volumes.AddConfigVolume(pod)
+
+	if runsAsNativeSidecar(initContainer) {
+		volumes.AddInitSidecarStateVolumeMount(initContainer)
+		volumes.AddSidecarStateVolume(pod)
+	}
+
 	pod.Spec.InitContainers = append(pod.Spec.InitContainers, *initContainer)
 }
 
-func defaultInitContainerResources() corev1.ResourceRequirements {
-	return corev1.ResourceRequirements{
+// initContainerResources returns the resources of the sizing profile, completed by the defaults.
+// These are only kept if the CSI driver provides the code modules, see the OneAgent mutator.
+func initContainerResources(dk dynakube.DynaKube) corev1.ResourceRequirements {
+	defaults := corev1.ResourceRequirements{
 		Requests: k8sresource.NewResourceList("30m", "30Mi"),
 		Limits:   k8sresource.NewResourceList("100m", "60Mi"),
 	}
+
+	if profile := dk.Sizing().InitContainer; profile != nil {
+		return sizing.Merge(*profile, &defaults)
+	}
+
+	return defaults
 }
 
 func securityContextForInitContainer(pod *corev1.Pod, dk dynakube.DynaKube, isOpenShift bool) *corev1.SecurityContext {
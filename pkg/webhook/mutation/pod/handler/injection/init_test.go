@@ -8,8 +8,10 @@ import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/exp"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/oneagent"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/sizing"
 	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8smount"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8sresource"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8svolume"
 	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/events"
 	dtwebhook "github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator"
@@ -194,6 +196,68 @@ func TestCreateInitContainerBase(t *testing.T) {
 	})
 }
 
+func TestCreateInitContainerBaseNativeSidecar(t *testing.T) {
+	t.Run("feature-flag set and supported by the cluster => native sidecar", func(t *testing.T) {
+		wh := createTestHandler(webhookmock.NewMutator(t), webhookmock.NewMutator(t))
+		wh.supportsNativeSidecars = true
+		dk := getTestDynakube()
+		dk.Annotations = map[string]string{exp.InjectionNativeSidecarKey: "true"}
+
+		initContainer := wh.createInitContainerBase(getTestPod(), *dk)
+
+		require.NotNil(t, initContainer.RestartPolicy)
+		assert.Equal(t, corev1.ContainerRestartPolicyAlways, *initContainer.RestartPolicy)
+		assert.Contains(t, initContainer.Args, "--"+bootstrapper.SidecarFlag)
+		require.NotNil(t, initContainer.StartupProbe)
+		require.NotNil(t, initContainer.StartupProbe.Exec)
+		assert.Equal(t, []string{
+			operatorBinary,
+			bootstrapper.Use,
+			"--" + bootstrapper.CheckReadyFlag,
+			"--" + bootstrapper.StateFolderFlag + "=" + volumes.InitSidecarStateMountPath,
+		}, initContainer.StartupProbe.Exec.Command)
+		assert.Contains(t, initContainer.Args, "--"+bootstrapper.StateFolderFlag+"="+volumes.InitSidecarStateMountPath)
+
+		pod := getTestPod()
+		addInitContainerToPod(pod, initContainer)
+
+		assert.True(t, k8svolume.Contains(pod.Spec.Volumes, volumes.SidecarStateVolumeName))
+		assert.True(t, k8smount.ContainsPath(pod.Spec.InitContainers[len(pod.Spec.InitContainers)-1].VolumeMounts, volumes.InitSidecarStateMountPath))
+
+		for _, container := range pod.Spec.Containers {
+			assert.False(t, k8smount.ContainsPath(container.VolumeMounts, volumes.InitSidecarStateMountPath))
+		}
+	})
+
+	t.Run("not supported by the cluster => init container", func(t *testing.T) {
+		wh := createTestHandler(webhookmock.NewMutator(t), webhookmock.NewMutator(t))
+		dk := getTestDynakube()
+		dk.Annotations = map[string]string{exp.InjectionNativeSidecarKey: "true"}
+
+		initContainer := wh.createInitContainerBase(getTestPod(), *dk)
+
+		assert.Nil(t, initContainer.RestartPolicy)
+		assert.Nil(t, initContainer.StartupProbe)
+		assert.NotContains(t, initContainer.Args, "--"+bootstrapper.SidecarFlag)
+	})
+
+	t.Run("feature-flag not set => init container", func(t *testing.T) {
+		wh := createTestHandler(webhookmock.NewMutator(t), webhookmock.NewMutator(t))
+		wh.supportsNativeSidecars = true
+
+		initContainer := wh.createInitContainerBase(getTestPod(), *getTestDynakube())
+
+		assert.Nil(t, initContainer.RestartPolicy)
+		assert.Nil(t, initContainer.StartupProbe)
+		assert.NotContains(t, initContainer.Args, "--"+bootstrapper.SidecarFlag)
+
+		pod := getTestPod()
+		addInitContainerToPod(pod, initContainer)
+
+		assert.False(t, k8svolume.Contains(pod.Spec.Volumes, volumes.SidecarStateVolumeName))
+	})
+}
+
 func createTestHandler(oaMut, metaMut dtwebhook.Mutator, objects ...client.Object) *Handler {
 	fakeClient := fake.NewClient(objects...)
 
@@ -203,6 +267,7 @@ func createTestHandler(oaMut, metaMut dtwebhook.Mutator, objects ...client.Objec
 		events.NewRecorder(record.NewFakeRecorder(10)),
 		testWebhookImage,
 		false,
+		false,
 		metaMut,
 		oaMut,
 	)
@@ -210,6 +275,37 @@ func createTestHandler(oaMut, metaMut dtwebhook.Mutator, objects ...client.Objec
 	return handler
 }
 
+func TestInitContainerResources(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		resources := initContainerResources(*getTestDynakube())
+
+		assert.Equal(t, k8sresource.NewResourceList("30m", "30Mi"), resources.Requests)
+		assert.Equal(t, k8sresource.NewResourceList("100m", "60Mi"), resources.Limits)
+	})
+
+	t.Run("sizing profile", func(t *testing.T) {
+		dk := getTestDynakube()
+		dk.Spec.Sizing = &sizing.Spec{Profile: sizing.LargeProfile}
+
+		assert.Equal(t, *dk.Sizing().InitContainer, initContainerResources(*dk))
+	})
+
+	t.Run("custom profile is completed by the defaults", func(t *testing.T) {
+		dk := getTestDynakube()
+		dk.Spec.Sizing = &sizing.Spec{
+			Profile: sizing.CustomProfile,
+			Custom: &sizing.Resources{
+				InitContainer: &corev1.ResourceRequirements{Limits: k8sresource.NewResourceList("1", "1Gi")},
+			},
+		}
+
+		resources := initContainerResources(*dk)
+
+		assert.Equal(t, k8sresource.NewResourceList("30m", "30Mi"), resources.Requests)
+		assert.Equal(t, k8sresource.NewResourceList("1", "1Gi"), resources.Limits)
+	})
+}
+
 func TestAddInitContainerToPod(t *testing.T) {
 	t.Run("adds common volumes/mounts", func(t *testing.T) {
 		pod := corev1.Pod{}
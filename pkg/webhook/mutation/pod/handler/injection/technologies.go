@@ -0,0 +1,46 @@
+package injection
+
+import (
+	"github.com/Dynatrace/dynatrace-operator/pkg/injection/imageindex"
+	dtwebhook "github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator"
+	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator/oneagent"
+)
+
+// setDetectedTechnologies sets the technologies annotation based on the image index of the DynaKube, so only the needed code modules are downloaded.
+// Technologies configured on the pod or the DynaKube take precedence, if the technologies of any image are unknown, all code modules are downloaded.
+// The index is read through the snapshot of the webhook, which serves the ConfigMaps of the DynaKube namespace from the informer cache.
+func (h *Handler) setDetectedTechnologies(mutationRequest *dtwebhook.MutationRequest) {
+	dk := mutationRequest.DynaKube
+	if !dk.OneAgent().IsAppInjectionNeeded() || !dk.FF().IsTechnologyDetection() || dk.FF().GetNodeImagePullTechnology() != "" {
+		return
+	}
+
+	if _, ok := mutationRequest.Pod.Annotations[oneagent.AnnotationTechnologies]; ok {
+		return
+	}
+
+	var images []string
+
+	for _, container := range mutationRequest.Pod.Spec.Containers {
+		if !oneagent.IsContainerExcluded(mutationRequest.BaseRequest, container) {
+			images = append(images, container.Image)
+		}
+	}
+
+	technologies, ok, err := imageindex.GetTechnologies(mutationRequest.Context, h.apiReader, dk.Name, dk.Namespace, images)
+	if err != nil {
+		log.Info("failed to read image index, injecting all technologies", "podName", mutationRequest.PodName(), "error", err.Error())
+
+		return
+	} else if !ok {
+		return
+	}
+
+	log.Info("detected technologies for pod", "podName", mutationRequest.PodName(), "namespace", mutationRequest.Namespace.Name, "technologies", technologies)
+
+	if mutationRequest.Pod.Annotations == nil {
+		mutationRequest.Pod.Annotations = make(map[string]string)
+	}
+
+	mutationRequest.Pod.Annotations[oneagent.AnnotationTechnologies] = technologies
+}
@@ -0,0 +1,66 @@
+package injection
+
+import (
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/exp"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/injection/imageindex"
+	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator/oneagent"
+	webhookmock "github.com/Dynatrace/dynatrace-operator/test/mocks/pkg/webhook/mutation/pod/mutator"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSetDetectedTechnologies(t *testing.T) {
+	indexConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: imageindex.GetConfigMapName(testDynakubeName), Namespace: testNamespaceName},
+		Data:       map[string]string{imageindex.IndexDataKey: `{"alpine":["java"]}`},
+	}
+
+	createDynakube := func() *dynakube.DynaKube {
+		dk := getTestDynakube()
+		dk.Annotations = map[string]string{exp.InjectionTechnologyDetectionKey: "true"}
+
+		return dk
+	}
+
+	t.Run("detected technologies are set", func(t *testing.T) {
+		h := createTestHandler(webhookmock.NewMutator(t), webhookmock.NewMutator(t), indexConfigMap)
+		request := createTestMutationRequest(createDynakube())
+
+		h.setDetectedTechnologies(request)
+
+		assert.Equal(t, "java", request.Pod.Annotations[oneagent.AnnotationTechnologies])
+	})
+
+	t.Run("technologies of the pod are not overwritten", func(t *testing.T) {
+		h := createTestHandler(webhookmock.NewMutator(t), webhookmock.NewMutator(t), indexConfigMap)
+		request := createTestMutationRequest(createDynakube())
+		request.Pod.Annotations = map[string]string{oneagent.AnnotationTechnologies: "nodejs"}
+
+		h.setDetectedTechnologies(request)
+
+		assert.Equal(t, "nodejs", request.Pod.Annotations[oneagent.AnnotationTechnologies])
+	})
+
+	t.Run("unknown image => no technologies", func(t *testing.T) {
+		h := createTestHandler(webhookmock.NewMutator(t), webhookmock.NewMutator(t), indexConfigMap)
+		request := createTestMutationRequest(createDynakube())
+		request.Pod.Spec.Containers = append(request.Pod.Spec.Containers, corev1.Container{Name: "sidecar", Image: "unknown"})
+
+		h.setDetectedTechnologies(request)
+
+		assert.NotContains(t, request.Pod.Annotations, oneagent.AnnotationTechnologies)
+	})
+
+	t.Run("feature flag disabled => no technologies", func(t *testing.T) {
+		h := createTestHandler(webhookmock.NewMutator(t), webhookmock.NewMutator(t), indexConfigMap)
+		request := createTestMutationRequest(getTestDynakube())
+
+		h.setDetectedTechnologies(request)
+
+		assert.NotContains(t, request.Pod.Annotations, oneagent.AnnotationTechnologies)
+	})
+}
@@ -44,7 +44,9 @@ func (h *Handler) Handle(mutationRequest *dtwebhook.MutationRequest) error {
 	// the execution of both the env var mutator and the resource attribute mutator
 	// is controlled by the env var mutator's IsEnabled method
 	// therefore, we only need to check it here
-	if h.envVarMutator.IsEnabled(mutationRequest.BaseRequest) {
+	if !h.envVarMutator.IsEnabled(mutationRequest.BaseRequest) {
+		recordSkipped(mutationRequest, dtwebhook.NotEnabledReason)
+	} else {
 		if !h.isTokenSecretPresent(
 			mutationRequest,
 			exporterconfig.GetSourceConfigSecretName(mutationRequest.DynaKube.Name),
@@ -71,11 +73,7 @@ func (h *Handler) Handle(mutationRequest *dtwebhook.MutationRequest) error {
 				log.Debug("OTLP resource attribute reinvocation policy applied", "podName", mutationRequest.PodName())
 			}
 		} else {
-			if err := h.envVarMutator.Mutate(mutationRequest); err != nil {
-				return err
-			}
-
-			if err := h.resourceAttributeMutator.Mutate(mutationRequest); err != nil {
+			if err := h.mutate(mutationRequest); err != nil {
 				return err
 			}
 		}
@@ -92,6 +90,31 @@ func (h *Handler) Handle(mutationRequest *dtwebhook.MutationRequest) error {
 	return nil
 }
 
+func (h *Handler) mutate(mutationRequest *dtwebhook.MutationRequest) error {
+	if err := h.envVarMutator.Mutate(mutationRequest); err != nil {
+		mutationRequest.RecordFailed(dtwebhook.OTLPExporterMutatorName, err)
+
+		return err
+	}
+
+	if err := h.resourceAttributeMutator.Mutate(mutationRequest); err != nil {
+		mutationRequest.RecordFailed(dtwebhook.OTLPResourceAttributesMutatorName, err)
+
+		return err
+	}
+
+	mutationRequest.RecordInjected(dtwebhook.OTLPExporterMutatorName)
+	mutationRequest.RecordInjected(dtwebhook.OTLPResourceAttributesMutatorName)
+
+	return nil
+}
+
+// recordSkipped records both OTLP mutators as skipped, as both are controlled by the env var mutator.
+func recordSkipped(mutationRequest *dtwebhook.MutationRequest, reason string) {
+	mutationRequest.RecordSkipped(dtwebhook.OTLPExporterMutatorName, reason)
+	mutationRequest.RecordSkipped(dtwebhook.OTLPResourceAttributesMutatorName, reason)
+}
+
 func (h *Handler) isTokenSecretPresent(mutationRequest *dtwebhook.MutationRequest, sourceSecretName string) bool {
 	err := secrets.EnsureReplicated(mutationRequest, h.kubeClient, h.apiReader, sourceSecretName, consts.OTLPExporterSecretName, log)
 	if k8serrors.IsNotFound(err) {
@@ -101,6 +124,7 @@ func (h *Handler) isTokenSecretPresent(mutationRequest *dtwebhook.MutationReques
 			dtwebhook.AnnotationOTLPReason,
 			NoOTLPExporterConfigSecretReason,
 		)
+		recordSkipped(mutationRequest, NoOTLPExporterConfigSecretReason)
 
 		return false
 	}
@@ -114,6 +138,7 @@ func (h *Handler) isTokenSecretPresent(mutationRequest *dtwebhook.MutationReques
 			dtwebhook.AnnotationOTLPReason,
 			NoOTLPExporterConfigSecretReason,
 		)
+		recordSkipped(mutationRequest, NoOTLPExporterConfigSecretReason)
 
 		return false
 	}
@@ -135,6 +160,7 @@ func (h *Handler) isExporterActiveGateCertSecretPresent(mutationRequest *dtwebho
 			dtwebhook.AnnotationOTLPReason,
 			NoOTLPExporterActiveGateCertSecretReason,
 		)
+		recordSkipped(mutationRequest, NoOTLPExporterActiveGateCertSecretReason)
 
 		return false
 	}
@@ -148,6 +174,7 @@ func (h *Handler) isExporterActiveGateCertSecretPresent(mutationRequest *dtwebho
 			dtwebhook.AnnotationOTLPReason,
 			NoOTLPExporterActiveGateCertSecretReason,
 		)
+		recordSkipped(mutationRequest, NoOTLPExporterActiveGateCertSecretReason)
 
 		return false
 	}
@@ -0,0 +1,73 @@
+package pod
+
+import (
+	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const metricsSubsystem = "webhook"
+
+var (
+	injectionsMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "dynatrace",
+		Subsystem: metricsSubsystem,
+		Name:      "injections_total",
+		Help:      "Number of pods mutated by the webhook, per mutator",
+	}, []string{"dynakube", "namespace", "mutator"})
+
+	skipsMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "dynatrace",
+		Subsystem: metricsSubsystem,
+		Name:      "injection_skips_total",
+		Help:      "Number of pods not mutated by the webhook, per mutator and reason",
+	}, []string{"dynakube", "namespace", "mutator", "reason"})
+
+	failuresMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "dynatrace",
+		Subsystem: metricsSubsystem,
+		Name:      "injection_failures_total",
+		Help:      "Number of pods the webhook failed to mutate, per mutator and reason",
+	}, []string{"dynakube", "namespace", "mutator", "reason"})
+
+	admissionDurationMetric = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "dynatrace",
+		Subsystem: metricsSubsystem,
+		Name:      "admission_duration_seconds",
+		Help:      "Time it took the webhook to handle a pod admission request",
+		Buckets:   prometheus.DefBuckets,
+	})
+)
+
+func init() {
+	metrics.Registry.MustRegister(injectionsMetric, skipsMetric, failuresMetric, admissionDurationMetric)
+}
+
+// recordSkipped counts a pod that isn't mutated at all as skipped by every mutator.
+func recordSkipped(mutationRequest *mutator.MutationRequest, reason string) {
+	for _, mutatorName := range mutator.MutatorNames {
+		mutationRequest.RecordSkipped(mutatorName, reason)
+	}
+
+	recordOutcomes(mutationRequest, false)
+}
+
+// recordOutcomes exposes the decisions of the mutators for the pod of the request.
+// If the mutation failed, the pod is admitted without any modifications, so no injections are counted.
+func recordOutcomes(mutationRequest *mutator.MutationRequest, failed bool) {
+	dkName := mutationRequest.DynaKube.Name
+	namespace := mutationRequest.Namespace.Name
+
+	for _, outcome := range mutationRequest.Outcomes() {
+		switch outcome.Result {
+		case mutator.InjectedResult:
+			if !failed {
+				injectionsMetric.WithLabelValues(dkName, namespace, outcome.Mutator).Inc()
+			}
+		case mutator.SkippedResult:
+			skipsMetric.WithLabelValues(dkName, namespace, outcome.Mutator, outcome.Reason).Inc()
+		case mutator.FailedResult:
+			failuresMetric.WithLabelValues(dkName, namespace, outcome.Mutator, outcome.Reason).Inc()
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package pod
+
+import (
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRecordOutcomes(t *testing.T) {
+	createRequest := func(namespace string) *mutator.MutationRequest {
+		return &mutator.MutationRequest{
+			BaseRequest: &mutator.BaseRequest{
+				Namespace: corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}},
+				DynaKube:  dynakube.DynaKube{ObjectMeta: metav1.ObjectMeta{Name: "dk"}},
+			},
+		}
+	}
+
+	t.Run("successful mutation", func(t *testing.T) {
+		request := createRequest("success")
+		request.RecordInjected(mutator.OneAgentMutatorName)
+		request.RecordSkipped(mutator.MetadataMutatorName, mutator.NotEnabledReason)
+
+		recordOutcomes(request, false)
+
+		assert.InDelta(t, 1, testutil.ToFloat64(injectionsMetric.WithLabelValues("dk", "success", mutator.OneAgentMutatorName)), 0)
+		assert.InDelta(t, 1, testutil.ToFloat64(skipsMetric.WithLabelValues("dk", "success", mutator.MetadataMutatorName, mutator.NotEnabledReason)), 0)
+	})
+
+	t.Run("failed mutation doesn't count injections", func(t *testing.T) {
+		request := createRequest("failure")
+		request.RecordInjected(mutator.OneAgentMutatorName)
+		request.RecordFailed(mutator.OTLPExporterMutatorName, mutator.MutatorError{Reason: "NoIngestEndpoint"})
+
+		recordOutcomes(request, true)
+
+		assert.InDelta(t, 0, testutil.ToFloat64(injectionsMetric.WithLabelValues("dk", "failure", mutator.OneAgentMutatorName)), 0)
+		assert.InDelta(t, 1, testutil.ToFloat64(failuresMetric.WithLabelValues("dk", "failure", mutator.OTLPExporterMutatorName, "NoIngestEndpoint")), 0)
+	})
+
+	t.Run("skipped pod counts as skipped by every mutator", func(t *testing.T) {
+		request := createRequest("skipped")
+
+		recordSkipped(request, mutator.InjectionDisabledReason)
+
+		for _, mutatorName := range mutator.MutatorNames {
+			assert.InDelta(t, 1, testutil.ToFloat64(skipsMetric.WithLabelValues("dk", "skipped", mutatorName, mutator.InjectionDisabledReason)), 0)
+		}
+	})
+}
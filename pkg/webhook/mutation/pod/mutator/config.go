@@ -14,6 +14,10 @@ const (
 	// AnnotationDynatraceReason is add to provide extra info why an injection didn't happen.
 	AnnotationDynatraceReason = "dynakube.dynatrace.com/reason"
 
+	// AnnotationDynatraceStaleSince is set by the webhook, if the Kubernetes API wasn't reachable during the admission of the Pod.
+	// The Pod was injected using the last known configuration, the value is the time the Kubernetes API was last reached.
+	AnnotationDynatraceStaleSince = "dynakube.dynatrace.com/stale-since"
+
 	// AnnotationDynatraceInject is set to "false" on the Pod to indicate that does not want any injection.
 	AnnotationDynatraceInject = "dynatrace.com/inject"
 
@@ -6,6 +6,8 @@ import corev1 "k8s.io/api/core/v1"
 type MutatorError struct {
 	Err      error
 	Annotate func(*corev1.Pod)
+	// Reason is the reason that is also put into the annotations, it is used for the failure metrics.
+	Reason string
 }
 
 func (e MutatorError) Error() string {
@@ -18,12 +18,12 @@ import (
 )
 
 type Mutator struct {
-	metaClient client.Client
+	apiReader client.Reader
 }
 
-func NewMutator(metaClient client.Client) dtwebhook.Mutator {
+func NewMutator(apiReader client.Reader) dtwebhook.Mutator {
 	return &Mutator{
-		metaClient: metaClient,
+		apiReader: apiReader,
 	}
 }
 
@@ -54,11 +54,12 @@ func (mut *Mutator) IsInjected(request *dtwebhook.BaseRequest) bool {
 func (mut *Mutator) Mutate(request *dtwebhook.MutationRequest) error {
 	log.Info("adding metadata-enrichment to pod", "name", request.PodName())
 
-	workloadInfo, err := workload.FindRootOwnerOfPod(request.Context, mut.metaClient, *request.BaseRequest, log)
+	workloadInfo, err := workload.FindRootOwnerOfPod(request.Context, mut.apiReader, *request.BaseRequest, log)
 	if err != nil {
 		return dtwebhook.MutatorError{
 			Err:      errors.WithStack(err),
 			Annotate: setNotInjectedAnnotationFunc(OwnerLookupFailedReason),
+			Reason:   OwnerLookupFailedReason,
 		}
 	}
 
@@ -16,10 +16,19 @@ const (
 	AnnotationInject   = AnnotationPrefix + ".dynatrace.com/inject"
 	AnnotationInjected = AnnotationPrefix + ".dynatrace.com/injected"
 	AnnotationReason   = AnnotationPrefix + ".dynatrace.com/reason"
+	// AnnotationVersion is set on injected pods to the version of the code modules at the time of the injection.
+	AnnotationVersion = AnnotationPrefix + ".dynatrace.com/version"
+	// AnnotationResourceOverhead is set on injected pods to the resource overhead that was added to each injected container, so it's only added once.
+	AnnotationResourceOverhead = AnnotationPrefix + ".dynatrace.com/resource-overhead"
 
 	MissingTenantUUIDReason      = "MissingTenantUUID"
 	DynaKubeStatusNotReadyReason = "DynaKubeStatusNotReady"
 
+	// reasons for ephemeral containers not being injected.
+	PodNotInjectedReason             = "PodNotInjected"
+	EphemeralContainerExcludedReason = "ContainerExcluded"
+	NoInjectedTargetContainerReason  = "NoInjectedTargetContainer"
+
 	// AnnotationTechnologies can be set on a Pod to configure which code module technologies to download. It's set to
 	// "all" if not set.
 	AnnotationTechnologies = exp.OANodeImagePullTechnologiesKey
@@ -59,4 +68,7 @@ const (
 	// This should be replaced by the `storage` property in the ruxitagentproc.conf
 	DtStorageEnv  = "DT_STORAGE"
 	DtStoragePath = volumes.ConfigMountPath + "/oneagent"
+
+	// EphemeralConfigMountPath is where the whole config volume is mounted into injected ephemeral containers, which can't use subPath mounts.
+	EphemeralConfigMountPath = "/var/lib/dynatrace-ephemeral"
 )
@@ -72,7 +72,7 @@ func addPreloadEnv(container *corev1.Container, installPath string) {
 	}
 }
 
-func addDtStorageEnv(container *corev1.Container) {
+func addDtStorageEnv(container *corev1.Container, storagePath string) {
 	storageEnv := k8senv.Find(container.Env, DtStorageEnv)
 	if storageEnv != nil {
 		return
@@ -81,7 +81,7 @@ func addDtStorageEnv(container *corev1.Container) {
 	container.Env = append(container.Env,
 		corev1.EnvVar{
 			Name:  DtStorageEnv,
-			Value: DtStoragePath,
+			Value: storagePath,
 		})
 }
 
@@ -0,0 +1,65 @@
+package oneagent
+
+import (
+	"path/filepath"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8scontainer"
+	maputils "github.com/Dynatrace/dynatrace-operator/pkg/util/map"
+	dtwebhook "github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// MutateEphemeralContainer adds the OneAgent to an ephemeral container of an injected pod, e.g. one added by kubectl debug.
+// The install container doesn't run again, so the code modules of the pod and the configuration of the target container are reused.
+// If the ephemeral container is not injected, the reason is returned, containers that are already injected have no reason.
+func MutateEphemeralContainer(request *dtwebhook.BaseRequest, ephemeralContainer *corev1.EphemeralContainer) (bool, string) {
+	container := (*corev1.Container)(&ephemeralContainer.EphemeralContainerCommon)
+
+	if containerIsInjected(*container, request) {
+		return false, ""
+	}
+
+	if !maputils.GetFieldBool(request.Pod.Annotations, AnnotationInjected, false) {
+		return false, PodNotInjectedReason
+	}
+
+	if IsContainerExcluded(request, *container) {
+		return false, EphemeralContainerExcludedReason
+	}
+
+	source := findInjectedContainer(request, ephemeralContainer.TargetContainerName)
+	if source == nil {
+		return false, NoInjectedTargetContainerReason
+	}
+
+	log.Info("adding OneAgent to ephemeral container", "name", container.Name, "source", source.Name)
+
+	// ephemeral containers can't use subPath mounts, so the whole config volume is mounted, and the envs point to the configuration of the target container.
+	// The OneAgent is preloaded by the LD_PRELOAD env only, as /etc/ld.so.preload can't be mounted.
+	installPath := maputils.GetField(request.Pod.Annotations, AnnotationInstallPath, DefaultInstallPath)
+	addBinVolumeMount(container, installPath)
+	addEphemeralConfigVolumeMount(container)
+	addEnvs(request.DynaKube, container, request.Namespace, installPath, filepath.Join(EphemeralConfigMountPath, source.Name, "oneagent"))
+
+	return true, ""
+}
+
+// findInjectedContainer returns the target container, or the first injected container if no target is set.
+func findInjectedContainer(request *dtwebhook.BaseRequest, targetContainerName string) *corev1.Container {
+	if targetContainerName != "" {
+		target := k8scontainer.FindInPodSpec(&request.Pod.Spec, targetContainerName)
+		if target == nil || !containerIsInjected(*target, request) {
+			return nil
+		}
+
+		return target
+	}
+
+	for i := range request.Pod.Spec.Containers {
+		if containerIsInjected(request.Pod.Spec.Containers[i], request) {
+			return &request.Pod.Spec.Containers[i]
+		}
+	}
+
+	return nil
+}
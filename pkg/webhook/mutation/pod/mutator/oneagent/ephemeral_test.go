@@ -0,0 +1,106 @@
+package oneagent
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-bootstrapper/pkg/configure/oneagent/preload"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8senv"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8smount"
+	dtwebhook "github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator"
+	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/volumes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func createEphemeralRequest(t *testing.T) *dtwebhook.BaseRequest {
+	t.Helper()
+
+	request := createTestMutationRequestWithoutInjectedContainers().BaseRequest
+	setInjectedAnnotation(request.Pod)
+
+	for i := range request.Pod.Spec.Containers {
+		container := &request.Pod.Spec.Containers[i]
+		addOneAgentToContainer(request.DynaKube, container, request.Namespace, DefaultInstallPath)
+		volumes.AddConfigVolumeMount(container, request)
+	}
+
+	return request
+}
+
+func TestMutateEphemeralContainer(t *testing.T) {
+	t.Run("ephemeral container gets the OneAgent and the configuration of the target container", func(t *testing.T) {
+		request := createEphemeralRequest(t)
+		target := request.Pod.Spec.Containers[1]
+		ephemeralContainer := corev1.EphemeralContainer{
+			EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "debugger", Image: "busybox"},
+			TargetContainerName:      target.Name,
+		}
+
+		injected, reason := MutateEphemeralContainer(request, &ephemeralContainer)
+
+		require.True(t, injected)
+		assert.Empty(t, reason)
+		assert.True(t, k8smount.Contains(ephemeralContainer.VolumeMounts, BinVolumeName))
+		assert.Contains(t, ephemeralContainer.VolumeMounts, corev1.VolumeMount{Name: volumes.ConfigVolumeName, MountPath: EphemeralConfigMountPath})
+
+		for _, mount := range ephemeralContainer.VolumeMounts {
+			assert.Empty(t, mount.SubPath, "ephemeral containers can't use subPath mounts")
+		}
+
+		assert.Equal(t, filepath.Join(EphemeralConfigMountPath, target.Name, "oneagent"), k8senv.Find(ephemeralContainer.Env, DtStorageEnv).Value)
+		assert.Equal(t, filepath.Join(DefaultInstallPath, preload.LibAgentProcPath), k8senv.Find(ephemeralContainer.Env, PreloadEnv).Value)
+
+		injected, reason = MutateEphemeralContainer(request, &ephemeralContainer)
+
+		assert.False(t, injected)
+		assert.Empty(t, reason)
+	})
+
+	t.Run("no target => first injected container is used", func(t *testing.T) {
+		request := createEphemeralRequest(t)
+		ephemeralContainer := corev1.EphemeralContainer{EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "debugger"}}
+
+		injected, _ := MutateEphemeralContainer(request, &ephemeralContainer)
+
+		require.True(t, injected)
+		assert.Equal(t, filepath.Join(EphemeralConfigMountPath, request.Pod.Spec.Containers[0].Name, "oneagent"), k8senv.Find(ephemeralContainer.Env, DtStorageEnv).Value)
+	})
+
+	t.Run("pod not injected", func(t *testing.T) {
+		request := createTestMutationRequestWithoutInjectedContainers().BaseRequest
+		ephemeralContainer := corev1.EphemeralContainer{EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "debugger"}}
+
+		injected, reason := MutateEphemeralContainer(request, &ephemeralContainer)
+
+		assert.False(t, injected)
+		assert.Equal(t, PodNotInjectedReason, reason)
+		assert.Empty(t, ephemeralContainer.VolumeMounts)
+	})
+
+	t.Run("container excluded", func(t *testing.T) {
+		request := createEphemeralRequest(t)
+		request.Pod.Annotations[dtwebhook.AnnotationContainerInjection+"/debugger"] = "false"
+		ephemeralContainer := corev1.EphemeralContainer{EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "debugger"}}
+
+		injected, reason := MutateEphemeralContainer(request, &ephemeralContainer)
+
+		assert.False(t, injected)
+		assert.Equal(t, EphemeralContainerExcludedReason, reason)
+	})
+
+	t.Run("target container not injected", func(t *testing.T) {
+		request := createEphemeralRequest(t)
+		request.Pod.Spec.Containers = append(request.Pod.Spec.Containers, corev1.Container{Name: "sidecar"})
+		ephemeralContainer := corev1.EphemeralContainer{
+			EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "debugger"},
+			TargetContainerName:      "sidecar",
+		}
+
+		injected, reason := MutateEphemeralContainer(request, &ephemeralContainer)
+
+		assert.False(t, injected)
+		assert.Equal(t, NoInjectedTargetContainerReason, reason)
+	})
+}
@@ -28,6 +28,7 @@ func mutateInitContainer(mutationRequest *dtwebhook.MutationRequest, installPath
 		return dtwebhook.MutatorError{
 			Err:      CodeModulesStatusNotReadyErr{dkName: mutationRequest.DynaKube.Name},
 			Annotate: setNotInjectedAnnotationFunc(DynaKubeStatusNotReadyReason),
+			Reason:   DynaKubeStatusNotReadyReason,
 		}
 	}
 
@@ -99,6 +100,7 @@ func addInitArgs(pod *corev1.Pod, initContainer *corev1.Container, dk dynakube.D
 			return dtwebhook.MutatorError{
 				Err:      err,
 				Annotate: setNotInjectedAnnotationFunc(MissingTenantUUIDReason),
+				Reason:   MissingTenantUUIDReason,
 			}
 		}
 
@@ -56,7 +56,28 @@ func IsEnabled(request *dtwebhook.BaseRequest) bool {
 }
 
 func (mut *Mutator) IsEnabled(request *dtwebhook.BaseRequest) bool {
-	return IsEnabled(request)
+	return IsEnabled(request) && !AreAllContainersExcludedByImage(request)
+}
+
+// IsContainerExcluded checks if the container is excluded from the OneAgent injection, either by annotation or by the image allow and deny lists of the DynaKube.
+func IsContainerExcluded(request *dtwebhook.BaseRequest, container corev1.Container) bool {
+	return dtwebhook.IsContainerExcludedFromInjection(request.DynaKube.Annotations, request.Pod.Annotations, container.Name) ||
+		request.DynaKube.OneAgent().IsContainerImageExcluded(container.Image)
+}
+
+// AreAllContainersExcludedByImage checks if none of the containers get the OneAgent, because their images are excluded.
+func AreAllContainersExcludedByImage(request *dtwebhook.BaseRequest) bool {
+	excludedByImage := false
+
+	for _, container := range request.Pod.Spec.Containers {
+		if !IsContainerExcluded(request, container) {
+			return false
+		}
+
+		excludedByImage = excludedByImage || request.DynaKube.OneAgent().IsContainerImageExcluded(container.Image)
+	}
+
+	return excludedByImage
 }
 
 func (mut *Mutator) IsInjected(request *dtwebhook.BaseRequest) bool {
@@ -75,6 +96,7 @@ func (mut *Mutator) Mutate(request *dtwebhook.MutationRequest) error {
 	// the caller of mutate already checks if it needs to be mutated
 	_ = mutateUserContainers(request.BaseRequest, installPath)
 	setInjectedAnnotation(request.Pod)
+	setVersionAnnotation(request.Pod, request.DynaKube.OneAgent().GetCodeModulesVersion())
 
 	return nil
 }
@@ -90,21 +112,35 @@ func containerIsInjected(container corev1.Container, _ *dtwebhook.BaseRequest) b
 }
 
 func mutateUserContainers(request *dtwebhook.BaseRequest, installPath string) bool {
-	newContainers := request.NewContainers(containerIsInjected)
-	for _, container := range newContainers {
+	injected := false
+
+	for _, container := range request.NewContainers(containerIsInjected) {
+		if request.DynaKube.OneAgent().IsContainerImageExcluded(container.Image) {
+			log.Info("image of container is excluded, skipping OneAgent injection", "name", container.Name, "image", container.Image)
+
+			continue
+		}
+
 		addOneAgentToContainer(request.DynaKube, container, request.Namespace, installPath)
+		addResourceOverhead(request, container)
+
+		injected = true
 	}
 
-	return len(newContainers) > 0
+	return injected
 }
 
 func addOneAgentToContainer(dk dynakube.DynaKube, container *corev1.Container, namespace corev1.Namespace, installPath string) {
 	log.Info("adding OneAgent to container", "name", container.Name)
 
 	addVolumeMounts(container, installPath)
+	addEnvs(dk, container, namespace, installPath, DtStoragePath)
+}
+
+func addEnvs(dk dynakube.DynaKube, container *corev1.Container, namespace corev1.Namespace, installPath, storagePath string) {
 	addDeploymentMetadataEnv(container, dk)
 	addPreloadEnv(container, installPath)
-	addDtStorageEnv(container)
+	addDtStorageEnv(container, storagePath)
 
 	if dk.Spec.NetworkZone != "" {
 		addNetworkZoneEnv(container, dk.Spec.NetworkZone)
@@ -124,6 +160,14 @@ func setInjectedAnnotation(pod *corev1.Pod) {
 	delete(pod.Annotations, AnnotationReason)
 }
 
+func setVersionAnnotation(pod *corev1.Pod, version string) {
+	if version == "" {
+		return
+	}
+
+	pod.Annotations[AnnotationVersion] = version
+}
+
 func setNotInjectedAnnotationFunc(reason string) func(*corev1.Pod) {
 	return func(pod *corev1.Pod) {
 		if pod.Annotations == nil {
@@ -232,6 +232,7 @@ func TestMutate(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		request := createTestMutationRequestWithoutInjectedContainers()
+		request.DynaKube.Status.CodeModules.Version = "1.2.3"
 
 		original := createTestMutationRequestWithoutInjectedContainers()
 		err := mut.Mutate(request)
@@ -247,6 +248,7 @@ func TestMutate(t *testing.T) {
 		}
 
 		assert.True(t, mut.IsInjected(request.BaseRequest))
+		assert.Equal(t, "1.2.3", request.Pod.Annotations[AnnotationVersion])
 	})
 	t.Run("install-path respected", func(t *testing.T) {
 		expectedInstallPath := "my-install"
@@ -480,3 +482,65 @@ func Test_setNotInjectedAnnotationFunc(t *testing.T) {
 		require.False(t, mut.IsInjected(request.BaseRequest))
 	})
 }
+
+func TestContainerExclusionImages(t *testing.T) {
+	createRequest := func(containerImages *oneagent.ContainerImagesSpec, containers ...corev1.Container) *dtwebhook.BaseRequest {
+		return &dtwebhook.BaseRequest{
+			Pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"container.inject.dynatrace.com/annotated": "false"},
+				},
+				Spec: corev1.PodSpec{Containers: containers},
+			},
+			DynaKube: dynakube.DynaKube{
+				Spec: dynakube.DynaKubeSpec{
+					OneAgent: oneagent.Spec{
+						ApplicationMonitoring: &oneagent.ApplicationMonitoringSpec{
+							AppInjectionSpec: oneagent.AppInjectionSpec{ContainerImages: containerImages},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	app := corev1.Container{Name: "app", Image: "registry.example.com/shop:1.0"}
+	proxy := corev1.Container{Name: "istio-proxy", Image: "docker.io/istio/proxyv2:1.24.0"}
+	annotated := corev1.Container{Name: "annotated", Image: "registry.example.com/other:1.0"}
+
+	t.Run("deny list", func(t *testing.T) {
+		request := createRequest(&oneagent.ContainerImagesSpec{Deny: []string{"*/istio/proxyv2*"}}, app, proxy, annotated)
+
+		assert.False(t, IsContainerExcluded(request, app))
+		assert.True(t, IsContainerExcluded(request, proxy))
+		assert.True(t, IsContainerExcluded(request, annotated))
+		assert.False(t, AreAllContainersExcludedByImage(request))
+
+		require.True(t, mutateUserContainers(request, DefaultInstallPath))
+		assert.True(t, containerIsInjected(request.Pod.Spec.Containers[0], request))
+		assert.False(t, containerIsInjected(request.Pod.Spec.Containers[1], request))
+		assert.False(t, containerIsInjected(request.Pod.Spec.Containers[2], request))
+	})
+
+	t.Run("allow list", func(t *testing.T) {
+		request := createRequest(&oneagent.ContainerImagesSpec{Allow: []string{"registry.example.com/*"}, Deny: []string{"*/other:*"}}, app, proxy)
+
+		assert.False(t, IsContainerExcluded(request, app))
+		assert.True(t, IsContainerExcluded(request, proxy))
+		assert.True(t, IsContainerExcluded(request, annotated))
+	})
+
+	t.Run("all containers excluded", func(t *testing.T) {
+		assert.True(t, AreAllContainersExcludedByImage(createRequest(&oneagent.ContainerImagesSpec{Deny: []string{"*/istio/*"}}, proxy, annotated)))
+		assert.False(t, AreAllContainersExcludedByImage(createRequest(&oneagent.ContainerImagesSpec{Deny: []string{"*/istio/*"}}, annotated)))
+		assert.False(t, AreAllContainersExcludedByImage(createRequest(nil, app, proxy)))
+	})
+
+	t.Run("metadata enrichment containers are not filtered by image", func(t *testing.T) {
+		request := createRequest(&oneagent.ContainerImagesSpec{Deny: []string{"*/istio/proxyv2*"}}, app, proxy, annotated)
+
+		newContainers := request.NewContainers(func(corev1.Container, *dtwebhook.BaseRequest) bool { return false })
+		require.Len(t, newContainers, 2)
+		assert.Equal(t, proxy.Name, newContainers[1].Name)
+	})
+}
@@ -0,0 +1,133 @@
+package oneagent
+
+import (
+	"encoding/json"
+	"strings"
+
+	dtwebhook "github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// addResourceOverhead adds the resource overhead of the code modules to the requests and limits that are set on the container.
+// Missing requests are set to the overhead, so the scheduler reserves at least the resources of the code modules,
+// this turns the QoS class of a BestEffort pod into Burstable. Missing limits stay unset, as the container isn't limited anyway.
+// The added overhead is recorded per container in an annotation of the pod, so it's never added twice to the same container.
+func addResourceOverhead(request *dtwebhook.BaseRequest, container *corev1.Container) {
+	overhead := request.DynaKube.OneAgent().GetResourceOverhead(strings.Split(getTechnology(*request.Pod, request.DynaKube), ","))
+	if overhead == nil {
+		return
+	}
+
+	applied := getAppliedResourceOverhead(request.Pod)
+	if _, ok := applied[container.Name]; ok {
+		return
+	}
+
+	delta := corev1.ResourceRequirements{}
+
+	for name, current := range overheadByResource(*overhead) {
+		requestOverhead, limitOverhead := current.request, current.limit
+		containerRequest, hasRequest := container.Resources.Requests[name]
+		containerLimit, hasLimit := container.Resources.Limits[name]
+
+		// the requests must stay equal to the limits, otherwise the QoS class of the pod would change
+		if hasRequest && hasLimit && containerRequest.Cmp(containerLimit) == 0 {
+			requestOverhead = limitOverhead
+		}
+
+		if !requestOverhead.IsZero() {
+			if hasRequest {
+				containerRequest.Add(requestOverhead)
+			} else {
+				containerRequest = requestOverhead.DeepCopy()
+			}
+
+			container.Resources.Requests = setQuantity(container.Resources.Requests, name, containerRequest)
+			delta.Requests = setQuantity(delta.Requests, name, requestOverhead)
+		}
+
+		if hasLimit && !limitOverhead.IsZero() {
+			containerLimit.Add(limitOverhead)
+			container.Resources.Limits[name] = containerLimit
+			delta.Limits = setQuantity(delta.Limits, name, limitOverhead)
+		}
+	}
+
+	log.Info("added resource overhead to container", "name", container.Name, "overhead", delta)
+
+	applied[container.Name] = delta
+	setAppliedResourceOverhead(request.Pod, applied)
+}
+
+type resourceOverhead struct {
+	request resource.Quantity
+	limit   resource.Quantity
+}
+
+// overheadByResource returns the overhead of the requests and limits per resource.
+// The limits grow at least by the overhead of the requests, so they never become lower than the requests.
+func overheadByResource(overhead corev1.ResourceRequirements) map[corev1.ResourceName]resourceOverhead {
+	byResource := map[corev1.ResourceName]resourceOverhead{}
+
+	for name, quantity := range overhead.Requests {
+		byResource[name] = resourceOverhead{request: quantity.DeepCopy()}
+	}
+
+	for name, quantity := range overhead.Limits {
+		current := byResource[name]
+		current.limit = quantity.DeepCopy()
+		byResource[name] = current
+	}
+
+	for name, current := range byResource {
+		if current.request.Cmp(current.limit) > 0 {
+			current.limit = current.request.DeepCopy()
+			byResource[name] = current
+		}
+	}
+
+	return byResource
+}
+
+func setQuantity(list corev1.ResourceList, name corev1.ResourceName, quantity resource.Quantity) corev1.ResourceList {
+	if list == nil {
+		list = corev1.ResourceList{}
+	}
+
+	list[name] = quantity
+
+	return list
+}
+
+func getAppliedResourceOverhead(pod *corev1.Pod) map[string]corev1.ResourceRequirements {
+	applied := map[string]corev1.ResourceRequirements{}
+
+	raw, ok := pod.Annotations[AnnotationResourceOverhead]
+	if !ok {
+		return applied
+	}
+
+	if err := json.Unmarshal([]byte(raw), &applied); err != nil {
+		log.Info("failed to parse the resource overhead annotation", "error", err.Error())
+
+		return map[string]corev1.ResourceRequirements{}
+	}
+
+	return applied
+}
+
+func setAppliedResourceOverhead(pod *corev1.Pod, applied map[string]corev1.ResourceRequirements) {
+	raw, err := json.Marshal(applied)
+	if err != nil {
+		log.Info("failed to serialize the resource overhead annotation", "error", err.Error())
+
+		return
+	}
+
+	if pod.Annotations == nil {
+		pod.Annotations = make(map[string]string)
+	}
+
+	pod.Annotations[AnnotationResourceOverhead] = string(raw)
+}
@@ -0,0 +1,140 @@
+package oneagent
+
+import (
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube/oneagent"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8sresource"
+	dtwebhook "github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createOverheadRequest(overhead *oneagent.ResourceOverheadSpec, containers ...corev1.Container) *dtwebhook.BaseRequest {
+	return &dtwebhook.BaseRequest{
+		Pod: &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{AnnotationTechnologies: "java"}},
+			Spec:       corev1.PodSpec{Containers: containers},
+		},
+		DynaKube: dynakube.DynaKube{
+			Spec: dynakube.DynaKubeSpec{OneAgent: oneagent.Spec{
+				ApplicationMonitoring: &oneagent.ApplicationMonitoringSpec{
+					AppInjectionSpec: oneagent.AppInjectionSpec{ResourceOverhead: overhead},
+				},
+			}},
+		},
+	}
+}
+
+func TestAddResourceOverhead(t *testing.T) {
+	overhead := &oneagent.ResourceOverheadSpec{
+		Technologies: map[string]corev1.ResourceRequirements{
+			"java": {
+				Requests: k8sresource.NewResourceList("10m", "100Mi"),
+				Limits:   k8sresource.NewResourceList("50m", "200Mi"),
+			},
+		},
+	}
+
+	t.Run("overhead is added to the set requests and limits", func(t *testing.T) {
+		request := createOverheadRequest(overhead, corev1.Container{
+			Name: "app",
+			Resources: corev1.ResourceRequirements{
+				Requests: k8sresource.NewResourceList("100m", "100Mi"),
+				Limits:   corev1.ResourceList{corev1.ResourceMemory: *k8sresource.NewQuantity("500Mi")},
+			},
+		})
+		container := &request.Pod.Spec.Containers[0]
+
+		addResourceOverhead(request, container)
+
+		assertResourceList(t, k8sresource.NewResourceList("110m", "200Mi"), container.Resources.Requests)
+		assertResourceList(t, corev1.ResourceList{corev1.ResourceMemory: *k8sresource.NewQuantity("700Mi")}, container.Resources.Limits)
+
+		applied := getAppliedResourceOverhead(request.Pod)
+		require.Contains(t, applied, "app")
+		assertResourceList(t, k8sresource.NewResourceList("10m", "100Mi"), applied["app"].Requests)
+		assertResourceList(t, corev1.ResourceList{corev1.ResourceMemory: *k8sresource.NewQuantity("200Mi")}, applied["app"].Limits)
+	})
+
+	t.Run("guaranteed containers stay guaranteed", func(t *testing.T) {
+		request := createOverheadRequest(overhead, corev1.Container{
+			Name: "app",
+			Resources: corev1.ResourceRequirements{
+				Requests: k8sresource.NewResourceList("100m", "100Mi"),
+				Limits:   k8sresource.NewResourceList("100m", "100Mi"),
+			},
+		})
+		container := &request.Pod.Spec.Containers[0]
+
+		addResourceOverhead(request, container)
+
+		assertResourceList(t, k8sresource.NewResourceList("150m", "300Mi"), container.Resources.Requests)
+		assertResourceList(t, container.Resources.Requests, container.Resources.Limits)
+	})
+
+	t.Run("containers without requests get the overhead as requests", func(t *testing.T) {
+		request := createOverheadRequest(overhead, corev1.Container{Name: "app"})
+		container := &request.Pod.Spec.Containers[0]
+
+		addResourceOverhead(request, container)
+
+		assertResourceList(t, k8sresource.NewResourceList("10m", "100Mi"), container.Resources.Requests)
+		assert.Empty(t, container.Resources.Limits)
+		assert.Contains(t, getAppliedResourceOverhead(request.Pod), "app")
+	})
+
+	t.Run("missing request of a single resource is set", func(t *testing.T) {
+		request := createOverheadRequest(overhead, corev1.Container{
+			Name: "app",
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceMemory: *k8sresource.NewQuantity("100Mi")},
+			},
+		})
+		container := &request.Pod.Spec.Containers[0]
+
+		addResourceOverhead(request, container)
+
+		assertResourceList(t, k8sresource.NewResourceList("10m", "200Mi"), container.Resources.Requests)
+	})
+
+	t.Run("overhead is only added once", func(t *testing.T) {
+		request := createOverheadRequest(overhead, corev1.Container{
+			Name:      "app",
+			Resources: corev1.ResourceRequirements{Requests: k8sresource.NewResourceList("100m", "100Mi")},
+		})
+		container := &request.Pod.Spec.Containers[0]
+
+		addResourceOverhead(request, container)
+		addResourceOverhead(request, container)
+
+		assertResourceList(t, k8sresource.NewResourceList("110m", "200Mi"), container.Resources.Requests)
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		request := createOverheadRequest(nil, corev1.Container{
+			Name:      "app",
+			Resources: corev1.ResourceRequirements{Requests: k8sresource.NewResourceList("100m", "100Mi")},
+		})
+		container := &request.Pod.Spec.Containers[0]
+
+		addResourceOverhead(request, container)
+
+		assertResourceList(t, k8sresource.NewResourceList("100m", "100Mi"), container.Resources.Requests)
+		assert.NotContains(t, request.Pod.Annotations, AnnotationResourceOverhead)
+	})
+}
+
+func assertResourceList(t *testing.T, expected, actual corev1.ResourceList) {
+	t.Helper()
+
+	require.Len(t, actual, len(expected))
+
+	for name, quantity := range expected {
+		actualQuantity := actual[name]
+		assert.Zero(t, quantity.Cmp(actualQuantity), "%s: expected %s, got %s", name, quantity.String(), actualQuantity.String())
+	}
+}
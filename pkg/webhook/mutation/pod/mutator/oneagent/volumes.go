@@ -20,16 +20,32 @@ const (
 )
 
 func addVolumeMounts(container *corev1.Container, installPath string) {
+	addBinVolumeMount(container, installPath)
+	container.VolumeMounts = append(container.VolumeMounts,
+		corev1.VolumeMount{
+			Name:      volumes.ConfigVolumeName,
+			MountPath: ldPreloadPath,
+			SubPath:   ldPreloadSubPath,
+		},
+	)
+}
+
+func addBinVolumeMount(container *corev1.Container, installPath string) {
 	container.VolumeMounts = append(container.VolumeMounts,
 		corev1.VolumeMount{
 			Name:      BinVolumeName,
 			MountPath: installPath,
 			ReadOnly:  true,
 		},
+	)
+}
+
+// addEphemeralConfigVolumeMount mounts the whole config volume, as ephemeral containers can't use subPath mounts.
+func addEphemeralConfigVolumeMount(container *corev1.Container) {
+	container.VolumeMounts = append(container.VolumeMounts,
 		corev1.VolumeMount{
 			Name:      volumes.ConfigVolumeName,
-			MountPath: ldPreloadPath,
-			SubPath:   ldPreloadSubPath,
+			MountPath: EphemeralConfigMountPath,
 		},
 	)
 }
@@ -106,6 +106,7 @@ func (m Mutator) mutate(request *dtwebhook.BaseRequest) (bool, error) {
 		return false, dtwebhook.MutatorError{
 			Err:      fmt.Errorf("could not acquire ingest endpoint: %w", err),
 			Annotate: setNotInjectedAnnotationFunc(CouldNotGetIngestEndpointReason),
+			Reason:   CouldNotGetIngestEndpointReason,
 		}
 	}
 
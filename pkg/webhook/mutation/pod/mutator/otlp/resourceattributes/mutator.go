@@ -23,11 +23,11 @@ const (
 )
 
 type Mutator struct {
-	kubeClient client.Client
+	apiReader client.Reader
 }
 
-func New(apiReader client.Client) dtwebhook.Mutator {
-	return &Mutator{kubeClient: apiReader}
+func New(apiReader client.Reader) dtwebhook.Mutator {
+	return &Mutator{apiReader: apiReader}
 }
 
 func (Mutator) IsEnabled(_ *dtwebhook.BaseRequest) bool {
@@ -60,13 +60,14 @@ func (m *Mutator) mutate(ctx context.Context, request *dtwebhook.BaseRequest) (b
 	log.Debug("injecting OTLP resource Attributes")
 
 	// fetch workload information once per pod
-	ownerInfo, err := workload.FindRootOwnerOfPod(ctx, m.kubeClient, *request, log)
+	ownerInfo, err := workload.FindRootOwnerOfPod(ctx, m.apiReader, *request, log)
 	if err != nil {
 		log.Error(err, "failed to get workload info", "podName", request.PodName(), "namespace", request.Namespace.Name)
 
 		return false, dtwebhook.MutatorError{
 			Err:      errors.WithStack(err),
 			Annotate: setNotInjectedAnnotationFunc(metadata.OwnerLookupFailedReason),
+			Reason:   metadata.OwnerLookupFailedReason,
 		}
 	}
 
@@ -0,0 +1,65 @@
+package mutator
+
+import "errors"
+
+const (
+	OneAgentMutatorName               = "oneagent"
+	MetadataMutatorName               = "metadata"
+	OTLPExporterMutatorName           = "otlp-exporter"
+	OTLPResourceAttributesMutatorName = "otlp-resource-attributes"
+
+	// NotEnabledReason is used for mutators that are not enabled for the pod, e.g. because of the namespace selector or an annotation.
+	NotEnabledReason = "NotEnabled"
+	// InternalErrorReason is used for failures that are not caused by a MutatorError.
+	InternalErrorReason = "InternalError"
+
+	// InjectionDisabledReason is used for pods that opted out of the injection via annotation.
+	InjectionDisabledReason = "InjectionDisabled"
+	// AllContainersExcludedReason is used for pods where all containers are excluded from the injection.
+	AllContainersExcludedReason = "AllContainersExcluded"
+	// OcDebugPodReason is used for pods created by oc debug, which are copies of already injected pods.
+	OcDebugPodReason = "OcDebugPod"
+)
+
+// MutatorNames lists all mutators, in the order they run.
+var MutatorNames = []string{OneAgentMutatorName, MetadataMutatorName, OTLPExporterMutatorName, OTLPResourceAttributesMutatorName}
+
+type Result string
+
+const (
+	InjectedResult Result = "injected"
+	SkippedResult  Result = "skipped"
+	FailedResult   Result = "failed"
+)
+
+// Outcome is the decision of a single mutator for the pod of a request.
+type Outcome struct {
+	Mutator string `json:"mutator"`
+	Result  Result `json:"result"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+func (req *BaseRequest) RecordInjected(mutator string) {
+	req.outcomes = append(req.outcomes, Outcome{Mutator: mutator, Result: InjectedResult})
+}
+
+func (req *BaseRequest) RecordSkipped(mutator, reason string) {
+	req.outcomes = append(req.outcomes, Outcome{Mutator: mutator, Result: SkippedResult, Reason: reason})
+}
+
+// RecordFailed records the failure of a mutator, the reason is taken from the MutatorError if possible.
+func (req *BaseRequest) RecordFailed(mutator string, err error) {
+	reason := InternalErrorReason
+
+	mutErr := new(MutatorError)
+	if errors.As(err, mutErr) && mutErr.Reason != "" {
+		reason = mutErr.Reason
+	}
+
+	req.outcomes = append(req.outcomes, Outcome{Mutator: mutator, Result: FailedResult, Reason: reason})
+}
+
+// Outcomes returns the decisions of the mutators, in the order they were recorded.
+func (req *BaseRequest) Outcomes() []Outcome {
+	return req.outcomes
+}
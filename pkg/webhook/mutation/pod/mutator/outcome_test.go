@@ -0,0 +1,40 @@
+package mutator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutcomes(t *testing.T) {
+	t.Run("nothing recorded", func(t *testing.T) {
+		assert.Empty(t, (&BaseRequest{}).Outcomes())
+	})
+
+	t.Run("outcomes are kept in order", func(t *testing.T) {
+		request := &BaseRequest{}
+
+		request.RecordSkipped(OneAgentMutatorName, NotEnabledReason)
+		request.RecordInjected(MetadataMutatorName)
+
+		assert.Equal(t, []Outcome{
+			{Mutator: OneAgentMutatorName, Result: SkippedResult, Reason: NotEnabledReason},
+			{Mutator: MetadataMutatorName, Result: InjectedResult},
+		}, request.Outcomes())
+	})
+
+	t.Run("failure reason is taken from the mutator error", func(t *testing.T) {
+		request := &BaseRequest{}
+
+		request.RecordFailed(OneAgentMutatorName, MutatorError{Err: errors.New("boom"), Reason: "MissingTenantUUID"})
+		request.RecordFailed(MetadataMutatorName, MutatorError{Err: errors.New("boom")})
+		request.RecordFailed(OTLPExporterMutatorName, errors.New("boom"))
+
+		assert.Equal(t, []Outcome{
+			{Mutator: OneAgentMutatorName, Result: FailedResult, Reason: "MissingTenantUUID"},
+			{Mutator: MetadataMutatorName, Result: FailedResult, Reason: InternalErrorReason},
+			{Mutator: OTLPExporterMutatorName, Result: FailedResult, Reason: InternalErrorReason},
+		}, request.Outcomes())
+	})
+}
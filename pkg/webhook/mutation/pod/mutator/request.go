@@ -52,6 +52,8 @@ type BaseRequest struct {
 	Pod       *corev1.Pod
 	Namespace corev1.Namespace
 	DynaKube  dynakube.DynaKube
+
+	outcomes []Outcome
 }
 
 func (req *BaseRequest) PodName() string {
@@ -0,0 +1,187 @@
+package policy
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/v1alpha2/injectionpolicy"
+	"github.com/Dynatrace/dynatrace-operator/pkg/logd"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/wildcard"
+	dtwebhook "github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator"
+	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator/metadata"
+	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator/oneagent"
+	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/workload"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// AnnotationInjectionPolicy is set on pods matched by an InjectionPolicy, it contains the name of the policy and rule that was applied.
+	AnnotationInjectionPolicy = "dynatrace.com/injection-policy"
+
+	// AnnotationInjectionPolicyReason is set on pods for which the InjectionPolicies couldn't be evaluated, the pod is mutated according to the DynaKube then.
+	AnnotationInjectionPolicyReason = "dynatrace.com/injection-policy-reason"
+
+	EvaluationFailedReason = "EvaluationFailed"
+
+	defaultServiceAccountName = "default"
+)
+
+var log = logd.Get().WithName("pod-mutation-policy")
+
+// Apply evaluates the InjectionPolicies of the namespace of the pod, in the order of their names.
+// The settings of the first matching rule are set as annotations on the pod, so the mutators pick them up like any other annotation.
+// Annotations that are already set on the pod take precedence over the rule.
+// If the policies can't be evaluated, the error is logged and recorded on the pod, and the pod is left to the settings of the DynaKube.
+func Apply(ctx context.Context, apiReader client.Reader, request *dtwebhook.BaseRequest) {
+	if err := apply(ctx, apiReader, request); err != nil {
+		log.Error(err, "failed to apply injection policies, continuing with the settings of the DynaKube", "podName", request.PodName(), "namespace", request.Namespace.Name)
+
+		if request.Pod.Annotations == nil {
+			request.Pod.Annotations = make(map[string]string)
+		}
+
+		request.Pod.Annotations[AnnotationInjectionPolicyReason] = EvaluationFailedReason
+	}
+}
+
+func apply(ctx context.Context, apiReader client.Reader, request *dtwebhook.BaseRequest) error {
+	var policies injectionpolicy.InjectionPolicyList
+	if err := apiReader.List(ctx, &policies, client.InNamespace(request.Namespace.Name)); meta.IsNoMatchError(err) {
+		// the InjectionPolicy CRD is not installed, so there are no policies
+		return nil
+	} else if err != nil {
+		return errors.WithMessage(err, "failed to list injection policies")
+	}
+
+	slices.SortFunc(policies.Items, func(a, b injectionpolicy.InjectionPolicy) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	m := matcher{ctx: ctx, apiReader: apiReader, request: request}
+
+	for _, policy := range policies.Items {
+		for _, rule := range policy.Spec.Rules {
+			matches, err := m.matches(rule.Match)
+			if err != nil {
+				return errors.WithMessagef(err, "failed to evaluate rule %s of injection policy %s", rule.Name, policy.Name)
+			}
+
+			if matches {
+				log.Info("applying injection policy", "podName", request.PodName(), "namespace", request.Namespace.Name, "policy", policy.Name, "rule", rule.Name)
+				applyRule(request.Pod, policy.Name, rule)
+
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
+
+func applyRule(pod *corev1.Pod, policyName string, rule injectionpolicy.Rule) {
+	if pod.Annotations == nil {
+		pod.Annotations = make(map[string]string)
+	}
+
+	pod.Annotations[AnnotationInjectionPolicy] = policyName + "/" + rule.Name
+
+	if rule.OneAgent != nil {
+		setDefault(pod, oneagent.AnnotationInject, strconv.FormatBool(rule.OneAgent.Inject))
+
+		if len(rule.OneAgent.Technologies) > 0 {
+			setDefault(pod, oneagent.AnnotationTechnologies, strings.Join(rule.OneAgent.Technologies, ","))
+		}
+
+		if rule.OneAgent.InstallPath != "" {
+			setDefault(pod, oneagent.AnnotationInstallPath, rule.OneAgent.InstallPath)
+		}
+	}
+
+	if rule.MetadataEnrichment != nil {
+		setDefault(pod, metadata.AnnotationInject, strconv.FormatBool(rule.MetadataEnrichment.Inject))
+	}
+
+	if rule.OTLPExporterConfiguration != nil {
+		setDefault(pod, dtwebhook.AnnotationOTLPInjectionEnabled, strconv.FormatBool(rule.OTLPExporterConfiguration.Inject))
+	}
+}
+
+func setDefault(pod *corev1.Pod, key, value string) {
+	if _, ok := pod.Annotations[key]; !ok {
+		pod.Annotations[key] = value
+	}
+}
+
+type matcher struct {
+	ctx       context.Context
+	apiReader client.Reader
+	request   *dtwebhook.BaseRequest
+
+	// workloadInfo is only looked up if a rule matches on the workload, and only once per request
+	workloadInfo *workload.Info
+}
+
+func (m *matcher) matches(match injectionpolicy.MatchSpec) (bool, error) {
+	pod := m.request.Pod
+
+	if match.PodSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(match.PodSelector)
+		if err != nil {
+			return false, errors.WithStack(err)
+		}
+
+		if !selector.Matches(labels.Set(pod.Labels)) {
+			return false, nil
+		}
+	}
+
+	if len(match.ServiceAccounts) > 0 && !slices.Contains(match.ServiceAccounts, getServiceAccountName(pod)) {
+		return false, nil
+	}
+
+	if len(match.Images) > 0 && !slices.ContainsFunc(pod.Spec.Containers, func(container corev1.Container) bool {
+		return wildcard.MatchesAny(match.Images, container.Image)
+	}) {
+		return false, nil
+	}
+
+	if len(match.WorkloadKinds) == 0 && len(match.WorkloadNames) == 0 {
+		return true, nil
+	}
+
+	return m.matchesWorkload(match)
+}
+
+func (m *matcher) matchesWorkload(match injectionpolicy.MatchSpec) (bool, error) {
+	if m.workloadInfo == nil {
+		workloadInfo, err := workload.FindRootOwnerOfPod(m.ctx, m.apiReader, *m.request, log)
+		if err != nil {
+			return false, err
+		}
+
+		m.workloadInfo = workloadInfo
+	}
+
+	if len(match.WorkloadKinds) > 0 && !slices.ContainsFunc(match.WorkloadKinds, func(kind string) bool {
+		return strings.EqualFold(kind, m.workloadInfo.Kind)
+	}) {
+		return false, nil
+	}
+
+	return len(match.WorkloadNames) == 0 || wildcard.MatchesAny(match.WorkloadNames, m.workloadInfo.Name), nil
+}
+
+func getServiceAccountName(pod *corev1.Pod) string {
+	if pod.Spec.ServiceAccountName == "" {
+		return defaultServiceAccountName
+	}
+
+	return pod.Spec.ServiceAccountName
+}
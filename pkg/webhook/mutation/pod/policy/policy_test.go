@@ -0,0 +1,209 @@
+package policy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/v1alpha2/injectionpolicy"
+	dtwebhook "github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator"
+	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator/metadata"
+	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator/oneagent"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+const testNamespace = "test-namespace"
+
+func createPolicy(name string, rules ...injectionpolicy.Rule) *injectionpolicy.InjectionPolicy {
+	return &injectionpolicy.InjectionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		Spec:       injectionpolicy.InjectionPolicySpec{Rules: rules},
+	}
+}
+
+func createRequest(pod *corev1.Pod) *dtwebhook.BaseRequest {
+	return &dtwebhook.BaseRequest{
+		Pod:       pod,
+		Namespace: corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: testNamespace}},
+	}
+}
+
+func createPod(annotations map[string]string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-pod",
+			Labels:      map[string]string{"app": "shop"},
+			Annotations: annotations,
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "apps/v1", Kind: "Deployment", Name: "shop-frontend", Controller: ptr.To(true)},
+			},
+		},
+		Spec: corev1.PodSpec{
+			ServiceAccountName: "shop",
+			Containers: []corev1.Container{
+				{Name: "app", Image: "registry.example.com/shop/frontend:1.0"},
+			},
+		},
+	}
+}
+
+func createDeployment() *appsv1.Deployment {
+	return &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "shop-frontend", Namespace: testNamespace},
+	}
+}
+
+func TestApply(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("no policies => no changes", func(t *testing.T) {
+		pod := createPod(nil)
+
+		Apply(ctx, fake.NewClient(), createRequest(pod))
+
+		assert.Empty(t, pod.Annotations)
+	})
+
+	t.Run("first matching rule is applied", func(t *testing.T) {
+		pod := createPod(nil)
+		clt := fake.NewClient(
+			createDeployment(),
+			createPolicy("b-policy", injectionpolicy.Rule{
+				Name:     "all",
+				OneAgent: &injectionpolicy.OneAgentInjectionSpec{InjectionSpec: injectionpolicy.InjectionSpec{Inject: false}},
+			}),
+			createPolicy("a-policy",
+				injectionpolicy.Rule{
+					Name:     "other-workload",
+					Match:    injectionpolicy.MatchSpec{WorkloadNames: []string{"backend-*"}},
+					OneAgent: &injectionpolicy.OneAgentInjectionSpec{InjectionSpec: injectionpolicy.InjectionSpec{Inject: false}},
+				},
+				injectionpolicy.Rule{
+					Name: "frontend",
+					Match: injectionpolicy.MatchSpec{
+						WorkloadKinds:   []string{"Deployment"},
+						WorkloadNames:   []string{"shop-*"},
+						PodSelector:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": "shop"}},
+						Images:          []string{"registry.example.com/shop/*"},
+						ServiceAccounts: []string{"shop"},
+					},
+					OneAgent: &injectionpolicy.OneAgentInjectionSpec{
+						InjectionSpec: injectionpolicy.InjectionSpec{Inject: true},
+						Technologies:  []string{"java", "nodejs"},
+						InstallPath:   "/opt/dynatrace",
+					},
+					MetadataEnrichment:        &injectionpolicy.InjectionSpec{Inject: false},
+					OTLPExporterConfiguration: &injectionpolicy.InjectionSpec{Inject: true},
+				},
+			),
+		)
+
+		Apply(ctx, clt, createRequest(pod))
+
+		assert.Equal(t, map[string]string{
+			AnnotationInjectionPolicy:                "a-policy/frontend",
+			oneagent.AnnotationInject:                "true",
+			oneagent.AnnotationTechnologies:          "java,nodejs",
+			oneagent.AnnotationInstallPath:           "/opt/dynatrace",
+			metadata.AnnotationInject:                "false",
+			dtwebhook.AnnotationOTLPInjectionEnabled: "true",
+		}, pod.Annotations)
+	})
+
+	t.Run("annotations of the pod take precedence", func(t *testing.T) {
+		pod := createPod(map[string]string{oneagent.AnnotationInject: "true"})
+		clt := fake.NewClient(createPolicy("policy", injectionpolicy.Rule{
+			Name:     "skip",
+			OneAgent: &injectionpolicy.OneAgentInjectionSpec{InjectionSpec: injectionpolicy.InjectionSpec{Inject: false}},
+		}))
+
+		Apply(ctx, clt, createRequest(pod))
+
+		assert.Equal(t, "true", pod.Annotations[oneagent.AnnotationInject])
+		assert.Equal(t, "policy/skip", pod.Annotations[AnnotationInjectionPolicy])
+	})
+
+	t.Run("no matching rule => no changes", func(t *testing.T) {
+		pod := createPod(nil)
+		clt := fake.NewClient(createPolicy("policy",
+			injectionpolicy.Rule{
+				Name:     "service-account",
+				Match:    injectionpolicy.MatchSpec{ServiceAccounts: []string{"default"}},
+				OneAgent: &injectionpolicy.OneAgentInjectionSpec{},
+			},
+			injectionpolicy.Rule{
+				Name:     "image",
+				Match:    injectionpolicy.MatchSpec{Images: []string{"docker.io/*"}},
+				OneAgent: &injectionpolicy.OneAgentInjectionSpec{},
+			},
+			injectionpolicy.Rule{
+				Name:     "labels",
+				Match:    injectionpolicy.MatchSpec{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "other"}}},
+				OneAgent: &injectionpolicy.OneAgentInjectionSpec{},
+			},
+		))
+
+		Apply(ctx, clt, createRequest(pod))
+
+		assert.Empty(t, pod.Annotations)
+	})
+
+	t.Run("policies of other namespaces are ignored", func(t *testing.T) {
+		pod := createPod(nil)
+		policy := createPolicy("policy", injectionpolicy.Rule{Name: "all", OneAgent: &injectionpolicy.OneAgentInjectionSpec{}})
+		policy.Namespace = "other"
+
+		Apply(ctx, fake.NewClient(policy), createRequest(pod))
+
+		assert.Empty(t, pod.Annotations)
+	})
+
+	t.Run("workload can't be looked up => reason set, no rule applied", func(t *testing.T) {
+		pod := createPod(nil)
+		clt := fake.NewClient(createPolicy("policy", injectionpolicy.Rule{
+			Name:     "kind",
+			Match:    injectionpolicy.MatchSpec{WorkloadKinds: []string{"Deployment"}},
+			OneAgent: &injectionpolicy.OneAgentInjectionSpec{InjectionSpec: injectionpolicy.InjectionSpec{Inject: false}},
+		}))
+
+		Apply(ctx, clt, createRequest(pod))
+
+		assert.Equal(t, map[string]string{AnnotationInjectionPolicyReason: EvaluationFailedReason}, pod.Annotations)
+	})
+
+	t.Run("policies can't be listed => reason set", func(t *testing.T) {
+		pod := createPod(nil)
+		clt := fake.NewClientWithInterceptors(interceptor.Funcs{
+			List: func(ctx context.Context, client client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+				return errors.New("boom")
+			},
+		})
+
+		Apply(ctx, clt, createRequest(pod))
+
+		assert.Equal(t, map[string]string{AnnotationInjectionPolicyReason: EvaluationFailedReason}, pod.Annotations)
+	})
+
+	t.Run("CRD not installed => no changes", func(t *testing.T) {
+		pod := createPod(nil)
+		clt := fake.NewClientWithInterceptors(interceptor.Funcs{
+			List: func(ctx context.Context, client client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+				return &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: "dynatrace.com", Kind: "InjectionPolicy"}}
+			},
+		})
+
+		Apply(ctx, clt, createRequest(pod))
+
+		assert.Empty(t, pod.Annotations)
+	})
+}
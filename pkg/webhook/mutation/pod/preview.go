@@ -0,0 +1,310 @@
+package pod
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"strings"
+
+	dtwebhook "github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator"
+	"github.com/pkg/errors"
+	admissionv1 "k8s.io/api/admission/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const (
+	PreviewPath = "/preview"
+
+	maxPreviewRequestSize = 1 << 20
+)
+
+// PreviewRequest is the body of a request to the preview endpoint.
+type PreviewRequest struct {
+	// Namespace the pod would be created in.
+	Namespace string `json:"namespace"`
+
+	// Object is the manifest of a Pod, or of a workload with a pod template, e.g. a Deployment.
+	Object runtime.RawExtension `json:"object"`
+}
+
+// PreviewResponse describes how the webhook would mutate the pod of a PreviewRequest.
+type PreviewResponse struct {
+	// DynaKube is the name of the DynaKube the namespace is assigned to.
+	DynaKube string `json:"dynakube,omitempty"`
+
+	// Message explains why the pod would not be mutated at all.
+	Message string `json:"message,omitempty"`
+
+	// Outcomes are the decisions of the individual mutators.
+	Outcomes []dtwebhook.Outcome `json:"outcomes,omitempty"`
+
+	// Annotations are the annotations added or changed by the webhook, including the reason if the pod is not injected.
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Pod is the pod as it would be admitted.
+	Pod *corev1.Pod `json:"pod,omitempty"`
+}
+
+// previewHandler runs the mutation of a pod without admitting it. The webhook it uses has to be created with dry-run clients,
+// so nothing is persisted, e.g. missing secrets are not replicated into the namespace.
+// The endpoint is reachable by everything that can reach the webhook service, so the caller has to send a bearer token
+// and is only allowed to preview pods it could create in the namespace.
+type previewHandler struct {
+	webhook *webhook
+
+	// authClient creates the TokenReviews and SubjectAccessReviews, it must not be a dry-run client.
+	authClient client.Client
+}
+
+func (h *previewHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	user, status, err := h.authenticate(r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+
+		return
+	}
+
+	var request PreviewRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxPreviewRequestSize)).Decode(&request); err != nil {
+		http.Error(w, "failed to decode preview request: "+err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	if request.Namespace == "" {
+		http.Error(w, "namespace is required", http.StatusBadRequest)
+
+		return
+	}
+
+	if status, err := h.authorize(r.Context(), user, request.Namespace); err != nil {
+		http.Error(w, err.Error(), status)
+
+		return
+	}
+
+	pod, owner, err := podFromManifest(request.Object.Raw)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	if owner != nil && h.webhook.ownerExists(r.Context(), request.Namespace, *owner) {
+		pod.OwnerReferences = []metav1.OwnerReference{*owner}
+	}
+
+	response, err := h.webhook.preview(r.Context(), request.Namespace, pod)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		log.Error(err, "failed to write preview response")
+	}
+}
+
+// authenticate reviews the bearer token of the request, the returned status is only meaningful if an error is returned.
+func (h *previewHandler) authenticate(r *http.Request) (authenticationv1.UserInfo, int, error) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return authenticationv1.UserInfo{}, http.StatusUnauthorized, errors.New("bearer token is required")
+	}
+
+	review := &authenticationv1.TokenReview{Spec: authenticationv1.TokenReviewSpec{Token: token}}
+	if err := h.authClient.Create(r.Context(), review); err != nil {
+		log.Error(err, "failed to review token of preview request")
+
+		return authenticationv1.UserInfo{}, http.StatusInternalServerError, errors.New("failed to review token")
+	}
+
+	if !review.Status.Authenticated {
+		return authenticationv1.UserInfo{}, http.StatusUnauthorized, errors.New("token is not valid")
+	}
+
+	return review.Status.User, http.StatusOK, nil
+}
+
+// authorize checks if the user is allowed to create pods in the namespace, as the preview reveals the same as a created pod would.
+func (h *previewHandler) authorize(ctx context.Context, user authenticationv1.UserInfo, namespace string) (int, error) {
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+	for key, value := range user.Extra {
+		extra[key] = authorizationv1.ExtraValue(value)
+	}
+
+	review := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   user.Username,
+			UID:    user.UID,
+			Groups: user.Groups,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: namespace,
+				Verb:      "create",
+				Resource:  "pods",
+			},
+		},
+	}
+	if err := h.authClient.Create(ctx, review); err != nil {
+		log.Error(err, "failed to review access of preview request")
+
+		return http.StatusInternalServerError, errors.New("failed to review access")
+	}
+
+	if !review.Status.Allowed {
+		return http.StatusForbidden, errors.Errorf("%s is not allowed to create pods in namespace %s", user.Username, namespace)
+	}
+
+	return http.StatusOK, nil
+}
+
+// preview follows the same steps as Handle, but returns the details of the decision instead of a patch.
+func (wh *webhook) preview(ctx context.Context, namespace string, pod *corev1.Pod) (*PreviewResponse, error) {
+	raw, err := json.Marshal(pod)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	request := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+		Namespace: namespace,
+		Object:    runtime.RawExtension{Raw: raw},
+	}}
+
+	mutationRequest, err := wh.createMutationRequestBase(ctx, request)
+	if err != nil {
+		return &PreviewResponse{Message: "unable to inject into pod: " + err.Error()}, nil
+	}
+
+	if mutationRequest == nil {
+		return &PreviewResponse{Message: "namespace is not assigned to a DynaKube"}, nil
+	}
+
+	response := &PreviewResponse{DynaKube: mutationRequest.DynaKube.Name, Pod: mutationRequest.Pod}
+
+	switch wh.getSkipReason(mutationRequest) {
+	case "":
+	case dtwebhook.OcDebugPodReason:
+		response.Message = "pod is an OpenShift debug pod"
+
+		return response, nil
+	default:
+		response.Message = "injection is disabled for the pod or all of its containers"
+
+		return response, nil
+	}
+
+	wh.recorder.Setup(mutationRequest)
+
+	originalPod := mutationRequest.Pod.DeepCopy()
+
+	if err := wh.runHandlers(mutationRequest); err != nil && !errors.As(err, new(dtwebhook.MutatorError)) {
+		// the pod is admitted without any modifications in this case
+		response.Message = "failed to inject into pod: " + err.Error()
+		mutationRequest.Pod = originalPod
+	}
+
+	response.Pod = mutationRequest.Pod
+	response.Outcomes = mutationRequest.Outcomes()
+	response.Annotations = changedAnnotations(originalPod.Annotations, mutationRequest.Pod.Annotations)
+
+	return response, nil
+}
+
+func (wh *webhook) ownerExists(ctx context.Context, namespace string, owner metav1.OwnerReference) bool {
+	object := &metav1.PartialObjectMetadata{TypeMeta: metav1.TypeMeta{APIVersion: owner.APIVersion, Kind: owner.Kind}}
+
+	return wh.apiReader.Get(ctx, client.ObjectKey{Name: owner.Name, Namespace: namespace}, object) == nil
+}
+
+func changedAnnotations(before, after map[string]string) map[string]string {
+	changed := map[string]string{}
+
+	for key, value := range after {
+		if oldValue, ok := before[key]; !ok || oldValue != value {
+			changed[key] = value
+		}
+	}
+
+	return changed
+}
+
+// podFromManifest returns the pod of the manifest, for workloads it is created from the pod template.
+// The returned owner reference points to the workload, it is only set on the pod if the workload exists, as the mutators look it up.
+func podFromManifest(raw []byte) (*corev1.Pod, *metav1.OwnerReference, error) {
+	var typeMeta metav1.TypeMeta
+	if err := json.Unmarshal(raw, &typeMeta); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to decode manifest")
+	}
+
+	switch typeMeta.GroupVersionKind() {
+	case corev1.SchemeGroupVersion.WithKind("Pod"):
+		var pod corev1.Pod
+		if err := json.Unmarshal(raw, &pod); err != nil {
+			return nil, nil, errors.Wrap(err, "failed to decode pod")
+		}
+
+		return &pod, nil, nil
+	case appsv1.SchemeGroupVersion.WithKind("Deployment"):
+		return podFromTemplate(raw, &appsv1.Deployment{}, func(d *appsv1.Deployment) corev1.PodTemplateSpec { return d.Spec.Template })
+	case appsv1.SchemeGroupVersion.WithKind("StatefulSet"):
+		return podFromTemplate(raw, &appsv1.StatefulSet{}, func(s *appsv1.StatefulSet) corev1.PodTemplateSpec { return s.Spec.Template })
+	case appsv1.SchemeGroupVersion.WithKind("DaemonSet"):
+		return podFromTemplate(raw, &appsv1.DaemonSet{}, func(d *appsv1.DaemonSet) corev1.PodTemplateSpec { return d.Spec.Template })
+	case appsv1.SchemeGroupVersion.WithKind("ReplicaSet"):
+		return podFromTemplate(raw, &appsv1.ReplicaSet{}, func(r *appsv1.ReplicaSet) corev1.PodTemplateSpec { return r.Spec.Template })
+	case batchv1.SchemeGroupVersion.WithKind("Job"):
+		return podFromTemplate(raw, &batchv1.Job{}, func(j *batchv1.Job) corev1.PodTemplateSpec { return j.Spec.Template })
+	case batchv1.SchemeGroupVersion.WithKind("CronJob"):
+		return podFromTemplate(raw, &batchv1.CronJob{}, func(c *batchv1.CronJob) corev1.PodTemplateSpec { return c.Spec.JobTemplate.Spec.Template })
+	}
+
+	return nil, nil, errors.Errorf("unsupported kind %s, expected a Pod or a workload with a pod template", typeMeta.GroupVersionKind())
+}
+
+func podFromTemplate[T client.Object](raw []byte, workload T, getTemplate func(T) corev1.PodTemplateSpec) (*corev1.Pod, *metav1.OwnerReference, error) {
+	if err := json.Unmarshal(raw, workload); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to decode workload")
+	}
+
+	template := getTemplate(workload)
+	gvk := workload.GetObjectKind().GroupVersionKind()
+
+	pod := &corev1.Pod{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: workload.GetName() + "-",
+			Labels:       maps.Clone(template.Labels),
+			Annotations:  maps.Clone(template.Annotations),
+		},
+		Spec: template.Spec,
+	}
+
+	owner := &metav1.OwnerReference{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Name:       workload.GetName(),
+		Controller: ptr.To(true),
+	}
+
+	return pod, owner, nil
+}
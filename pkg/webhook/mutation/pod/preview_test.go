@@ -0,0 +1,224 @@
+package pod
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
+	dtwebhook "github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator"
+	handlermock "github.com/Dynatrace/dynatrace-operator/test/mocks/pkg/webhook/mutation/pod/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+const testToken = "test-token"
+
+func createTestDeployment() *appsv1.Deployment {
+	return &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "test-deployment", Namespace: testNamespaceName},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "test"}},
+				Spec:       getTestPod().Spec,
+			},
+		},
+	}
+}
+
+// createTestAuthClient answers TokenReviews and SubjectAccessReviews like the Kubernetes API would.
+func createTestAuthClient(authenticated, allowed bool) client.Client {
+	return fake.NewClientWithInterceptors(interceptor.Funcs{
+		Create: func(ctx context.Context, clt client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			switch review := obj.(type) {
+			case *authenticationv1.TokenReview:
+				review.Status.Authenticated = authenticated && review.Spec.Token == testToken
+				review.Status.User = authenticationv1.UserInfo{Username: "test-user"}
+			case *authorizationv1.SubjectAccessReview:
+				review.Status.Allowed = allowed && review.Spec.User == "test-user" && review.Spec.ResourceAttributes.Verb == "create"
+			}
+
+			return nil
+		},
+	})
+}
+
+func sendPreviewRequest(t *testing.T, wh *webhook, namespace string, object any) *httptest.ResponseRecorder {
+	t.Helper()
+
+	return sendAuthenticatedPreviewRequest(t, &previewHandler{webhook: wh, authClient: createTestAuthClient(true, true)}, testToken, namespace, object)
+}
+
+func sendAuthenticatedPreviewRequest(t *testing.T, handler *previewHandler, token, namespace string, object any) *httptest.ResponseRecorder {
+	t.Helper()
+
+	raw, err := json.Marshal(object)
+	require.NoError(t, err)
+
+	body, err := json.Marshal(PreviewRequest{Namespace: namespace, Object: runtime.RawExtension{Raw: raw}})
+	require.NoError(t, err)
+
+	request := httptest.NewRequest(http.MethodPost, PreviewPath, bytes.NewReader(body))
+	if token != "" {
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+
+	return recorder
+}
+
+func readPreviewResponse(t *testing.T, recorder *httptest.ResponseRecorder) PreviewResponse {
+	t.Helper()
+
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+
+	var response PreviewResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+
+	return response
+}
+
+func TestPreview(t *testing.T) {
+	injectAnnotation := func(args mock.Arguments) {
+		mutationRequest := args.Get(0).(*dtwebhook.MutationRequest)
+		mutationRequest.Pod.Annotations = map[string]string{dtwebhook.AnnotationDynatraceInjected: "true"}
+		mutationRequest.RecordInjected(dtwebhook.OneAgentMutatorName)
+	}
+
+	t.Run("preview pod of existing workload", func(t *testing.T) {
+		injectionHandler := handlermock.NewHandler(t)
+		injectionHandler.On("Handle", mock.Anything).Run(injectAnnotation).Return(nil)
+
+		otlpHandler := handlermock.NewHandler(t)
+		otlpHandler.On("Handle", mock.Anything).Return(nil)
+
+		deployment := createTestDeployment()
+		wh := createTestWebhook(t, injectionHandler, otlpHandler, getTestNamespace(), getTestDynakube(), deployment)
+
+		response := readPreviewResponse(t, sendPreviewRequest(t, wh, testNamespaceName, deployment))
+
+		assert.Equal(t, testDynakubeName, response.DynaKube)
+		assert.Empty(t, response.Message)
+		assert.Equal(t, []dtwebhook.Outcome{{Mutator: dtwebhook.OneAgentMutatorName, Result: dtwebhook.InjectedResult}}, response.Outcomes)
+		assert.Equal(t, map[string]string{dtwebhook.AnnotationDynatraceInjected: "true"}, response.Annotations)
+		require.NotNil(t, response.Pod)
+		assert.Equal(t, "test-deployment-", response.Pod.GenerateName)
+		require.Len(t, response.Pod.OwnerReferences, 1)
+		assert.Equal(t, "Deployment", response.Pod.OwnerReferences[0].Kind)
+	})
+
+	t.Run("owner is not referenced if the workload doesn't exist", func(t *testing.T) {
+		injectionHandler := handlermock.NewHandler(t)
+		injectionHandler.On("Handle", mock.Anything).Return(nil)
+
+		otlpHandler := handlermock.NewHandler(t)
+		otlpHandler.On("Handle", mock.Anything).Return(nil)
+
+		wh := createTestWebhook(t, injectionHandler, otlpHandler, getTestNamespace(), getTestDynakube())
+
+		response := readPreviewResponse(t, sendPreviewRequest(t, wh, testNamespaceName, createTestDeployment()))
+
+		require.NotNil(t, response.Pod)
+		assert.Empty(t, response.Pod.OwnerReferences)
+	})
+
+	t.Run("arbitrary error ==> unmodified pod and message", func(t *testing.T) {
+		injectionHandler := handlermock.NewHandler(t)
+		injectionHandler.On("Handle", mock.Anything).Run(injectAnnotation).Return(errors.New("err"))
+
+		wh := createTestWebhook(t, injectionHandler, handlermock.NewHandler(t), getTestNamespace(), getTestDynakube())
+
+		pod := getTestPod()
+		pod.TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"}
+
+		response := readPreviewResponse(t, sendPreviewRequest(t, wh, testNamespaceName, pod))
+
+		assert.Contains(t, response.Message, "err")
+		assert.Empty(t, response.Annotations)
+		require.NotNil(t, response.Pod)
+		assert.Empty(t, response.Pod.Annotations)
+	})
+
+	t.Run("injection disabled ==> message", func(t *testing.T) {
+		wh := createTestWebhook(t, handlermock.NewHandler(t), handlermock.NewHandler(t), getTestNamespace(), getTestDynakube())
+
+		pod := getTestPodWithInjectionDisabled()
+		pod.TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"}
+
+		response := readPreviewResponse(t, sendPreviewRequest(t, wh, testNamespaceName, pod))
+
+		assert.Equal(t, testDynakubeName, response.DynaKube)
+		assert.NotEmpty(t, response.Message)
+		assert.Empty(t, response.Outcomes)
+	})
+
+	t.Run("namespace without DynaKube ==> message", func(t *testing.T) {
+		wh := createTestWebhook(t, handlermock.NewHandler(t), handlermock.NewHandler(t))
+
+		response := readPreviewResponse(t, sendPreviewRequest(t, wh, testNamespaceName, createTestDeployment()))
+
+		assert.Empty(t, response.DynaKube)
+		assert.Contains(t, response.Message, "unable to inject into pod")
+	})
+
+	t.Run("unsupported kind ==> bad request", func(t *testing.T) {
+		wh := createTestWebhook(t, handlermock.NewHandler(t), handlermock.NewHandler(t))
+
+		recorder := sendPreviewRequest(t, wh, testNamespaceName, getTestNamespace())
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("missing namespace ==> bad request", func(t *testing.T) {
+		wh := createTestWebhook(t, handlermock.NewHandler(t), handlermock.NewHandler(t))
+
+		recorder := sendPreviewRequest(t, wh, "", createTestDeployment())
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestPreviewAuth(t *testing.T) {
+	t.Run("missing token ==> unauthorized", func(t *testing.T) {
+		wh := createTestWebhook(t, handlermock.NewHandler(t), handlermock.NewHandler(t), getTestNamespace(), getTestDynakube())
+		handler := &previewHandler{webhook: wh, authClient: createTestAuthClient(true, true)}
+
+		recorder := sendAuthenticatedPreviewRequest(t, handler, "", testNamespaceName, createTestDeployment())
+
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	})
+
+	t.Run("invalid token ==> unauthorized", func(t *testing.T) {
+		wh := createTestWebhook(t, handlermock.NewHandler(t), handlermock.NewHandler(t), getTestNamespace(), getTestDynakube())
+		handler := &previewHandler{webhook: wh, authClient: createTestAuthClient(false, true)}
+
+		recorder := sendAuthenticatedPreviewRequest(t, handler, testToken, testNamespaceName, createTestDeployment())
+
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	})
+
+	t.Run("not allowed to create pods ==> forbidden", func(t *testing.T) {
+		wh := createTestWebhook(t, handlermock.NewHandler(t), handlermock.NewHandler(t), getTestNamespace(), getTestDynakube())
+		handler := &previewHandler{webhook: wh, authClient: createTestAuthClient(true, false)}
+
+		recorder := sendAuthenticatedPreviewRequest(t, handler, testToken, testNamespaceName, createTestDeployment())
+
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+}
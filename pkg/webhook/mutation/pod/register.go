@@ -4,6 +4,9 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/v1alpha2/injectionpolicy"
+	"github.com/Dynatrace/dynatrace-operator/pkg/consts"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8scontainer"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/objects/k8spod"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/system"
@@ -15,17 +18,22 @@ import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator/oneagent"
 	otlpexporter "github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator/otlp/exporter"
 	otlpresourceattributes "github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator/otlp/resourceattributes"
+	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/snapshot"
+	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/workload"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	webhooks "sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
-func registerInjectEndpoint(ctx context.Context, mgr manager.Manager, webhookNamespace string, webhookPodName string, isOpenShift bool) error {
+func registerInjectEndpoint(ctx context.Context, mgr manager.Manager, webhookNamespace string, webhookPodName string, isOpenShift, supportsNativeSidecars bool) error { //nolint:revive
 	eventRecorder := events.NewRecorder(mgr.GetEventRecorderFor("dynatrace-webhook")) //nolint
-	kubeConfig := mgr.GetConfig()
 	kubeClient := mgr.GetClient()
 	apiReader := mgr.GetAPIReader()
 
@@ -34,39 +42,67 @@ func registerInjectEndpoint(ctx context.Context, mgr manager.Manager, webhookNam
 		return err
 	}
 
-	// the injected podMutator.client doesn't have permissions to Get(sth) from a different namespace
-	metaClient, err := client.New(kubeConfig, client.Options{})
+	if err := startInformers(ctx, mgr.GetCache()); err != nil {
+		return err
+	}
+
+	appCaches, err := startAppInformers(ctx, mgr, isOpenShift)
 	if err != nil {
+		return err
+	}
+
+	// admissions read from the snapshot, so they don't depend on the Kubernetes API being reachable
+	snapshotReader := snapshot.New(mgr.GetCache(), apiReader, webhookNamespace, appCaches...)
+	if err := mgr.Add(snapshotReader); err != nil {
 		return errors.WithStack(err)
 	}
 
 	wh, err := newWebhook(
 		kubeClient,
-		metaClient,
-		apiReader,
+		snapshotReader,
 		eventRecorder,
 		admission.NewDecoder(mgr.GetScheme()),
 		*webhookPod,
 		isOpenShift,
+		supportsNativeSidecars,
 	)
 	if err != nil {
 		return err
 	}
 
+	wh.snapshot = snapshotReader
+
 	mgr.GetWebhookServer().Register("/inject", &webhooks.Admission{Handler: wh})
 	log.Info("registered /inject endpoint")
 
+	// the preview must not persist anything, so all writes are sent as dry-run and events are dropped
+	previewWh, err := newWebhook(
+		client.NewDryRunClient(kubeClient),
+		snapshotReader,
+		events.NewDiscardingRecorder(),
+		admission.NewDecoder(mgr.GetScheme()),
+		*webhookPod,
+		isOpenShift,
+		supportsNativeSidecars,
+	)
+	if err != nil {
+		return err
+	}
+
+	mgr.GetWebhookServer().Register(PreviewPath, &previewHandler{webhook: previewWh, authClient: kubeClient})
+	log.Info("registered " + PreviewPath + " endpoint")
+
 	return nil
 }
 
 func newWebhook( //nolint:revive
-	kubeClient,
-	metaClient client.Client,
+	kubeClient client.Client,
 	apiReader client.Reader,
 	eventRecorder events.EventRecorder,
 	decoder admission.Decoder,
 	webhookPod corev1.Pod,
-	isOpenshift bool) (*webhook, error) {
+	isOpenshift,
+	supportsNativeSidecars bool) (*webhook, error) {
 	webhookPodImage, err := getWebhookContainerImage(webhookPod)
 	if err != nil {
 		return nil, err
@@ -79,14 +115,15 @@ func newWebhook( //nolint:revive
 			eventRecorder,
 			webhookPodImage,
 			isOpenshift,
-			metadata.NewMutator(metaClient),
+			supportsNativeSidecars,
+			metadata.NewMutator(apiReader),
 			oneagent.NewMutator(),
 		),
 		otlpHandler: otlphandler.New(
 			kubeClient,
 			apiReader,
 			otlpexporter.New(),
-			otlpresourceattributes.New(metaClient),
+			otlpresourceattributes.New(apiReader),
 		),
 		apiReader:        apiReader,
 		recorder:         eventRecorder,
@@ -96,6 +133,90 @@ func newWebhook( //nolint:revive
 	}, nil
 }
 
+// startInformers creates the informers of the snapshot before the manager is started, so they are synced before the first admission.
+func startInformers(ctx context.Context, informers cache.Informers) error {
+	objects := []client.Object{
+		&dynakube.DynaKube{},
+		&corev1.Namespace{},
+		&injectionpolicy.InjectionPolicy{},
+		&corev1.Secret{},
+		&corev1.ConfigMap{},
+	}
+
+	for _, object := range objects {
+		if _, err := informers.GetInformer(ctx, object); err != nil {
+			return errors.WithMessagef(err, "failed to create informer for %T", object)
+		}
+	}
+
+	return nil
+}
+
+// startAppInformers creates the caches of the objects in the namespaces of the pods, which are read during admission.
+// The webhook may only watch the secrets it replicates, by name, so there is a cache per secret. Of the workloads only the metadata is cached.
+func startAppInformers(ctx context.Context, mgr manager.Manager, isOpenShift bool) ([]snapshot.Option, error) {
+	var options []snapshot.Option
+
+	secretNames := []string{
+		consts.BootstrapperInitSecretName,
+		consts.BootstrapperInitCertsSecretName,
+		consts.OTLPExporterSecretName,
+		consts.OTLPExporterCertsSecretName,
+	}
+
+	for _, name := range secretNames {
+		secretCache, err := newAppCache(mgr, map[client.Object]cache.ByObject{
+			&corev1.Secret{}: {Field: fields.OneTermEqualSelector("metadata.name", name)},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := secretCache.GetInformer(ctx, &corev1.Secret{}); err != nil {
+			return nil, errors.WithMessagef(err, "failed to create informer for secret %s", name)
+		}
+
+		options = append(options, snapshot.WithSecretCache(name, secretCache))
+	}
+
+	workloadCache, err := newAppCache(mgr, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var kinds []schema.GroupVersionKind
+
+	for _, workloadType := range workload.KnownWorkloads {
+		kind := schema.FromAPIVersionAndKind(workloadType.APIVersion, workloadType.Kind)
+		if kind.Group == "apps.openshift.io" && !isOpenShift {
+			continue
+		}
+
+		if _, err := workloadCache.GetInformer(ctx, &metav1.PartialObjectMetadata{TypeMeta: workloadType}); err != nil {
+			return nil, errors.WithMessagef(err, "failed to create informer for %s", kind)
+		}
+
+		kinds = append(kinds, kind)
+	}
+
+	return append(options, snapshot.WithWorkloadCache(workloadCache, kinds...)), nil
+}
+
+func newAppCache(mgr manager.Manager, byObject map[client.Object]cache.ByObject) (cache.Cache, error) {
+	appCache, err := cache.New(mgr.GetConfig(), cache.Options{
+		HTTPClient:       mgr.GetHTTPClient(),
+		Scheme:           mgr.GetScheme(),
+		Mapper:           mgr.GetRESTMapper(),
+		ByObject:         byObject,
+		DefaultTransform: cache.TransformStripManagedFields(),
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return appCache, errors.WithStack(mgr.Add(appCache))
+}
+
 func registerLivezEndpoint(mgr manager.Manager) {
 	mgr.GetWebhookServer().Register("/livez", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
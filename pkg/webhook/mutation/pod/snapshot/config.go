@@ -0,0 +1,41 @@
+package snapshot
+
+import (
+	"time"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/logd"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	log = logd.Get().WithName("pod-mutation-snapshot")
+
+	ageMetric = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "dynatrace",
+		Subsystem: "webhook",
+		Name:      "snapshot_age_seconds",
+		Help:      "Time since the webhook last reached the Kubernetes API, the configuration used for admissions may be outdated by this much",
+	})
+)
+
+const (
+	// probeInterval is the interval in which the Kubernetes API is probed, to know the age of the snapshot even if no objects are read.
+	probeInterval = 30 * time.Second
+
+	// staleThreshold is the age of the snapshot after which the webhook is considered degraded.
+	staleThreshold = 2 * time.Minute
+
+	// lastKnownTTL is the time an object, that is not part of the watch-fed cache, is reused without reading it again.
+	lastKnownTTL = time.Minute
+
+	// lastKnownRetention is the time an object is kept as fallback for the case the Kubernetes API can't be reached, as long as it can be reached.
+	lastKnownRetention = 15 * time.Minute
+
+	// maxLastKnownObjects limits the objects that are kept, the oldest one is dropped if the limit is reached.
+	maxLastKnownObjects = 1000
+)
+
+func init() {
+	metrics.Registry.MustRegister(ageMetric)
+}
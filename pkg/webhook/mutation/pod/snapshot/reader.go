@@ -0,0 +1,289 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/v1alpha2/injectionpolicy"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/timeprovider"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// Reader serves the objects needed during admission from memory, so hiccups of the Kubernetes API don't cause pods to be admitted without injection.
+// DynaKubes, Namespaces, InjectionPolicies and the Secrets and ConfigMaps in the webhook namespace are read from the watch-fed cache of the manager.
+// The secrets replicated into the namespaces of the pods and the metadata of the workloads owning the pods are read from their own watch-fed caches.
+// Any other object is remembered once it was read from the Kubernetes API, it's reused for a short time, and for as long as the Kubernetes API can't be reached.
+// Objects missing in a cache are read from the Kubernetes API, as the cache might not have caught up yet.
+type Reader struct {
+	cache            client.Reader
+	apiReader        client.Reader
+	timeProvider     *timeprovider.Provider
+	webhookNamespace string
+
+	secretCaches  map[string]client.Reader
+	workloadCache client.Reader
+	workloadKinds map[schema.GroupVersionKind]bool
+
+	mutex       sync.RWMutex
+	lastKnown   map[string]lastKnownObject
+	lastContact *metav1.Time
+}
+
+type lastKnownObject struct {
+	object client.Object
+	readAt *metav1.Time
+}
+
+type Option func(*Reader)
+
+var (
+	_ client.Reader    = &Reader{}
+	_ manager.Runnable = &Reader{}
+)
+
+// WithSecretCache reads the secrets with the name from the cache, in all namespaces.
+func WithSecretCache(name string, cache client.Reader) Option {
+	return func(r *Reader) {
+		r.secretCaches[name] = cache
+	}
+}
+
+// WithWorkloadCache reads the metadata of the workloads of the kinds from the cache, in all namespaces.
+func WithWorkloadCache(cache client.Reader, kinds ...schema.GroupVersionKind) Option {
+	return func(r *Reader) {
+		r.workloadCache = cache
+
+		for _, kind := range kinds {
+			r.workloadKinds[kind] = true
+		}
+	}
+}
+
+func New(cache, apiReader client.Reader, webhookNamespace string, options ...Option) *Reader {
+	timeProvider := timeprovider.New()
+
+	reader := &Reader{
+		cache:            cache,
+		apiReader:        apiReader,
+		timeProvider:     timeProvider,
+		webhookNamespace: webhookNamespace,
+		secretCaches:     map[string]client.Reader{},
+		workloadKinds:    map[schema.GroupVersionKind]bool{},
+		lastKnown:        map[string]lastKnownObject{},
+		lastContact:      timeProvider.Now(),
+	}
+
+	for _, option := range options {
+		option(reader)
+	}
+
+	return reader
+}
+
+func (r *Reader) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	if cache := r.getCache(obj, key); cache != nil {
+		err := cache.Get(ctx, key, obj, opts...)
+		if !k8serrors.IsNotFound(err) {
+			return err
+		}
+
+		// the cache might not have caught up yet, e.g. with the owner of a pod that was just created
+		return r.getFromAPI(ctx, key, obj, false, opts...)
+	}
+
+	return r.getFromAPI(ctx, key, obj, true, opts...)
+}
+
+// getFromAPI reads the object from the Kubernetes API and remembers it, the last known state is only used if the Kubernetes API can't be reached.
+// If reuseRecent is set, an object read within the lastKnownTTL is reused without reading it again.
+func (r *Reader) getFromAPI(ctx context.Context, key client.ObjectKey, obj client.Object, reuseRecent bool, opts ...client.GetOption) error {
+	// the kind distinguishes metadata-only objects, e.g. of different workloads
+	lastKnownKey := fmt.Sprintf("%T/%s/%s", obj, obj.GetObjectKind().GroupVersionKind().Kind, key)
+
+	// while the Kubernetes API can't be reached, the last known state is used without waiting for another failed request
+	lastKnown, ok := r.getLastKnown(lastKnownKey)
+	if ok && (r.IsDegraded() || (reuseRecent && !timeprovider.TimeoutReached(lastKnown.readAt, r.timeProvider.Now(), lastKnownTTL))) {
+		copyInto(lastKnown.object, obj)
+
+		return nil
+	}
+
+	err := r.apiReader.Get(ctx, key, obj, opts...)
+
+	switch {
+	case err == nil:
+		r.setLastKnown(lastKnownKey, obj)
+	case k8serrors.IsNotFound(err):
+		r.deleteLastKnown(lastKnownKey)
+		r.setContact()
+	case ok:
+		log.Info("failed to read object, using the last known state", "key", lastKnownKey, "readAt", lastKnown.readAt, "error", err.Error())
+		copyInto(lastKnown.object, obj)
+
+		return nil
+	}
+
+	return err
+}
+
+func (r *Reader) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	switch list.(type) {
+	case *dynakube.DynaKubeList, *corev1.NamespaceList, *injectionpolicy.InjectionPolicyList:
+		return r.cache.List(ctx, list, opts...)
+	}
+
+	return r.apiReader.List(ctx, list, opts...)
+}
+
+// Start probes the Kubernetes API periodically, so the age of the snapshot is known even if no objects are read.
+func (r *Reader) Start(ctx context.Context) error {
+	ticker := time.NewTicker(probeInterval)
+	defer ticker.Stop()
+
+	for {
+		r.probe(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection returns false, as every webhook replica needs its own snapshot.
+func (r *Reader) NeedLeaderElection() bool {
+	return false
+}
+
+// IsDegraded checks if the Kubernetes API wasn't reachable for a while, in which case admissions rely on the last known state.
+func (r *Reader) IsDegraded() bool {
+	return timeprovider.TimeoutReached(r.LastContact(), r.timeProvider.Now(), staleThreshold)
+}
+
+// LastContact returns the time the Kubernetes API was last reached successfully.
+func (r *Reader) LastContact() *metav1.Time {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return r.lastContact
+}
+
+func (r *Reader) probe(ctx context.Context) {
+	if err := r.apiReader.Get(ctx, client.ObjectKey{Name: r.webhookNamespace}, &corev1.Namespace{}); err != nil {
+		log.Info("failed to reach the Kubernetes API", "lastContact", r.LastContact(), "error", err.Error())
+	} else {
+		r.setContact()
+		r.evictLastKnown()
+	}
+
+	ageMetric.Set(r.timeProvider.Now().Sub(r.LastContact().Time).Seconds())
+}
+
+// getCache returns the watch-fed cache the object is part of, or nil if it's not part of any.
+func (r *Reader) getCache(obj client.Object, key client.ObjectKey) client.Reader {
+	switch obj := obj.(type) {
+	case *dynakube.DynaKube, *corev1.Namespace, *injectionpolicy.InjectionPolicy:
+		return r.cache
+	case *corev1.ConfigMap:
+		if key.Namespace == r.webhookNamespace {
+			return r.cache
+		}
+	case *corev1.Secret:
+		if key.Namespace == r.webhookNamespace {
+			return r.cache
+		}
+
+		return r.secretCaches[key.Name]
+	case *metav1.PartialObjectMetadata:
+		if r.workloadKinds[obj.GroupVersionKind()] {
+			return r.workloadCache
+		}
+	}
+
+	return nil
+}
+
+func (r *Reader) getLastKnown(key string) (lastKnownObject, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	lastKnown, ok := r.lastKnown[key]
+
+	return lastKnown, ok
+}
+
+func (r *Reader) setLastKnown(key string, obj client.Object) {
+	now := r.timeProvider.Now()
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, ok := r.lastKnown[key]; !ok && len(r.lastKnown) >= maxLastKnownObjects {
+		r.deleteOldestLastKnown()
+	}
+
+	r.lastKnown[key] = lastKnownObject{object: obj.DeepCopyObject().(client.Object), readAt: now}
+	r.lastContact = now
+}
+
+// evictLastKnown drops the objects that weren't read for a while, it's only called while the Kubernetes API is reachable,
+// otherwise they are still needed.
+func (r *Reader) evictLastKnown() {
+	now := r.timeProvider.Now()
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for key, lastKnown := range r.lastKnown {
+		if timeprovider.TimeoutReached(lastKnown.readAt, now, lastKnownRetention) {
+			delete(r.lastKnown, key)
+		}
+	}
+}
+
+// deleteOldestLastKnown has to be called with the lock held.
+func (r *Reader) deleteOldestLastKnown() {
+	var oldestKey string
+
+	var oldest *metav1.Time
+
+	for key, lastKnown := range r.lastKnown {
+		if oldest == nil || lastKnown.readAt.Before(oldest) {
+			oldestKey = key
+			oldest = lastKnown.readAt
+		}
+	}
+
+	delete(r.lastKnown, oldestKey)
+}
+
+func (r *Reader) deleteLastKnown(key string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.lastKnown, key)
+}
+
+func (r *Reader) setContact() {
+	now := r.timeProvider.Now()
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.lastContact = now
+}
+
+// copyInto sets obj to a copy of the source, both have to be pointers to the same type.
+func copyInto(source, obj client.Object) {
+	reflect.ValueOf(obj).Elem().Set(reflect.ValueOf(source.DeepCopyObject()).Elem())
+}
@@ -0,0 +1,232 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/latest/dynakube"
+	"github.com/Dynatrace/dynatrace-operator/pkg/api/scheme/fake"
+	"github.com/Dynatrace/dynatrace-operator/pkg/util/timeprovider"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	webhookNamespace = "dynatrace"
+	testNamespace    = "test-namespace"
+)
+
+var testTime = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+type failingReader struct {
+	client.Reader
+	fail  bool
+	reads int
+}
+
+func (r *failingReader) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	r.reads++
+
+	if r.fail {
+		return errors.New("connection refused")
+	}
+
+	return r.Reader.Get(ctx, key, obj, opts...)
+}
+
+func createReader(cache, apiReader client.Reader, options ...Option) *Reader {
+	reader := New(cache, apiReader, webhookNamespace, options...)
+	reader.timeProvider = timeprovider.New().Freeze()
+	reader.timeProvider.Set(testTime)
+	reader.lastContact = reader.timeProvider.Now()
+
+	return reader
+}
+
+func createSecret(namespace string) *corev1.Secret {
+	return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: namespace}}
+}
+
+func TestGet(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("objects of the snapshot are read from the cache", func(t *testing.T) {
+		cache := fake.NewClient(
+			&dynakube.DynaKube{ObjectMeta: metav1.ObjectMeta{Name: "dynakube", Namespace: webhookNamespace}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: testNamespace}},
+			createSecret(webhookNamespace),
+		)
+		reader := createReader(cache, &failingReader{fail: true})
+
+		require.NoError(t, reader.Get(ctx, client.ObjectKey{Name: "dynakube", Namespace: webhookNamespace}, &dynakube.DynaKube{}))
+		require.NoError(t, reader.Get(ctx, client.ObjectKey{Name: testNamespace}, &corev1.Namespace{}))
+		require.NoError(t, reader.Get(ctx, client.ObjectKey{Name: "secret", Namespace: webhookNamespace}, &corev1.Secret{}))
+	})
+
+	t.Run("replicated secrets and workloads are read from their caches", func(t *testing.T) {
+		deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "deployment", Namespace: testNamespace}}
+		secretCache := fake.NewClient(createSecret(testNamespace))
+		workloadCache := fake.NewClient(deployment)
+		reader := createReader(fake.NewClient(), &failingReader{fail: true},
+			WithSecretCache("secret", secretCache),
+			WithWorkloadCache(workloadCache, schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}),
+		)
+
+		require.NoError(t, reader.Get(ctx, client.ObjectKey{Name: "secret", Namespace: testNamespace}, &corev1.Secret{}))
+
+		owner := &metav1.PartialObjectMetadata{TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"}}
+		require.NoError(t, reader.Get(ctx, client.ObjectKey{Name: "deployment", Namespace: testNamespace}, owner))
+		assert.Equal(t, "deployment", owner.Name)
+
+		other := &metav1.PartialObjectMetadata{TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "StatefulSet"}}
+		require.Error(t, reader.Get(ctx, client.ObjectKey{Name: "deployment", Namespace: testNamespace}, other))
+		require.Error(t, reader.Get(ctx, client.ObjectKey{Name: "other", Namespace: testNamespace}, &corev1.Secret{}))
+	})
+
+	t.Run("objects missing in the cache are read from the api", func(t *testing.T) {
+		workloadKind := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "ReplicaSet"}
+		replicaSet := &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{Name: "replicaset", Namespace: testNamespace}}
+		clt := fake.NewClient(replicaSet)
+		apiReader := &failingReader{Reader: clt}
+		reader := createReader(fake.NewClient(), apiReader, WithWorkloadCache(fake.NewClient(), workloadKind))
+		key := client.ObjectKey{Name: "replicaset", Namespace: testNamespace}
+
+		owner := &metav1.PartialObjectMetadata{TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "ReplicaSet"}}
+		require.NoError(t, reader.Get(ctx, key, owner))
+		assert.Equal(t, "replicaset", owner.Name)
+
+		apiReader.fail = true
+
+		owner = &metav1.PartialObjectMetadata{TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "ReplicaSet"}}
+		require.NoError(t, reader.Get(ctx, key, owner))
+		assert.Equal(t, "replicaset", owner.Name)
+
+		apiReader.fail = false
+		require.NoError(t, clt.Delete(ctx, replicaSet))
+
+		err := reader.Get(ctx, key, &metav1.PartialObjectMetadata{TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "ReplicaSet"}})
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+
+	t.Run("other objects are reused until the ttl is reached", func(t *testing.T) {
+		apiReader := fake.NewClient(createSecret(testNamespace))
+		reader := createReader(fake.NewClient(), apiReader)
+		key := client.ObjectKey{Name: "secret", Namespace: testNamespace}
+
+		require.NoError(t, reader.Get(ctx, key, &corev1.Secret{}))
+		require.NoError(t, apiReader.Delete(ctx, createSecret(testNamespace)))
+
+		var secret corev1.Secret
+		require.NoError(t, reader.Get(ctx, key, &secret))
+		assert.Equal(t, "secret", secret.Name)
+
+		reader.timeProvider.Set(testTime.Add(lastKnownTTL))
+
+		err := reader.Get(ctx, key, &corev1.Secret{})
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+
+	t.Run("last known state is used if the api is not reachable", func(t *testing.T) {
+		apiReader := &failingReader{Reader: fake.NewClient(createSecret(testNamespace))}
+		reader := createReader(fake.NewClient(), apiReader)
+		key := client.ObjectKey{Name: "secret", Namespace: testNamespace}
+
+		require.NoError(t, reader.Get(ctx, key, &corev1.Secret{}))
+
+		apiReader.fail = true
+
+		reader.timeProvider.Set(testTime.Add(time.Hour))
+
+		var secret corev1.Secret
+		require.NoError(t, reader.Get(ctx, key, &secret))
+		assert.Equal(t, "secret", secret.Name)
+
+		require.Error(t, reader.Get(ctx, client.ObjectKey{Name: "other", Namespace: testNamespace}, &corev1.Secret{}))
+	})
+
+	t.Run("last known state is used without reading the api if degraded", func(t *testing.T) {
+		apiReader := &failingReader{Reader: fake.NewClient(createSecret(testNamespace))}
+		reader := createReader(fake.NewClient(), apiReader)
+		key := client.ObjectKey{Name: "secret", Namespace: testNamespace}
+
+		require.NoError(t, reader.Get(ctx, key, &corev1.Secret{}))
+
+		apiReader.fail = true
+		reader.timeProvider.Set(testTime.Add(staleThreshold + time.Second))
+		require.True(t, reader.IsDegraded())
+
+		reads := apiReader.reads
+
+		require.NoError(t, reader.Get(ctx, key, &corev1.Secret{}))
+		assert.Equal(t, reads, apiReader.reads)
+	})
+}
+
+func TestLastKnownLimits(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("oldest object is dropped if the limit is reached", func(t *testing.T) {
+		reader := createReader(fake.NewClient(), fake.NewClient())
+
+		for i := range maxLastKnownObjects + 1 {
+			reader.timeProvider.Set(testTime.Add(time.Duration(i) * time.Millisecond))
+			reader.setLastKnown(fmt.Sprintf("key-%d", i), createSecret(testNamespace))
+		}
+
+		assert.Len(t, reader.lastKnown, maxLastKnownObjects)
+		assert.NotContains(t, reader.lastKnown, "key-0")
+		assert.Contains(t, reader.lastKnown, fmt.Sprintf("key-%d", maxLastKnownObjects))
+	})
+
+	t.Run("objects are evicted after the retention if the api is reachable", func(t *testing.T) {
+		apiReader := &failingReader{Reader: fake.NewClient(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: webhookNamespace}})}
+		reader := createReader(fake.NewClient(), apiReader)
+		reader.setLastKnown("old", createSecret(testNamespace))
+
+		reader.timeProvider.Set(testTime.Add(lastKnownRetention + time.Second))
+		reader.setLastKnown("new", createSecret(testNamespace))
+
+		apiReader.fail = true
+
+		reader.probe(ctx)
+		assert.Len(t, reader.lastKnown, 2)
+
+		apiReader.fail = false
+
+		reader.probe(ctx)
+		assert.Equal(t, []string{"new"}, slices.Collect(maps.Keys(reader.lastKnown)))
+	})
+}
+
+func TestIsDegraded(t *testing.T) {
+	ctx := context.Background()
+	apiReader := &failingReader{Reader: fake.NewClient(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: webhookNamespace}})}
+	reader := createReader(fake.NewClient(), apiReader)
+
+	assert.False(t, reader.IsDegraded())
+
+	apiReader.fail = true
+
+	reader.timeProvider.Set(testTime.Add(staleThreshold))
+	reader.probe(ctx)
+
+	assert.True(t, reader.IsDegraded())
+	assert.Equal(t, testTime, reader.LastContact().Time)
+
+	apiReader.fail = false
+
+	reader.probe(ctx)
+
+	assert.False(t, reader.IsDegraded())
+}
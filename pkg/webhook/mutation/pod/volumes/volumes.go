@@ -23,6 +23,10 @@ const (
 	InputVolumeName    = "dynatrace-input"
 	InitInputMountPath = "/mnt/input"
 
+	// SidecarStateVolumeName is only mounted into the install container, if it runs as a native sidecar, to mark the bootstrap as done.
+	SidecarStateVolumeName    = "dynatrace-sidecar-state"
+	InitSidecarStateMountPath = "/mnt/sidecar-state"
+
 	// AnnotationResourcePrefix is used as a prefix for all volume resource annotations.
 	AnnotationResourcePrefix = "volume.dynatrace.com/"
 
@@ -92,6 +96,34 @@ func AddInitConfigVolumeMount(container *corev1.Container) {
 	)
 }
 
+func AddSidecarStateVolume(pod *corev1.Pod) {
+	if k8svolume.Contains(pod.Spec.Volumes, SidecarStateVolumeName) {
+		return
+	}
+
+	pod.Spec.Volumes = append(pod.Spec.Volumes,
+		corev1.Volume{
+			Name: SidecarStateVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{SizeLimit: ptr.To(resource.MustParse("1Mi"))},
+			},
+		},
+	)
+}
+
+func AddInitSidecarStateVolumeMount(container *corev1.Container) {
+	if k8smount.ContainsPath(container.VolumeMounts, InitSidecarStateMountPath) {
+		return
+	}
+
+	container.VolumeMounts = append(container.VolumeMounts,
+		corev1.VolumeMount{
+			Name:      SidecarStateVolumeName,
+			MountPath: InitSidecarStateMountPath,
+		},
+	)
+}
+
 func AddInputVolume(pod *corev1.Pod) {
 	if k8svolume.Contains(pod.Spec.Volumes, InputVolumeName) {
 		return
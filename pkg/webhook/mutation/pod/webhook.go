@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/Dynatrace/dynatrace-operator/pkg/logd"
 	"github.com/Dynatrace/dynatrace-operator/pkg/util/kubernetes/fields/k8senv"
@@ -14,6 +15,7 @@ import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/events"
 	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/handler"
 	dtwebhook "github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator"
+	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/snapshot"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -29,13 +31,13 @@ const (
 	ocDebugAnnotationsResource  = "debug.openshift.io/source-resource"
 )
 
-func AddWebhookToManager(ctx context.Context, mgr manager.Manager, ns string, isOpenShift bool) error {
+func AddWebhookToManager(ctx context.Context, mgr manager.Manager, ns string, isOpenShift, supportsNativeSidecars bool) error {
 	podName := os.Getenv(k8senv.PodName)
 	if podName == "" {
 		log.Info("no Pod name set for webhook container")
 	}
 
-	if err := registerInjectEndpoint(ctx, mgr, ns, podName, isOpenShift); err != nil {
+	if err := registerInjectEndpoint(ctx, mgr, ns, podName, isOpenShift, supportsNativeSidecars); err != nil {
 		return err
 	}
 
@@ -52,12 +54,20 @@ type webhook struct {
 
 	decoder   admission.Decoder
 	apiReader client.Reader
+	snapshot  *snapshot.Reader
 
 	webhookNamespace string
 	deployedViaOLM   bool
 }
 
 func (wh *webhook) Handle(ctx context.Context, request admission.Request) admission.Response {
+	start := time.Now()
+	defer func() { admissionDurationMetric.Observe(time.Since(start).Seconds()) }()
+
+	if request.SubResource == ephemeralContainersSubResource {
+		return wh.handleEphemeralContainers(ctx, request)
+	}
+
 	emptyPatch := admission.Patched("")
 
 	mutationRequest, err := wh.createMutationRequestBase(ctx, request)
@@ -76,12 +86,33 @@ func (wh *webhook) Handle(ctx context.Context, request admission.Request) admiss
 
 	podName := mutationRequest.PodName()
 
-	if !mutationRequired(mutationRequest) || wh.isOcDebugPod(mutationRequest.Pod) {
+	if reason := wh.getSkipReason(mutationRequest); reason != "" {
+		recordSkipped(mutationRequest, reason)
+
 		return emptyPatch
 	}
 
 	wh.recorder.Setup(mutationRequest)
 
+	handlerErr := wh.runHandlers(mutationRequest)
+
+	recordOutcomes(mutationRequest, handlerErr != nil)
+
+	if handlerErr != nil && !errors.As(handlerErr, new(dtwebhook.MutatorError)) {
+		return silentErrorResponse(mutationRequest.Pod, handlerErr)
+	}
+
+	wh.setStaleAnnotation(mutationRequest.Pod)
+
+	log.Info("injection finished for pod", "podName", podName, "namespace", request.Namespace)
+
+	return createResponseForPod(mutationRequest.Pod, request)
+}
+
+// runHandlers runs all handlers on the pod of the request.
+// If a mutator fails with a MutatorError, the modifications to the pod are reverted and only the annotations of the error are set.
+// Any other error is returned as is, in which case the pod must not be patched at all.
+func (wh *webhook) runHandlers(mutationRequest *dtwebhook.MutationRequest) error {
 	originalPod := mutationRequest.Pod.DeepCopy()
 
 	var handlerErr error
@@ -92,34 +123,51 @@ func (wh *webhook) Handle(ctx context.Context, request admission.Request) admiss
 		handlerErr = err
 	}
 
-	if handlerErr != nil {
-		mutErr := new(dtwebhook.MutatorError)
-		if !errors.As(handlerErr, mutErr) {
-			return silentErrorResponse(mutationRequest.Pod, handlerErr)
-		}
-
+	mutErr := new(dtwebhook.MutatorError)
+	if handlerErr != nil && errors.As(handlerErr, mutErr) {
 		mutationRequest.Pod = originalPod // prevent partial modifications
 		mutErr.SetAnnotations(mutationRequest.Pod)
 	}
 
-	log.Info("injection finished for pod", "podName", podName, "namespace", request.Namespace)
-
-	return createResponseForPod(mutationRequest.Pod, request)
+	return handlerErr
 }
 
-func mutationRequired(mutationRequest *dtwebhook.MutationRequest) bool {
-	if mutationRequest == nil {
-		return false
+// setStaleAnnotation marks pods admitted while the Kubernetes API was not reachable, as their configuration may be outdated.
+func (wh *webhook) setStaleAnnotation(pod *corev1.Pod) {
+	if wh.snapshot == nil || !wh.snapshot.IsDegraded() {
+		return
 	}
 
-	enabledOnPod := maputils.GetFieldBool(mutationRequest.Pod.Annotations, dtwebhook.AnnotationDynatraceInject, true)
+	lastContact := wh.snapshot.LastContact()
+	log.Info("Kubernetes API not reachable, injecting with the last known configuration", "podName", k8spod.GetName(*pod), "lastContact", lastContact)
+
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+
+	pod.Annotations[dtwebhook.AnnotationDynatraceStaleSince] = lastContact.UTC().Format(time.RFC3339)
+}
+
+// getSkipReason returns why the pod of the request must not be mutated at all, or an empty string if it has to be mutated.
+func (wh *webhook) getSkipReason(mutationRequest *dtwebhook.MutationRequest) string {
+	if !maputils.GetFieldBool(mutationRequest.Pod.Annotations, dtwebhook.AnnotationDynatraceInject, true) {
+		return dtwebhook.InjectionDisabledReason
+	}
 
 	enabledOnContainers := false
 	for _, container := range mutationRequest.Pod.Spec.Containers {
 		enabledOnContainers = enabledOnContainers || !dtwebhook.IsContainerExcludedFromInjection(mutationRequest.DynaKube.Annotations, mutationRequest.Pod.Annotations, container.Name)
 	}
 
-	return enabledOnPod && enabledOnContainers
+	if !enabledOnContainers {
+		return dtwebhook.AllContainersExcludedReason
+	}
+
+	if wh.isOcDebugPod(mutationRequest.Pod) {
+		return dtwebhook.OcDebugPodReason
+	}
+
+	return ""
 }
 
 func (wh *webhook) isOcDebugPod(pod *corev1.Pod) bool {
@@ -114,7 +114,7 @@ func TestWebhook(t *testing.T) {
 			require.NoError(t, mgr.GetClient().Create(t.Context(), dummyWebhookPod))
 			t.Setenv(k8senv.PodName, dummyWebhookPod.Name)
 
-			return podmutation.AddWebhookToManager(t.Context(), mgr, testNamespace, false)
+			return podmutation.AddWebhookToManager(t.Context(), mgr, testNamespace, false, false)
 		},
 	)
 
@@ -369,7 +369,7 @@ func TestOTLPWebhook(t *testing.T) {
 			require.NoError(t, mgr.GetClient().Create(t.Context(), dummyWebhookPod))
 			t.Setenv(k8senv.PodName, dummyWebhookPod.Name)
 
-			return podmutation.AddWebhookToManager(t.Context(), mgr, testNamespace, false)
+			return podmutation.AddWebhookToManager(t.Context(), mgr, testNamespace, false, false)
 		},
 	)
 
@@ -847,7 +847,7 @@ func setupOTLPWebhookEnv(t *testing.T) client.Client {
 			require.NoError(t, mgr.GetClient().Create(t.Context(), dummyWebhookPod))
 			t.Setenv(k8senv.PodName, dummyWebhookPod.Name)
 
-			return podmutation.AddWebhookToManager(t.Context(), mgr, testNamespace, false)
+			return podmutation.AddWebhookToManager(t.Context(), mgr, testNamespace, false, false)
 		},
 	)
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/handler"
 	podwebhook "github.com/Dynatrace/dynatrace-operator/pkg/webhook/mutation/pod/mutator"
 	handlermock "github.com/Dynatrace/dynatrace-operator/test/mocks/pkg/webhook/mutation/pod/handler"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -102,6 +103,7 @@ func TestHandle(t *testing.T) {
 		require.NotNil(t, resp)
 		assert.True(t, resp.Allowed)
 		assert.Equal(t, admission.Patched(""), resp)
+		assert.InDelta(t, 1, testutil.ToFloat64(skipsMetric.WithLabelValues(testDynakubeName, testNamespaceName, podwebhook.OneAgentMutatorName, podwebhook.InjectionDisabledReason)), 0)
 	})
 
 	t.Run("no inject annotation (per container) ==> no inject, empty patch", func(t *testing.T) {
@@ -118,6 +120,7 @@ func TestHandle(t *testing.T) {
 		require.NotNil(t, resp)
 		assert.True(t, resp.Allowed)
 		assert.Equal(t, admission.Patched(""), resp)
+		assert.InDelta(t, 1, testutil.ToFloat64(skipsMetric.WithLabelValues(testDynakubeName, testNamespaceName, podwebhook.OneAgentMutatorName, podwebhook.AllContainersExcludedReason)), 0)
 	})
 
 	t.Run("OC debug pod ==> no inject", func(t *testing.T) {
@@ -134,6 +137,7 @@ func TestHandle(t *testing.T) {
 		require.NotNil(t, resp)
 		assert.True(t, resp.Allowed)
 		assert.Equal(t, admission.Patched(""), resp)
+		assert.InDelta(t, 1, testutil.ToFloat64(skipsMetric.WithLabelValues(testDynakubeName, testNamespaceName, podwebhook.OneAgentMutatorName, podwebhook.OcDebugPodReason)), 0)
 	})
 	t.Run("Arbitrary Error in OTLP handler ==> revert all modifications and include message", func(t *testing.T) {
 		injectionHandler := handlermock.NewHandler(t)
@@ -244,8 +248,8 @@ func createTestWebhook(t *testing.T, injectionHandler, otlpHandler handler.Handl
 
 	fakeClient := fake.NewClient(objects...)
 
-	wh, err := newWebhook(fakeClient, fakeClient, fakeClient,
-		events.NewRecorder(record.NewFakeRecorder(10)), decoder, getTestWebhookPod(t), false)
+	wh, err := newWebhook(fakeClient, fakeClient,
+		events.NewRecorder(record.NewFakeRecorder(10)), decoder, getTestWebhookPod(t), false, false)
 
 	require.NoError(t, err)
 
@@ -26,7 +26,7 @@ func NewInfo(partialObjectMetadata *metav1.PartialObjectMetadata) *Info {
 	}
 }
 
-func FindRootOwnerOfPod(ctx context.Context, clt client.Client, request dtwebhook.BaseRequest, log logd.Logger) (*Info, error) {
+func FindRootOwnerOfPod(ctx context.Context, clt client.Reader, request dtwebhook.BaseRequest, log logd.Logger) (*Info, error) {
 	podPartialMetadata := &metav1.PartialObjectMetadata{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: request.Pod.APIVersion,
@@ -48,7 +48,7 @@ func FindRootOwnerOfPod(ctx context.Context, clt client.Client, request dtwebhoo
 	return NewInfo(rootOwner), nil
 }
 
-func findRootOwner(ctx context.Context, clt client.Client, childObjectMetadata *metav1.PartialObjectMetadata, log logd.Logger) (parentObjectMetadata *metav1.PartialObjectMetadata, err error) {
+func findRootOwner(ctx context.Context, clt client.Reader, childObjectMetadata *metav1.PartialObjectMetadata, log logd.Logger) (parentObjectMetadata *metav1.PartialObjectMetadata, err error) {
 	objectMetadata := childObjectMetadata.ObjectMeta
 	for _, owner := range objectMetadata.OwnerReferences {
 		if owner.Controller != nil && *owner.Controller {
@@ -87,19 +87,20 @@ func findRootOwner(ctx context.Context, clt client.Client, childObjectMetadata *
 	return childObjectMetadata, nil
 }
 
-func isWellKnownWorkload(ownerRef *metav1.PartialObjectMetadata) bool {
-	knownWorkloads := []metav1.TypeMeta{
-		{Kind: "ReplicaSet", APIVersion: "apps/v1"},
-		{Kind: "Deployment", APIVersion: "apps/v1"},
-		{Kind: "ReplicationController", APIVersion: "v1"},
-		{Kind: "StatefulSet", APIVersion: "apps/v1"},
-		{Kind: "DaemonSet", APIVersion: "apps/v1"},
-		{Kind: "Job", APIVersion: "batch/v1"},
-		{Kind: "CronJob", APIVersion: "batch/v1"},
-		{Kind: "DeploymentConfig", APIVersion: "apps.openshift.io/v1"},
-	}
+// KnownWorkloads are the kinds of workloads that are looked up as owners of a pod, only their metadata is read.
+var KnownWorkloads = []metav1.TypeMeta{
+	{Kind: "ReplicaSet", APIVersion: "apps/v1"},
+	{Kind: "Deployment", APIVersion: "apps/v1"},
+	{Kind: "ReplicationController", APIVersion: "v1"},
+	{Kind: "StatefulSet", APIVersion: "apps/v1"},
+	{Kind: "DaemonSet", APIVersion: "apps/v1"},
+	{Kind: "Job", APIVersion: "batch/v1"},
+	{Kind: "CronJob", APIVersion: "batch/v1"},
+	{Kind: "DeploymentConfig", APIVersion: "apps.openshift.io/v1"},
+}
 
-	for _, knownController := range knownWorkloads {
+func isWellKnownWorkload(ownerRef *metav1.PartialObjectMetadata) bool {
+	for _, knownController := range KnownWorkloads {
 		if ownerRef.Kind == knownController.Kind &&
 			ownerRef.APIVersion == knownController.APIVersion {
 			return true